@@ -82,13 +82,15 @@ func testStream(ctx context.Context, t *testing.T, logger log.Logger, app types.
 	client.SetResponseCallback(func(req *types.Request, res *types.Response) {
 		// Process response
 		switch r := res.Value.(type) {
-		case *types.Response_DeliverTx:
+		case *types.Response_FinalizeBlock:
 			counter++
-			if r.DeliverTx.Code != code.CodeTypeOK {
-				t.Error("DeliverTx failed with ret_code", r.DeliverTx.Code)
+			for _, tx := range r.FinalizeBlock.TxResults {
+				if tx.Code != code.CodeTypeOK {
+					t.Error("FinalizeBlock failed with ret_code", tx.Code)
+				}
 			}
 			if counter > numDeliverTxs {
-				t.Fatalf("Too many DeliverTx responses. Got %d, expected %d", counter, numDeliverTxs)
+				t.Fatalf("Too many FinalizeBlock responses. Got %d, expected %d", counter, numDeliverTxs)
 			}
 			if counter == numDeliverTxs {
 				go func() {
@@ -107,7 +109,7 @@ func testStream(ctx context.Context, t *testing.T, logger log.Logger, app types.
 	// Write requests
 	for counter := 0; counter < numDeliverTxs; counter++ {
 		// Send request
-		_, err = client.DeliverTxAsync(ctx, types.RequestDeliverTx{Tx: []byte("test")})
+		_, err = client.FinalizeBlockAsync(ctx, types.RequestFinalizeBlock{Txs: [][]byte{[]byte("test")}})
 		require.NoError(t, err)
 
 		// Sometimes send flush messages
@@ -162,15 +164,17 @@ func testGRPCSync(ctx context.Context, t *testing.T, logger log.Logger, app type
 	// Write requests
 	for counter := 0; counter < numDeliverTxs; counter++ {
 		// Send request
-		response, err := client.DeliverTx(ctx, &types.RequestDeliverTx{Tx: []byte("test")})
-		require.NoError(t, err, "Error in GRPC DeliverTx")
+		response, err := client.FinalizeBlock(ctx, &types.RequestFinalizeBlock{Txs: [][]byte{[]byte("test")}})
+		require.NoError(t, err, "Error in GRPC FinalizeBlock")
 
 		counter++
-		if response.Code != code.CodeTypeOK {
-			t.Error("DeliverTx failed with ret_code", response.Code)
+		for _, tx := range response.TxResults {
+			if tx.Code != code.CodeTypeOK {
+				t.Error("FinalizeBlock failed with ret_code", tx.Code)
+			}
 		}
 		if counter > numDeliverTxs {
-			t.Fatal("Too many DeliverTx responses")
+			t.Fatal("Too many FinalizeBlock responses")
 		}
 		t.Log("response", counter)
 		if counter == numDeliverTxs {
@@ -16,7 +16,6 @@ import (
 	"github.com/tendermint/tendermint/abci/example/code"
 	abciserver "github.com/tendermint/tendermint/abci/server"
 	"github.com/tendermint/tendermint/abci/types"
-	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
 const (
@@ -25,12 +24,14 @@ const (
 )
 
 func testKVStore(t *testing.T, app types.Application, tx []byte, key, value string) {
-	req := types.RequestDeliverTx{Tx: tx}
-	ar := app.DeliverTx(req)
-	require.False(t, ar.IsErr(), ar)
+	req := types.RequestFinalizeBlock{Txs: [][]byte{tx}}
+	ar := app.FinalizeBlock(req)
+	require.Equal(t, 1, len(ar.TxResults))
+	require.False(t, ar.TxResults[0].IsErr())
 	// repeating tx doesn't raise error
-	ar = app.DeliverTx(req)
-	require.False(t, ar.IsErr(), ar)
+	ar = app.FinalizeBlock(req)
+	require.Equal(t, 1, len(ar.TxResults))
+	require.False(t, ar.TxResults[0].IsErr())
 	// commit
 	app.Commit()
 
@@ -104,11 +105,7 @@ func TestPersistentKVStoreInfo(t *testing.T) {
 	// make and apply block
 	height = int64(1)
 	hash := []byte("foo")
-	header := tmproto.Header{
-		Height: height,
-	}
-	kvstore.BeginBlock(types.RequestBeginBlock{Hash: hash, Header: header})
-	kvstore.EndBlock(types.RequestEndBlock{Height: header.Height})
+	kvstore.FinalizeBlock(types.RequestFinalizeBlock{Hash: hash, Height: height})
 	kvstore.Commit()
 
 	resInfo = kvstore.Info(types.RequestInfo{})
@@ -185,6 +182,61 @@ func TestValUpdates(t *testing.T) {
 
 }
 
+func TestPrepareAndProcessProposal(t *testing.T) {
+	dir := t.TempDir()
+	kvstore := NewPersistentKVStoreApplication(dir)
+	t.Cleanup(func() { require.NoError(t, kvstore.Close()) })
+
+	val := RandVal(0)
+	goodTx := MakeValSetChangeTx(val.PubKey, val.Power)
+	badTx := []byte("val:notbase64!10")
+	kvTx := []byte("abc=def")
+
+	resPrepare := kvstore.PrepareProposal(types.RequestPrepareProposal{
+		MaxTxBytes: 1024,
+		Txs:        [][]byte{goodTx, badTx, kvTx},
+	})
+	require.Equal(t, [][]byte{goodTx, kvTx}, resPrepare.Txs)
+
+	resProcess := kvstore.ProcessProposal(types.RequestProcessProposal{
+		Txs: resPrepare.Txs,
+	})
+	require.True(t, resProcess.IsAccepted())
+
+	resProcess = kvstore.ProcessProposal(types.RequestProcessProposal{
+		Txs: [][]byte{goodTx, badTx},
+	})
+	require.False(t, resProcess.IsAccepted())
+}
+
+func TestVoteExtensions(t *testing.T) {
+	dir := t.TempDir()
+	kvstore := NewPersistentKVStoreApplication(dir)
+	t.Cleanup(func() { require.NoError(t, kvstore.Close()) })
+
+	resExtend := kvstore.ExtendVote(types.RequestExtendVote{Height: 5})
+	require.NotEmpty(t, resExtend.VoteExtension)
+
+	testCases := []struct {
+		name      string
+		height    int64
+		extension []byte
+		ok        bool
+	}{
+		{"matching height", 5, resExtend.VoteExtension, true},
+		{"no extension", 5, nil, true},
+		{"other height", 6, resExtend.VoteExtension, false},
+		{"malformed", 5, append(resExtend.VoteExtension, 0x01), false},
+	}
+	for _, tc := range testCases {
+		res := kvstore.VerifyVoteExtension(types.RequestVerifyVoteExtension{
+			Height:        tc.height,
+			VoteExtension: tc.extension,
+		})
+		require.Equal(t, tc.ok, res.IsOK(), tc.name)
+	}
+}
+
 func makeApplyBlock(
 	t *testing.T,
 	kvstore types.Application,
@@ -194,20 +246,20 @@ func makeApplyBlock(
 	// make and apply block
 	height := int64(heightInt)
 	hash := []byte("foo")
-	header := tmproto.Header{
-		Height: height,
-	}
 
-	kvstore.BeginBlock(types.RequestBeginBlock{Hash: hash, Header: header})
-	for _, tx := range txs {
-		if r := kvstore.DeliverTx(types.RequestDeliverTx{Tx: tx}); r.IsErr() {
+	resFinalizeBlock := kvstore.FinalizeBlock(types.RequestFinalizeBlock{
+		Hash:   hash,
+		Height: height,
+		Txs:    txs,
+	})
+	for _, r := range resFinalizeBlock.TxResults {
+		if r.IsErr() {
 			t.Fatal(r)
 		}
 	}
-	resEndBlock := kvstore.EndBlock(types.RequestEndBlock{Height: header.Height})
 	kvstore.Commit()
 
-	valsEqual(t, diff, resEndBlock.ValidatorUpdates)
+	valsEqual(t, diff, resFinalizeBlock.ValidatorUpdates)
 
 }
 
@@ -324,13 +376,15 @@ func runClientTests(ctx context.Context, t *testing.T, client abciclient.Client)
 }
 
 func testClient(ctx context.Context, t *testing.T, app abciclient.Client, tx []byte, key, value string) {
-	ar, err := app.DeliverTxSync(ctx, types.RequestDeliverTx{Tx: tx})
+	ar, err := app.FinalizeBlockSync(ctx, types.RequestFinalizeBlock{Txs: [][]byte{tx}})
 	require.NoError(t, err)
-	require.False(t, ar.IsErr(), ar)
+	require.Equal(t, 1, len(ar.TxResults))
+	require.False(t, ar.TxResults[0].IsErr())
 	// repeating tx doesn't raise error
-	ar, err = app.DeliverTxSync(ctx, types.RequestDeliverTx{Tx: tx})
+	ar, err = app.FinalizeBlockSync(ctx, types.RequestFinalizeBlock{Txs: [][]byte{tx}})
 	require.NoError(t, err)
-	require.False(t, ar.IsErr(), ar)
+	require.Equal(t, 1, len(ar.TxResults))
+	require.False(t, ar.TxResults[0].IsErr())
 	// commit
 	_, err = app.CommitSync(ctx)
 	require.NoError(t, err)
@@ -3,6 +3,7 @@ package kvstore
 import (
 	"bytes"
 	"encoding/base64"
+	"encoding/binary"
 	"fmt"
 	"strconv"
 	"strings"
@@ -66,20 +67,6 @@ func (app *PersistentKVStoreApplication) Info(req types.RequestInfo) types.Respo
 	return res
 }
 
-// tx is either "val:pubkey!power" or "key=value" or just arbitrary bytes
-func (app *PersistentKVStoreApplication) DeliverTx(req types.RequestDeliverTx) types.ResponseDeliverTx {
-	// if it starts with "val:", update the validator set
-	// format is "val:pubkey!power"
-	if isValidatorTx(req.Tx) {
-		// update validators in the merkle tree
-		// and in app.ValUpdates
-		return app.execValidatorTx(req.Tx)
-	}
-
-	// otherwise, update the key-value store
-	return app.app.DeliverTx(req)
-}
-
 func (app *PersistentKVStoreApplication) CheckTx(req types.RequestCheckTx) types.ResponseCheckTx {
 	return app.app.CheckTx(req)
 }
@@ -119,8 +106,9 @@ func (app *PersistentKVStoreApplication) InitChain(req types.RequestInitChain) t
 	return types.ResponseInitChain{}
 }
 
-// Track the block hash and header information
-func (app *PersistentKVStoreApplication) BeginBlock(req types.RequestBeginBlock) types.ResponseBeginBlock {
+// FinalizeBlock punishes misbehaving validators, executes the transactions of
+// the block and returns the resulting changes to the validator set.
+func (app *PersistentKVStoreApplication) FinalizeBlock(req types.RequestFinalizeBlock) types.ResponseFinalizeBlock {
 	// reset valset changes
 	app.ValUpdates = make([]types.ValidatorUpdate, 0)
 
@@ -142,12 +130,63 @@ func (app *PersistentKVStoreApplication) BeginBlock(req types.RequestBeginBlock)
 		}
 	}
 
-	return types.ResponseBeginBlock{}
+	txs := make([]*types.ResponseDeliverTx, len(req.Txs))
+	for i, tx := range req.Txs {
+		res := app.handleTx(tx)
+		txs[i] = &res
+	}
+
+	return types.ResponseFinalizeBlock{TxResults: txs, ValidatorUpdates: app.ValUpdates}
+}
+
+// PrepareProposal drops malformed validator set change transactions, so that
+// the proposer does not propose a block it would itself reject.
+func (app *PersistentKVStoreApplication) PrepareProposal(
+	req types.RequestPrepareProposal) types.ResponsePrepareProposal {
+	txs := make([][]byte, 0, len(req.Txs))
+	for _, tx := range req.Txs {
+		if isValidatorTx(tx) {
+			if _, _, err := parseValidatorTx(tx); err != nil {
+				continue
+			}
+		}
+		txs = append(txs, tx)
+	}
+	req.Txs = txs
+	return app.app.PrepareProposal(req)
+}
+
+// ProcessProposal rejects proposals that contain malformed validator set
+// change transactions.
+func (app *PersistentKVStoreApplication) ProcessProposal(
+	req types.RequestProcessProposal) types.ResponseProcessProposal {
+	for _, tx := range req.Txs {
+		if isValidatorTx(tx) {
+			if _, _, err := parseValidatorTx(tx); err != nil {
+				return types.ResponseProcessProposal{Status: types.ResponseProcessProposal_REJECT}
+			}
+		}
+	}
+	return types.ResponseProcessProposal{Status: types.ResponseProcessProposal_ACCEPT}
 }
 
-// Update the validator set
-func (app *PersistentKVStoreApplication) EndBlock(req types.RequestEndBlock) types.ResponseEndBlock {
-	return types.ResponseEndBlock{ValidatorUpdates: app.ValUpdates}
+// ExtendVote attaches the height being voted on to this node's precommit.
+func (app *PersistentKVStoreApplication) ExtendVote(
+	req types.RequestExtendVote) types.ResponseExtendVote {
+	return types.ResponseExtendVote{VoteExtension: encodeVoteExtension(req.Height)}
+}
+
+// VerifyVoteExtension rejects vote extensions that do not carry the height
+// being voted on. Validators may omit the extension altogether.
+func (app *PersistentKVStoreApplication) VerifyVoteExtension(
+	req types.RequestVerifyVoteExtension) types.ResponseVerifyVoteExtension {
+	if len(req.VoteExtension) == 0 {
+		return types.ResponseVerifyVoteExtension{Status: types.ResponseVerifyVoteExtension_ACCEPT}
+	}
+	if height, ok := decodeVoteExtension(req.VoteExtension); !ok || height != req.Height {
+		return types.ResponseVerifyVoteExtension{Status: types.ResponseVerifyVoteExtension_REJECT}
+	}
+	return types.ResponseVerifyVoteExtension{Status: types.ResponseVerifyVoteExtension_ACCEPT}
 }
 
 func (app *PersistentKVStoreApplication) ListSnapshots(
@@ -170,6 +209,20 @@ func (app *PersistentKVStoreApplication) ApplySnapshotChunk(
 	return types.ResponseApplySnapshotChunk{Result: types.ResponseApplySnapshotChunk_ABORT}
 }
 
+// tx is either "val:pubkey!power" or "key=value" or just arbitrary bytes
+func (app *PersistentKVStoreApplication) handleTx(tx []byte) types.ResponseDeliverTx {
+	// if it starts with "val:", update the validator set
+	// format is "val:pubkey!power"
+	if isValidatorTx(tx) {
+		// update validators in the merkle tree
+		// and in app.ValUpdates
+		return app.execValidatorTx(tx)
+	}
+
+	// otherwise, update the key-value store
+	return app.app.handleTx(tx)
+}
+
 //---------------------------------------------
 // update validators
 
@@ -210,35 +263,42 @@ func isValidatorTx(tx []byte) bool {
 // format is "val:pubkey!power"
 // pubkey is a base64-encoded 32-byte ed25519 key
 func (app *PersistentKVStoreApplication) execValidatorTx(tx []byte) types.ResponseDeliverTx {
+	pubkey, power, err := parseValidatorTx(tx)
+	if err != nil {
+		return types.ResponseDeliverTx{
+			Code: code.CodeTypeEncodingError,
+			Log:  err.Error()}
+	}
+
+	// update
+	return app.updateValidator(types.UpdateValidator(pubkey, power, ""))
+}
+
+// parseValidatorTx decodes the pubkey and power of a "val:pubkey!power"
+// transaction.
+func parseValidatorTx(tx []byte) ([]byte, int64, error) {
 	tx = tx[len(ValidatorSetChangePrefix):]
 
 	//  get the pubkey and power
 	pubKeyAndPower := strings.Split(string(tx), "!")
 	if len(pubKeyAndPower) != 2 {
-		return types.ResponseDeliverTx{
-			Code: code.CodeTypeEncodingError,
-			Log:  fmt.Sprintf("Expected 'pubkey!power'. Got %v", pubKeyAndPower)}
+		return nil, 0, fmt.Errorf("expected 'pubkey!power', got %v", pubKeyAndPower)
 	}
 	pubkeyS, powerS := pubKeyAndPower[0], pubKeyAndPower[1]
 
 	// decode the pubkey
 	pubkey, err := base64.StdEncoding.DecodeString(pubkeyS)
 	if err != nil {
-		return types.ResponseDeliverTx{
-			Code: code.CodeTypeEncodingError,
-			Log:  fmt.Sprintf("Pubkey (%s) is invalid base64", pubkeyS)}
+		return nil, 0, fmt.Errorf("pubkey (%s) is invalid base64", pubkeyS)
 	}
 
 	// decode the power
 	power, err := strconv.ParseInt(powerS, 10, 64)
 	if err != nil {
-		return types.ResponseDeliverTx{
-			Code: code.CodeTypeEncodingError,
-			Log:  fmt.Sprintf("Power (%s) is not an int", powerS)}
+		return nil, 0, fmt.Errorf("power (%s) is not an int", powerS)
 	}
 
-	// update
-	return app.updateValidator(types.UpdateValidator(pubkey, power, ""))
+	return pubkey, power, nil
 }
 
 // add, update, or remove a validator
@@ -284,3 +344,17 @@ func (app *PersistentKVStoreApplication) updateValidator(v types.ValidatorUpdate
 
 	return types.ResponseDeliverTx{Code: code.CodeTypeOK}
 }
+
+//---------------------------------------------
+// vote extensions
+
+func encodeVoteExtension(height int64) []byte {
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutVarint(buf, height)
+	return buf[:n]
+}
+
+func decodeVoteExtension(ext []byte) (int64, bool) {
+	height, n := binary.Varint(ext)
+	return height, n > 0 && n == len(ext)
+}
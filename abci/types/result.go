@@ -41,6 +41,26 @@ func (r ResponseQuery) IsErr() bool {
 	return r.Code != CodeTypeOK
 }
 
+// IsAccepted returns true if the application accepted the proposal.
+func (r ResponseProcessProposal) IsAccepted() bool {
+	return r.Status == ResponseProcessProposal_ACCEPT
+}
+
+// IsStatusUnknown returns true if the application did not set a status.
+func (r ResponseProcessProposal) IsStatusUnknown() bool {
+	return r.Status == ResponseProcessProposal_UNKNOWN
+}
+
+// IsOK returns true if the application accepted the vote extension.
+func (r ResponseVerifyVoteExtension) IsOK() bool {
+	return r.Status == ResponseVerifyVoteExtension_ACCEPT
+}
+
+// IsStatusUnknown returns true if the application did not set a status.
+func (r ResponseVerifyVoteExtension) IsStatusUnknown() bool {
+	return r.Status == ResponseVerifyVoteExtension_UNKNOWN
+}
+
 //---------------------------------------------------------------------------
 // override JSON marshaling so we emit defaults (ie. disable omitempty)
 
@@ -39,6 +39,7 @@ func main() {
 		chainID          = flag.String("chain-id", "mychain", "chain id")
 		privValKeyPath   = flag.String("priv-key", "", "priv val key file path")
 		privValStatePath = flag.String("priv-state", "", "priv val state file path")
+		passphrasePath   = flag.String("priv-key-passphrase", "", "priv val key passphrase file path")
 		insecure         = flag.Bool("insecure", false, "allow server to run insecurely (no TLS)")
 		certFile         = flag.String("certfile", "", "absolute path to server certificate")
 		keyFile          = flag.String("keyfile", "", "absolute path to server key")
@@ -65,7 +66,13 @@ func main() {
 		"rootCA", *rootCA,
 	)
 
-	pv, err := privval.LoadFilePV(*privValKeyPath, *privValStatePath)
+	passphrase, err := privval.ReadPassphrase(*passphrasePath)
+	if err != nil {
+		fmt.Fprint(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	pv, err := privval.LoadFilePV(*privValKeyPath, *privValStatePath, passphrase)
 	if err != nil {
 		fmt.Fprint(os.Stderr, err)
 		os.Exit(1)
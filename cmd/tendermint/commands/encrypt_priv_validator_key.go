@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	tmos "github.com/tendermint/tendermint/libs/os"
+	"github.com/tendermint/tendermint/privval"
+)
+
+// EncryptPrivValidatorKeyCmd encrypts an existing plaintext private validator
+// key file in place.
+var EncryptPrivValidatorKeyCmd = &cobra.Command{
+	Use:   "encrypt-priv-validator-key",
+	Short: "Encrypt this node's private validator key file in place with a passphrase",
+	Long: `Encrypt this node's private validator key file in place with a passphrase.
+
+The passphrase is read from the file set by priv-validator.key-passphrase-file,
+or else from the ` + privval.PassphraseEnvVar + ` environment variable.
+Set the same passphrase when starting the node to unlock the key.`,
+	RunE: encryptPrivValidatorKey,
+}
+
+func init() {
+	addKeyPassphraseFlag(EncryptPrivValidatorKeyCmd)
+}
+
+func encryptPrivValidatorKey(cmd *cobra.Command, args []string) error {
+	keyFile := config.PrivValidator.KeyFile()
+	if !tmos.FileExists(keyFile) {
+		return fmt.Errorf("private validator file %s does not exist", keyFile)
+	}
+
+	passphrase, err := privval.ReadPassphrase(config.PrivValidator.KeyPassphraseFile())
+	if err != nil {
+		return err
+	}
+	if len(passphrase) == 0 {
+		return fmt.Errorf("no passphrase: set priv-validator.key-passphrase-file or $%s",
+			privval.PassphraseEnvVar)
+	}
+
+	// the state file is left untouched
+	pv, err := privval.LoadFilePVEmptyState(keyFile, config.PrivValidator.StateFile(), nil)
+	if errors.Is(err, privval.ErrKeyEncrypted) {
+		return fmt.Errorf("private validator file %s is already encrypted", keyFile)
+	}
+	if err != nil {
+		return err
+	}
+
+	pv.Key.SetPassphrase(passphrase)
+	if err := pv.Key.Save(); err != nil {
+		return err
+	}
+
+	logger.Info("Encrypted private validator key", "keyFile", keyFile, "address", pv.GetAddress())
+	return nil
+}
@@ -20,7 +20,8 @@ var GenValidatorCmd = &cobra.Command{
 
 func init() {
 	GenValidatorCmd.Flags().StringVar(&keyType, "key", types.ABCIPubKeyTypeEd25519,
-		"Key type to generate privval file with. Options: ed25519, secp256k1")
+		"Key type to generate privval file with. Options: ed25519, secp256k1, bls12381")
+	addKeyPassphraseFlag(GenValidatorCmd)
 }
 
 func genValidator(cmd *cobra.Command, args []string) error {
@@ -29,7 +30,25 @@ func genValidator(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	jsbz, err := tmjson.Marshal(pv)
+	passphrase, err := privval.ReadPassphrase(config.PrivValidator.KeyPassphraseFile())
+	if err != nil {
+		return err
+	}
+
+	var out interface{} = pv
+	if len(passphrase) > 0 {
+		key, err := privval.EncryptFilePVKey(pv.Key, passphrase)
+		if err != nil {
+			return fmt.Errorf("encrypting validator key: %w", err)
+		}
+		// the layout of FilePV, with the key encrypted
+		out = struct {
+			Key           *privval.EncryptedFilePVKey
+			LastSignState privval.FilePVLastSignState
+		}{key, pv.LastSignState}
+	}
+
+	jsbz, err := tmjson.Marshal(out)
 	if err != nil {
 		return fmt.Errorf("validator -> json: %w", err)
 	}
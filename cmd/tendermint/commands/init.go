@@ -31,7 +31,16 @@ var (
 
 func init() {
 	InitFilesCmd.Flags().StringVar(&keyType, "key", types.ABCIPubKeyTypeEd25519,
-		"Key type to generate privval file with. Options: ed25519, secp256k1")
+		"Key type to generate privval file with. Options: ed25519, secp256k1, bls12381")
+	addKeyPassphraseFlag(InitFilesCmd)
+}
+
+// addKeyPassphraseFlag adds the flag of the file containing the passphrase
+// the privval key file is encrypted with.
+func addKeyPassphraseFlag(cmd *cobra.Command) {
+	cmd.Flags().String("priv-validator.key-passphrase-file", config.PrivValidator.KeyPassphrase,
+		"file containing the passphrase of the encrypted privval key file (default: $"+
+			privval.PassphraseEnvVar+")")
 }
 
 func initFiles(cmd *cobra.Command, args []string) error {
@@ -44,16 +53,21 @@ func initFiles(cmd *cobra.Command, args []string) error {
 
 func initFilesWithConfig(ctx context.Context, config *cfg.Config) error {
 	var (
-		pv  *privval.FilePV
-		err error
+		pv         *privval.FilePV
+		passphrase []byte
+		err        error
 	)
 
 	if config.Mode == cfg.ModeValidator {
 		// private validator
 		privValKeyFile := config.PrivValidator.KeyFile()
 		privValStateFile := config.PrivValidator.StateFile()
+		passphrase, err = privval.ReadPassphrase(config.PrivValidator.KeyPassphraseFile())
+		if err != nil {
+			return err
+		}
 		if tmos.FileExists(privValKeyFile) {
-			pv, err = privval.LoadFilePV(privValKeyFile, privValStateFile)
+			pv, err = privval.LoadFilePV(privValKeyFile, privValStateFile, passphrase)
 			if err != nil {
 				return err
 			}
@@ -65,11 +79,12 @@ func initFilesWithConfig(ctx context.Context, config *cfg.Config) error {
 			if err != nil {
 				return err
 			}
+			pv.Key.SetPassphrase(passphrase)
 			if err := pv.Save(); err != nil {
 				return err
 			}
 			logger.Info("Generated private validator", "keyFile", privValKeyFile,
-				"stateFile", privValStateFile)
+				"stateFile", privValStateFile, "encrypted", len(passphrase) > 0)
 		}
 	}
 
@@ -94,9 +109,9 @@ func initFilesWithConfig(ctx context.Context, config *cfg.Config) error {
 			GenesisTime:     tmtime.Now(),
 			ConsensusParams: types.DefaultConsensusParams(),
 		}
-		if keyType == "secp256k1" {
+		if keyType == types.ABCIPubKeyTypeSecp256k1 || keyType == types.ABCIPubKeyTypeBls12381 {
 			genDoc.ConsensusParams.Validator = types.ValidatorParams{
-				PubKeyTypes: []string{types.ABCIPubKeyTypeSecp256k1},
+				PubKeyTypes: []string{keyType},
 			}
 		}
 
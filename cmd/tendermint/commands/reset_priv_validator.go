@@ -24,7 +24,9 @@ var keepAddrBook bool
 func init() {
 	ResetAllCmd.Flags().BoolVar(&keepAddrBook, "keep-addr-book", false, "keep the address book intact")
 	ResetPrivValidatorCmd.Flags().StringVar(&keyType, "key", types.ABCIPubKeyTypeEd25519,
-		"Key type to generate privval file with. Options: ed25519, secp256k1")
+		"Key type to generate privval file with. Options: ed25519, secp256k1, bls12381")
+	addKeyPassphraseFlag(ResetAllCmd)
+	addKeyPassphraseFlag(ResetPrivValidatorCmd)
 }
 
 // ResetPrivValidatorCmd resets the private validator files.
@@ -63,8 +65,12 @@ func ResetAll(dbDir, privValKeyFile, privValStateFile string, logger log.Logger)
 }
 
 func resetFilePV(privValKeyFile, privValStateFile string, logger log.Logger) error {
+	passphrase, err := privval.ReadPassphrase(config.PrivValidator.KeyPassphraseFile())
+	if err != nil {
+		return err
+	}
 	if _, err := os.Stat(privValKeyFile); err == nil {
-		pv, err := privval.LoadFilePVEmptyState(privValKeyFile, privValStateFile)
+		pv, err := privval.LoadFilePVEmptyState(privValKeyFile, privValStateFile, passphrase)
 		if err != nil {
 			return err
 		}
@@ -78,6 +84,7 @@ func resetFilePV(privValKeyFile, privValStateFile string, logger log.Logger) err
 		if err != nil {
 			return err
 		}
+		pv.Key.SetPassphrase(passphrase)
 		if err := pv.Save(); err != nil {
 			return err
 		}
@@ -12,6 +12,7 @@ import (
 	"github.com/spf13/cobra"
 
 	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/node"
 )
 
 var (
@@ -31,7 +32,9 @@ func AddNodeFlags(cmd *cobra.Command) {
 	cmd.Flags().String(
 		"priv-validator-laddr",
 		config.PrivValidator.ListenAddr,
-		"socket address to listen on for connections from external priv-validator process")
+		"socket address to listen on for connections from external priv-validator process, "+
+			"or comma-separated addresses of signers to fail over between")
+	addKeyPassphraseFlag(cmd)
 
 	// node flags
 
@@ -77,6 +80,14 @@ func AddNodeFlags(cmd *cobra.Command) {
 		"consensus.create-empty-blocks-interval",
 		config.Consensus.CreateEmptyBlocksInterval.String(),
 		"the possible interval between empty blocks")
+	cmd.Flags().Int64(
+		"consensus.halt-height",
+		config.Consensus.HaltHeight,
+		"stop participating in consensus and exit after committing this height (0 disables)")
+	cmd.Flags().Int64(
+		"consensus.halt-time",
+		config.Consensus.HaltTime,
+		"stop participating in consensus and exit after committing a block at or after this Unix time (0 disables)")
 
 	addDBFlags(cmd)
 }
@@ -118,8 +129,22 @@ func NewRunNodeCmd(nodeProvider cfg.ServiceProvider) *cobra.Command {
 
 			logger.Info("started node", "node", n.String())
 
-			<-ctx.Done()
-			return nil
+			h, ok := n.(node.Halter)
+			if !ok {
+				<-ctx.Done()
+				return nil
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-h.Halted():
+				// Stop the node so that its stores are closed before we
+				// exit with the distinct halt status code.
+				cancel()
+				n.Wait()
+				return h.HaltError()
+			}
 		},
 	}
 
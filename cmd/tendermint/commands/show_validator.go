@@ -27,13 +27,18 @@ func showValidator(cmd *cobra.Command, args []string) error {
 		err    error
 		bctx   = cmd.Context()
 	)
+	// with several signers, ask the first one
+	pvCfg := *config.PrivValidator
+	if addrs := pvCfg.ListenAddresses(); len(addrs) > 0 {
+		pvCfg.ListenAddr = addrs[0]
+	}
 	//TODO: remove once gRPC is the only supported protocol
-	protocol, _ := tmnet.ProtocolAndAddress(config.PrivValidator.ListenAddr)
+	protocol, _ := tmnet.ProtocolAndAddress(pvCfg.ListenAddr)
 	switch protocol {
 	case "grpc":
 		pvsc, err := tmgrpc.DialRemoteSigner(
 			bctx,
-			config.PrivValidator,
+			&pvCfg,
 			config.ChainID(),
 			logger,
 			config.Instrumentation.Prometheus,
@@ -56,7 +61,12 @@ func showValidator(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("private validator file %s does not exist", keyFilePath)
 		}
 
-		pv, err := privval.LoadFilePV(keyFilePath, config.PrivValidator.StateFile())
+		passphrase, err := privval.ReadPassphrase(config.PrivValidator.KeyPassphraseFile())
+		if err != nil {
+			return err
+		}
+
+		pv, err := privval.LoadFilePV(keyFilePath, config.PrivValidator.StateFile(), passphrase)
 		if err != nil {
 			return err
 		}
@@ -75,7 +75,7 @@ func init() {
 	TestnetFilesCmd.Flags().BoolVar(&randomMonikers, "random-monikers", false,
 		"randomize the moniker for each generated node")
 	TestnetFilesCmd.Flags().StringVar(&keyType, "key", types.ABCIPubKeyTypeEd25519,
-		"Key type to generate privval file with. Options: ed25519, secp256k1")
+		"Key type to generate privval file with. Options: ed25519, secp256k1, bls12381")
 }
 
 // TestnetFilesCmd allows initialisation of files for a Tendermint testnet.
@@ -145,7 +145,11 @@ func testnetFiles(cmd *cobra.Command, args []string) error {
 
 		pvKeyFile := filepath.Join(nodeDir, config.PrivValidator.Key)
 		pvStateFile := filepath.Join(nodeDir, config.PrivValidator.State)
-		pv, err := privval.LoadFilePV(pvKeyFile, pvStateFile)
+		passphrase, err := privval.ReadPassphrase(config.PrivValidator.KeyPassphraseFile())
+		if err != nil {
+			return err
+		}
+		pv, err := privval.LoadFilePV(pvKeyFile, pvStateFile, passphrase)
 		if err != nil {
 			return err
 		}
@@ -194,9 +198,9 @@ func testnetFiles(cmd *cobra.Command, args []string) error {
 		Validators:      genVals,
 		ConsensusParams: types.DefaultConsensusParams(),
 	}
-	if keyType == "secp256k1" {
+	if keyType == types.ABCIPubKeyTypeSecp256k1 || keyType == types.ABCIPubKeyTypeBls12381 {
 		genDoc.ConsensusParams.Validator = types.ValidatorParams{
-			PubKeyTypes: []string{types.ABCIPubKeyTypeSecp256k1},
+			PubKeyTypes: []string{keyType},
 		}
 	}
 
@@ -15,6 +15,7 @@ func main() {
 	rootCmd := cmd.RootCmd
 	rootCmd.AddCommand(
 		cmd.GenValidatorCmd,
+		cmd.EncryptPrivValidatorKeyCmd,
 		cmd.ReIndexEventCmd,
 		cmd.InitFilesCmd,
 		cmd.LightCmd,
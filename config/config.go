@@ -12,6 +12,7 @@ import (
 	tmjson "github.com/tendermint/tendermint/libs/json"
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
+	tmstrings "github.com/tendermint/tendermint/libs/strings"
 	"github.com/tendermint/tendermint/types"
 )
 
@@ -27,6 +28,9 @@ const (
 	ModeFull      = "full"
 	ModeValidator = "validator"
 	ModeSeed      = "seed"
+
+	MempoolGossipPush     = "push"
+	MempoolGossipAnnounce = "announce"
 )
 
 // NOTE: Most of the structs & relevant comments + the
@@ -47,6 +51,8 @@ var (
 	defaultPrivValKeyName   = "priv_validator_key.json"
 	defaultPrivValStateName = "priv_validator_state.json"
 
+	defaultPrivValFailoverStateName = "priv_validator_failover_state.json"
+
 	defaultNodeKeyName = "node_key.json"
 
 	defaultConfigFilePath   = filepath.Join(defaultConfigDir, defaultConfigFileName)
@@ -54,6 +60,8 @@ var (
 	defaultPrivValKeyPath   = filepath.Join(defaultConfigDir, defaultPrivValKeyName)
 	defaultPrivValStatePath = filepath.Join(defaultDataDir, defaultPrivValStateName)
 
+	defaultPrivValFailoverStatePath = filepath.Join(defaultDataDir, defaultPrivValFailoverStateName)
+
 	defaultNodeKeyPath = filepath.Join(defaultConfigDir, defaultNodeKeyName)
 )
 
@@ -142,6 +150,9 @@ func (cfg *Config) ValidateBasic() error {
 	if err := cfg.Instrumentation.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in [instrumentation] section: %w", err)
 	}
+	if err := cfg.PrivValidator.ValidateBasic(); err != nil {
+		return fmt.Errorf("error in [priv-validator] section: %w", err)
+	}
 	return nil
 }
 
@@ -337,10 +348,27 @@ type PrivValidatorConfig struct {
 	// Path to the JSON file containing the last sign state of a validator
 	State string `mapstructure:"state-file"`
 
+	// Path to the file containing the passphrase the private key file is
+	// encrypted with. If empty, the passphrase is read from the
+	// TM_PRIV_VALIDATOR_KEY_PASSPHRASE environment variable, and the key file
+	// is not encrypted if neither is set.
+	KeyPassphrase string `mapstructure:"key-passphrase-file"`
+
 	// TCP or UNIX socket address for Tendermint to listen on for
-	// connections from an external PrivValidator process
+	// connections from an external PrivValidator process.
+	// Several comma-separated addresses of signers holding the same key make
+	// Tendermint fail over between them.
 	ListenAddr string `mapstructure:"laddr"`
 
+	// How long a remote signer must fail to answer before Tendermint considers
+	// it dead, and sends it a request for the same height, round and step to
+	// another signer. Only used with several signer addresses.
+	DeadSignerTimeout time.Duration `mapstructure:"dead-signer-timeout"`
+
+	// Path to the JSON file containing the last sign request sent to one of
+	// several remote signers, and which signer it was sent to.
+	FailoverState string `mapstructure:"failover-state-file"`
+
 	// Client certificate generated while creating needed files for secure connection.
 	// If a remote validator address is provided but no certificate, the connection will be insecure
 	ClientCertificate string `mapstructure:"client-certificate-file"`
@@ -356,9 +384,25 @@ type PrivValidatorConfig struct {
 // for a Tendermint node.
 func DefaultPrivValidatorConfig() *PrivValidatorConfig {
 	return &PrivValidatorConfig{
-		Key:   defaultPrivValKeyPath,
-		State: defaultPrivValStatePath,
+		Key:               defaultPrivValKeyPath,
+		State:             defaultPrivValStatePath,
+		DeadSignerTimeout: 10 * time.Second,
+		FailoverState:     defaultPrivValFailoverStatePath,
+	}
+}
+
+// ListenAddresses returns the addresses of the remote signers.
+func (cfg *PrivValidatorConfig) ListenAddresses() []string {
+	return tmstrings.SplitAndTrimEmpty(cfg.ListenAddr, ",", " ")
+}
+
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *PrivValidatorConfig) ValidateBasic() error {
+	if cfg.DeadSignerTimeout < 0 {
+		return errors.New("dead-signer-timeout can't be negative")
 	}
+	return nil
 }
 
 // ClientKeyFile returns the full path to the priv_validator_key.json file
@@ -386,6 +430,21 @@ func (cfg *PrivValidatorConfig) StateFile() string {
 	return rootify(cfg.State, cfg.RootDir)
 }
 
+// FailoverStateFile returns the full path to the
+// priv_validator_failover_state.json file
+func (cfg *PrivValidatorConfig) FailoverStateFile() string {
+	return rootify(cfg.FailoverState, cfg.RootDir)
+}
+
+// KeyPassphraseFile returns the full path to the file containing the
+// passphrase of the private key file, or an empty string if it is not set.
+func (cfg *PrivValidatorConfig) KeyPassphraseFile() string {
+	if cfg.KeyPassphrase == "" {
+		return ""
+	}
+	return rootify(cfg.KeyPassphrase, cfg.RootDir)
+}
+
 func (cfg *PrivValidatorConfig) AreSecurityOptionsPresent() bool {
 	switch {
 	case cfg.RootCA == "":
@@ -454,6 +513,11 @@ type RPCConfig struct {
 	// Maximum size of request header, in bytes
 	MaxHeaderBytes int `mapstructure:"max-header-bytes"`
 
+	// Number of most recent commits for which the signing history of every
+	// validator is indexed and served by /signing_info.
+	// 0 - disables the endpoint.
+	SigningWindow int64 `mapstructure:"signing-window"`
+
 	// The path to a file containing certificate that is used to create the HTTPS server.
 	// Might be either absolute path or path related to Tendermint's config directory.
 	//
@@ -494,6 +558,8 @@ func DefaultRPCConfig() *RPCConfig {
 		MaxBodyBytes:   int64(1000000), // 1MB
 		MaxHeaderBytes: 1 << 20,        // same as the net/http default
 
+		SigningWindow: 1000,
+
 		TLSCertFile: "",
 		TLSKeyFile:  "",
 	}
@@ -528,6 +594,9 @@ func (cfg *RPCConfig) ValidateBasic() error {
 	if cfg.MaxHeaderBytes < 0 {
 		return errors.New("max-header-bytes can't be negative")
 	}
+	if cfg.SigningWindow < 0 {
+		return errors.New("signing-window can't be negative")
+	}
 	return nil
 }
 
@@ -696,6 +765,13 @@ type MempoolConfig struct {
 	Recheck   bool   `mapstructure:"recheck"`
 	Broadcast bool   `mapstructure:"broadcast"`
 
+	// GossipMode defines how transactions are broadcast to peers:
+	//   - "push": every transaction is sent in full to every peer.
+	//   - "announce": the keys of transactions are announced to peers, which
+	//     request the transactions they don't have. Transactions are still
+	//     pushed in full to peers that don't support announcements.
+	GossipMode string `mapstructure:"gossip-mode"`
+
 	// Maximum number of transactions in the mempool
 	Size int `mapstructure:"size"`
 
@@ -736,13 +812,28 @@ type MempoolConfig struct {
 	// has existed in the mempool at least TTLNumBlocks number of blocks or if
 	// it's insertion time into the mempool is beyond TTLDuration.
 	TTLNumBlocks int64 `mapstructure:"ttl-num-blocks"`
+
+	// ReplacePriorityBump defines the minimum amount by which the priority of a
+	// transaction must exceed the priority of a pending transaction with the
+	// same sender and nonce in order to replace it.
+	ReplacePriorityBump int64 `mapstructure:"replace-priority-bump"`
+
+	// Persist, if true, saves the transactions in the mempool to the mempool
+	// database on shutdown and every PersistInterval, and loads them back on
+	// startup.
+	Persist bool `mapstructure:"persist"`
+
+	// PersistInterval defines how often the transactions in the mempool are
+	// saved when Persist is enabled. If zero, they are only saved on shutdown.
+	PersistInterval time.Duration `mapstructure:"persist-interval"`
 }
 
 // DefaultMempoolConfig returns a default configuration for the Tendermint mempool.
 func DefaultMempoolConfig() *MempoolConfig {
 	return &MempoolConfig{
-		Recheck:   true,
-		Broadcast: true,
+		Recheck:    true,
+		Broadcast:  true,
+		GossipMode: MempoolGossipPush,
 		// Each signature verification takes .5ms, Size reduced until we implement
 		// ABCI Recheck
 		Size:         5000,
@@ -751,6 +842,11 @@ func DefaultMempoolConfig() *MempoolConfig {
 		MaxTxBytes:   1024 * 1024, // 1MB
 		TTLDuration:  0 * time.Second,
 		TTLNumBlocks: 0,
+
+		ReplacePriorityBump: 1,
+
+		Persist:         true,
+		PersistInterval: 1 * time.Minute,
 	}
 }
 
@@ -764,6 +860,11 @@ func TestMempoolConfig() *MempoolConfig {
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *MempoolConfig) ValidateBasic() error {
+	switch cfg.GossipMode {
+	case MempoolGossipPush, MempoolGossipAnnounce:
+	default:
+		return fmt.Errorf("unknown gossip-mode: %q", cfg.GossipMode)
+	}
 	if cfg.Size < 0 {
 		return errors.New("size can't be negative")
 	}
@@ -782,6 +883,12 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 	if cfg.TTLNumBlocks < 0 {
 		return errors.New("ttl-num-blocks can't be negative")
 	}
+	if cfg.ReplacePriorityBump <= 0 {
+		return errors.New("replace-priority-bump must be positive")
+	}
+	if cfg.PersistInterval < 0 {
+		return errors.New("persist-interval can't be negative")
+	}
 
 	return nil
 }
@@ -919,7 +1026,11 @@ type ConsensusConfig struct {
 	WalPath string `mapstructure:"wal-file"`
 	walFile string // overrides WalPath if set
 
-	// TODO: remove timeout configs, these should be global not local
+	// The consensus timeouts are set by the TimeoutParams of the consensus
+	// parameters. The timeouts below, when non-zero, override them locally.
+	// They are meant for testnets; validators of a network should not set
+	// different values.
+
 	// How long we wait for a proposal block before prevoting nil
 	TimeoutPropose time.Duration `mapstructure:"timeout-propose"`
 	// How much timeout-propose increases with each round
@@ -937,7 +1048,8 @@ type ConsensusConfig struct {
 	// though we already have +2/3).
 	TimeoutCommit time.Duration `mapstructure:"timeout-commit"`
 
-	// Make progress as soon as we have all the precommits (as if TimeoutCommit = 0)
+	// Make progress as soon as we have all the precommits (as if TimeoutCommit = 0),
+	// even if the consensus parameters do not bypass the commit timeout
 	SkipTimeoutCommit bool `mapstructure:"skip-timeout-commit"`
 
 	// EmptyBlocks mode and possible interval between empty blocks
@@ -949,25 +1061,44 @@ type ConsensusConfig struct {
 	PeerQueryMaj23SleepDuration time.Duration `mapstructure:"peer-query-maj23-sleep-duration"`
 
 	DoubleSignCheckHeight int64 `mapstructure:"double-sign-check-height"`
+
+	// HaltHeight, when non-zero, makes the node stop participating in
+	// consensus once the block at this height is committed, and exit. The
+	// halt-height consensus parameter has the same effect network-wide; the
+	// lower of the two wins.
+	HaltHeight int64 `mapstructure:"halt-height"`
+	// HaltTime, when non-zero, makes the node stop participating in
+	// consensus once it commits a block whose time is at or after this
+	// Unix time (in seconds), and exit.
+	HaltTime int64 `mapstructure:"halt-time"`
+
+	// BlockPartParity is the number of Reed-Solomon parity parts added to the
+	// parts of the blocks we propose, as a percentage of their data parts.
+	// Any set of as many parts as there are data parts rebuilds a block. Zero
+	// disables erasure coding.
+	BlockPartParity int `mapstructure:"block-part-parity"`
 }
 
 // DefaultConsensusConfig returns a default configuration for the consensus service
 func DefaultConsensusConfig() *ConsensusConfig {
 	return &ConsensusConfig{
 		WalPath:                     filepath.Join(defaultDataDir, "cs.wal", "wal"),
-		TimeoutPropose:              3000 * time.Millisecond,
-		TimeoutProposeDelta:         500 * time.Millisecond,
-		TimeoutPrevote:              1000 * time.Millisecond,
-		TimeoutPrevoteDelta:         500 * time.Millisecond,
-		TimeoutPrecommit:            1000 * time.Millisecond,
-		TimeoutPrecommitDelta:       500 * time.Millisecond,
-		TimeoutCommit:               1000 * time.Millisecond,
+		TimeoutPropose:              0,
+		TimeoutProposeDelta:         0,
+		TimeoutPrevote:              0,
+		TimeoutPrevoteDelta:         0,
+		TimeoutPrecommit:            0,
+		TimeoutPrecommitDelta:       0,
+		TimeoutCommit:               0,
 		SkipTimeoutCommit:           false,
 		CreateEmptyBlocks:           true,
 		CreateEmptyBlocksInterval:   0 * time.Second,
 		PeerGossipSleepDuration:     100 * time.Millisecond,
 		PeerQueryMaj23SleepDuration: 2000 * time.Millisecond,
 		DoubleSignCheckHeight:       int64(0),
+		HaltHeight:                  0,
+		HaltTime:                    0,
+		BlockPartParity:             0,
 	}
 }
 
@@ -993,33 +1124,6 @@ func (cfg *ConsensusConfig) WaitForTxs() bool {
 	return !cfg.CreateEmptyBlocks || cfg.CreateEmptyBlocksInterval > 0
 }
 
-// Propose returns the amount of time to wait for a proposal
-func (cfg *ConsensusConfig) Propose(round int32) time.Duration {
-	return time.Duration(
-		cfg.TimeoutPropose.Nanoseconds()+cfg.TimeoutProposeDelta.Nanoseconds()*int64(round),
-	) * time.Nanosecond
-}
-
-// Prevote returns the amount of time to wait for straggler votes after receiving any +2/3 prevotes
-func (cfg *ConsensusConfig) Prevote(round int32) time.Duration {
-	return time.Duration(
-		cfg.TimeoutPrevote.Nanoseconds()+cfg.TimeoutPrevoteDelta.Nanoseconds()*int64(round),
-	) * time.Nanosecond
-}
-
-// Precommit returns the amount of time to wait for straggler votes after receiving any +2/3 precommits
-func (cfg *ConsensusConfig) Precommit(round int32) time.Duration {
-	return time.Duration(
-		cfg.TimeoutPrecommit.Nanoseconds()+cfg.TimeoutPrecommitDelta.Nanoseconds()*int64(round),
-	) * time.Nanosecond
-}
-
-// Commit returns the amount of time to wait for straggler votes after receiving +2/3 precommits
-// for a single block (ie. a commit).
-func (cfg *ConsensusConfig) Commit(t time.Time) time.Time {
-	return t.Add(cfg.TimeoutCommit)
-}
-
 // WalFile returns the full path to the write-ahead log file
 func (cfg *ConsensusConfig) WalFile() string {
 	if cfg.walFile != "" {
@@ -1069,6 +1173,15 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.DoubleSignCheckHeight < 0 {
 		return errors.New("double-sign-check-height can't be negative")
 	}
+	if cfg.HaltHeight < 0 {
+		return errors.New("halt-height can't be negative")
+	}
+	if cfg.HaltTime < 0 {
+		return errors.New("halt-time can't be negative")
+	}
+	if cfg.BlockPartParity < 0 || cfg.BlockPartParity > 100 {
+		return errors.New("block-part-parity must be between 0 and 100")
+	}
 	return nil
 }
 
@@ -69,6 +69,7 @@ func TestRPCConfigValidateBasic(t *testing.T) {
 		"TimeoutBroadcastTxCommit",
 		"MaxBodyBytes",
 		"MaxHeaderBytes",
+		"SigningWindow",
 	}
 
 	for _, fieldName := range fieldsToTest {
@@ -94,6 +95,13 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		assert.Error(t, cfg.ValidateBasic())
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(0)
 	}
+
+	cfg.ReplacePriorityBump = 0
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.ReplacePriorityBump = 1
+
+	cfg.GossipMode = "gossip"
+	assert.Error(t, cfg.ValidateBasic())
 }
 
 func TestStateSyncConfigValidateBasic(t *testing.T) {
@@ -125,6 +133,11 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 		"PeerQueryMaj23SleepDuration":          {func(c *ConsensusConfig) { c.PeerQueryMaj23SleepDuration = time.Second }, false},
 		"PeerQueryMaj23SleepDuration negative": {func(c *ConsensusConfig) { c.PeerQueryMaj23SleepDuration = -1 }, true},
 		"DoubleSignCheckHeight negative":       {func(c *ConsensusConfig) { c.DoubleSignCheckHeight = -1 }, true},
+		"HaltHeight negative":                  {func(c *ConsensusConfig) { c.HaltHeight = -1 }, true},
+		"HaltTime negative":                    {func(c *ConsensusConfig) { c.HaltTime = -1 }, true},
+		"BlockPartParity":                      {func(c *ConsensusConfig) { c.BlockPartParity = 50 }, false},
+		"BlockPartParity negative":             {func(c *ConsensusConfig) { c.BlockPartParity = -1 }, true},
+		"BlockPartParity too big":              {func(c *ConsensusConfig) { c.BlockPartParity = 101 }, true},
 	}
 	for desc, tc := range testcases {
 		tc := tc // appease linter
@@ -159,11 +159,27 @@ key-file = "{{ js .PrivValidator.Key }}"
 # Path to the JSON file containing the last sign state of a validator
 state-file = "{{ js .PrivValidator.State }}"
 
+# Path to the file containing the passphrase the private key file is encrypted with.
+# If empty, the passphrase is read from the TM_PRIV_VALIDATOR_KEY_PASSPHRASE
+# environment variable, and the key file is not encrypted if neither is set.
+key-passphrase-file = "{{ js .PrivValidator.KeyPassphrase }}"
+
 # TCP or UNIX socket address for Tendermint to listen on for
 # connections from an external PrivValidator process
 # when the listenAddr is prefixed with grpc instead of tcp it will use the gRPC Client
+# Several comma-separated addresses of signers holding the same key make
+# Tendermint fail over between them
 laddr = "{{ .PrivValidator.ListenAddr }}"
 
+# How long a remote signer must fail to answer before it is considered dead,
+# and a request for the same height, round and step is sent to another signer.
+# Only used with several signer addresses
+dead-signer-timeout = "{{ .PrivValidator.DeadSignerTimeout }}"
+
+# Path to the JSON file containing the last sign request sent to one of
+# several signers, and which signer it was sent to
+failover-state-file = "{{ js .PrivValidator.FailoverState }}"
+
 # Path to the client certificate generated while creating needed files for secure connection.
 # If a remote validator address is provided but no certificate, the connection will be insecure
 client-certificate-file = "{{ js .PrivValidator.ClientCertificate }}"
@@ -231,6 +247,11 @@ max-body-bytes = {{ .RPC.MaxBodyBytes }}
 # Maximum size of request header, in bytes
 max-header-bytes = {{ .RPC.MaxHeaderBytes }}
 
+# Number of most recent commits for which the signing history of every
+# validator is indexed and served by /signing_info.
+# 0 - disables the endpoint.
+signing-window = {{ .RPC.SigningWindow }}
+
 # The path to a file containing certificate that is used to create the HTTPS server.
 # Might be either absolute path or path related to Tendermint's config directory.
 # If the certificate is signed by a certificate authority,
@@ -330,6 +351,13 @@ recv-rate = {{ .P2P.RecvRate }}
 recheck = {{ .Mempool.Recheck }}
 broadcast = {{ .Mempool.Broadcast }}
 
+# gossip-mode defines how transactions are broadcast to peers:
+#   - "push": every transaction is sent in full to every peer.
+#   - "announce": the keys of transactions are announced to peers, which
+#     request the transactions they don't have. Transactions are still pushed
+#     in full to peers that don't support announcements.
+gossip-mode = "{{ .Mempool.GossipMode }}"
+
 # Maximum number of transactions in the mempool
 size = {{ .Mempool.Size }}
 
@@ -371,6 +399,22 @@ ttl-duration = "{{ .Mempool.TTLDuration }}"
 # it's insertion time into the mempool is beyond ttl-duration.
 ttl-num-blocks = {{ .Mempool.TTLNumBlocks }}
 
+# replace-priority-bump defines the minimum amount by which the priority of a
+# transaction must exceed the priority of a pending transaction with the same
+# sender and nonce in order to replace it. This keeps users from churning the
+# mempool with replacements that barely raise the priority.
+replace-priority-bump = {{ .Mempool.ReplacePriorityBump }}
+
+# persist, if true, saves the transactions in the mempool to the mempool
+# database, under db-dir, on shutdown and every persist-interval. They are
+# loaded back through CheckTx on startup, keeping their original TTLs, except
+# for the transactions committed in the meantime.
+persist = {{ .Mempool.Persist }}
+
+# persist-interval defines how often the transactions in the mempool are saved
+# when persist is enabled. If zero, they are only saved on shutdown.
+persist-interval = "{{ .Mempool.PersistInterval }}"
+
 #######################################################
 ###         State Sync Configuration Options        ###
 #######################################################
@@ -422,6 +466,10 @@ fetchers = "{{ .StateSync.Fetchers }}"
 
 wal-file = "{{ js .Consensus.WalPath }}"
 
+# The consensus timeouts are set by the timeout consensus parameters of the
+# network. The timeouts below, when not "0s", override them on this node.
+# They are meant for testnets.
+
 # How long we wait for a proposal block before prevoting nil
 timeout-propose = "{{ .Consensus.TimeoutPropose }}"
 # How much timeout-propose increases with each round
@@ -445,7 +493,24 @@ timeout-commit = "{{ .Consensus.TimeoutCommit }}"
 # So, validators should stop the state machine, wait for some blocks, and then restart the state machine to avoid panic.
 double-sign-check-height = {{ .Consensus.DoubleSignCheckHeight }}
 
-# Make progress as soon as we have all the precommits (as if TimeoutCommit = 0)
+# When non-zero, the node stops participating in consensus once the block at
+# this height is committed, flushes its WAL and stores, and exits with a
+# distinct status code so that an upgraded binary can take over.
+# The block.halt_height consensus parameter has the same effect network-wide.
+halt-height = {{ .Consensus.HaltHeight }}
+
+# When non-zero, the node halts as above once it commits a block whose time is
+# at or after this Unix time (in seconds).
+halt-time = {{ .Consensus.HaltTime }}
+
+# The number of Reed-Solomon parity parts added to the parts of the blocks this
+# node proposes, as a percentage of their data parts. Peers rebuild a block from
+# any set of as many parts as it has data parts, so that they need not wait for
+# a missing part. 0 disables erasure coding.
+block-part-parity = {{ .Consensus.BlockPartParity }}
+
+# Make progress as soon as we have all the precommits (as if TimeoutCommit = 0),
+# even if the consensus parameters do not bypass the commit timeout
 skip-timeout-commit = {{ .Consensus.SkipTimeoutCommit }}
 
 # EmptyBlocks mode and possible interval between empty blocks
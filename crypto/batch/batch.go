@@ -3,11 +3,12 @@ package batch
 import (
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/crypto/sr25519"
 )
 
 // CreateBatchVerifier checks if a key type implements the batch verifier interface.
-// Currently only ed25519 & sr25519 supports batch verification.
+// Currently only ed25519, sr25519 & secp256k1 support batch verification.
 func CreateBatchVerifier(pk crypto.PubKey) (crypto.BatchVerifier, bool) {
 
 	switch pk.Type() {
@@ -15,6 +16,8 @@ func CreateBatchVerifier(pk crypto.PubKey) (crypto.BatchVerifier, bool) {
 		return ed25519.NewBatchVerifier(), true
 	case sr25519.KeyType:
 		return sr25519.NewBatchVerifier(), true
+	case secp256k1.KeyType:
+		return secp256k1.NewBatchVerifier(), true
 	}
 
 	// case where the key does not support batch verification
@@ -25,7 +28,7 @@ func CreateBatchVerifier(pk crypto.PubKey) (crypto.BatchVerifier, bool) {
 // interface.
 func SupportsBatchVerifier(pk crypto.PubKey) bool {
 	switch pk.Type() {
-	case ed25519.KeyType, sr25519.KeyType:
+	case ed25519.KeyType, sr25519.KeyType, secp256k1.KeyType:
 		return true
 	}
 
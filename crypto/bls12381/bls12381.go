@@ -0,0 +1,278 @@
+package bls12381
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	bls "github.com/kilic/bls12-381"
+	"golang.org/x/crypto/hkdf"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+)
+
+//-------------------------------------
+
+var (
+	_ crypto.PrivKey = PrivKey{}
+
+	// order is the order r of the G1 and G2 subgroups, which private keys
+	// are scalars of.
+	order, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+
+	// dst is the domain separation tag of the ciphersuite. Messages are
+	// augmented with the public key of the signer before they are hashed,
+	// which makes aggregates of signatures of identical messages safe
+	// without proofs of possession of the keys.
+	dst = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_AUG_")
+)
+
+const (
+	PrivKeyName = "tendermint/PrivKeyBls12381"
+	PubKeyName  = "tendermint/PubKeyBls12381"
+	// PrivKeySize is the size, in bytes, of a private key, which is a
+	// big-endian scalar.
+	PrivKeySize = 32
+	// PubKeySize is the size, in bytes, of a public key, which is a
+	// compressed G1 point.
+	PubKeySize = 48
+	// SignatureSize is the size, in bytes, of a signature, which is a
+	// compressed G2 point. An aggregated signature has the same size.
+	SignatureSize = 96
+
+	KeyType = "bls12381"
+
+	// keyGenSalt is the salt of the key generation of
+	// draft-irtf-cfrg-bls-signature.
+	keyGenSalt = "BLS-SIG-KEYGEN-SALT-"
+)
+
+func init() {
+	tmjson.RegisterType(PubKey{}, PubKeyName)
+	tmjson.RegisterType(PrivKey{}, PrivKeyName)
+}
+
+// PrivKey implements crypto.PrivKey.
+type PrivKey []byte
+
+// Bytes returns the privkey byte format.
+func (privKey PrivKey) Bytes() []byte {
+	return []byte(privKey)
+}
+
+// Sign produces a signature on the provided message.
+func (privKey PrivKey) Sign(msg []byte) ([]byte, error) {
+	sk, err := privKey.scalar()
+	if err != nil {
+		return nil, err
+	}
+	pubKey := privKey.PubKey().(PubKey)
+
+	g2 := bls.NewG2()
+	h, err := g2.HashToCurve(augment(pubKey, msg), dst)
+	if err != nil {
+		return nil, err
+	}
+	return g2.ToCompressed(g2.MulScalarBig(g2.New(), h, sk)), nil
+}
+
+// PubKey gets the corresponding public key from the private key.
+//
+// Panics if the private key is not a valid scalar.
+func (privKey PrivKey) PubKey() crypto.PubKey {
+	sk, err := privKey.scalar()
+	if err != nil {
+		panic(err)
+	}
+	g1 := bls.NewG1()
+	return PubKey(g1.ToCompressed(g1.MulScalarBig(g1.New(), g1.One(), sk)))
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey PrivKey) Equals(other crypto.PrivKey) bool {
+	if otherBls, ok := other.(PrivKey); ok {
+		return subtle.ConstantTimeCompare(privKey[:], otherBls[:]) == 1
+	}
+
+	return false
+}
+
+func (privKey PrivKey) Type() string {
+	return KeyType
+}
+
+// scalar returns the private key as a scalar, checking that it is neither
+// zero nor out of range.
+func (privKey PrivKey) scalar() (*big.Int, error) {
+	if len(privKey) != PrivKeySize {
+		return nil, fmt.Errorf("private key size is incorrect; expected: %d, got %d", PrivKeySize, len(privKey))
+	}
+	sk := new(big.Int).SetBytes(privKey)
+	if sk.Sign() == 0 || sk.Cmp(order) >= 0 {
+		return nil, errors.New("private key is out of range")
+	}
+	return sk, nil
+}
+
+// GenPrivKey generates a new BLS12-381 private key.
+// It uses OS randomness to generate the private key.
+func GenPrivKey() PrivKey {
+	return genPrivKey(crypto.CReader())
+}
+
+// genPrivKey generates a new BLS12-381 private key using the provided reader.
+func genPrivKey(rand io.Reader) PrivKey {
+	ikm := make([]byte, 32)
+	if _, err := io.ReadFull(rand, ikm); err != nil {
+		panic(err)
+	}
+	return GenPrivKeyFromSecret(ikm)
+}
+
+// GenPrivKeyFromSecret derives the private key from the secret with the key
+// generation of draft-irtf-cfrg-bls-signature, which hashes it with HKDF.
+// NOTE: secret should be the output of a KDF like bcrypt,
+// if it's derived from user input.
+func GenPrivKeyFromSecret(secret []byte) PrivKey {
+	salt := []byte(keyGenSalt)
+	ikm := append(append([]byte{}, secret...), 0)
+	info := []byte{0, 48}
+	for {
+		salt = crypto.Sha256(salt)
+		okm := make([]byte, 48)
+		if _, err := io.ReadFull(hkdf.New(sha256.New, ikm, salt, info), okm); err != nil {
+			panic(err)
+		}
+		sk := new(big.Int).Mod(new(big.Int).SetBytes(okm), order)
+		if sk.Sign() != 0 {
+			return PrivKey(sk.FillBytes(make([]byte, PrivKeySize)))
+		}
+	}
+}
+
+//-------------------------------------
+
+var _ crypto.PubKey = PubKey{}
+
+// PubKey implements crypto.PubKey for BLS signatures over BLS12-381.
+type PubKey []byte
+
+// Address is the SHA256-20 of the raw pubkey bytes.
+func (pubKey PubKey) Address() crypto.Address {
+	if len(pubKey) != PubKeySize {
+		panic("pubkey is incorrect size")
+	}
+	return crypto.Address(tmhash.SumTruncated(pubKey))
+}
+
+// Bytes returns the PubKey byte format.
+func (pubKey PubKey) Bytes() []byte {
+	return []byte(pubKey)
+}
+
+func (pubKey PubKey) VerifySignature(msg []byte, sig []byte) bool {
+	return VerifyAggregateSignature([]crypto.PubKey{pubKey}, [][]byte{msg}, sig)
+}
+
+func (pubKey PubKey) String() string {
+	return fmt.Sprintf("PubKeyBls12381{%X}", []byte(pubKey))
+}
+
+func (pubKey PubKey) Type() string {
+	return KeyType
+}
+
+func (pubKey PubKey) Equals(other crypto.PubKey) bool {
+	if otherBls, ok := other.(PubKey); ok {
+		return bytes.Equal(pubKey[:], otherBls[:])
+	}
+
+	return false
+}
+
+// point returns the public key as a G1 point, checking that it is in the
+// subgroup and not the identity.
+func (pubKey PubKey) point(g1 *bls.G1) (*bls.PointG1, error) {
+	if len(pubKey) != PubKeySize {
+		return nil, fmt.Errorf("pubkey size is incorrect; expected: %d, got %d", PubKeySize, len(pubKey))
+	}
+	p, err := g1.FromCompressed(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	if g1.IsZero(p) {
+		return nil, errors.New("pubkey is the identity")
+	}
+	return p, nil
+}
+
+//-------------------------------------
+
+// AggregateSignatures aggregates signatures into a single signature, of
+// the same size, that VerifyAggregateSignature verifies against the keys and
+// messages of all of them.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signatures to aggregate")
+	}
+
+	g2 := bls.NewG2()
+	agg := g2.Zero()
+	for i, sig := range sigs {
+		if len(sig) != SignatureSize {
+			return nil, fmt.Errorf("signature #%d size is incorrect; expected: %d, got %d", i, SignatureSize, len(sig))
+		}
+		p, err := g2.FromCompressed(sig)
+		if err != nil {
+			return nil, fmt.Errorf("signature #%d: %w", i, err)
+		}
+		g2.Add(agg, agg, p)
+	}
+	return g2.ToCompressed(agg), nil
+}
+
+// VerifyAggregateSignature verifies an aggregated signature of msgs, each
+// signed with the private key of the public key at the same index. All of
+// the public keys must be BLS12-381 keys.
+func VerifyAggregateSignature(pubKeys []crypto.PubKey, msgs [][]byte, sig []byte) bool {
+	if len(pubKeys) == 0 || len(pubKeys) != len(msgs) || len(sig) != SignatureSize {
+		return false
+	}
+
+	g1, g2 := bls.NewG1(), bls.NewG2()
+	s, err := g2.FromCompressed(sig)
+	if err != nil {
+		return false
+	}
+
+	engine := bls.NewEngine()
+	for i, key := range pubKeys {
+		pubKey, ok := key.(PubKey)
+		if !ok {
+			return false
+		}
+		p, err := pubKey.point(g1)
+		if err != nil {
+			return false
+		}
+		h, err := g2.HashToCurve(augment(pubKey, msgs[i]), dst)
+		if err != nil {
+			return false
+		}
+		engine.AddPair(p, h)
+	}
+	engine.AddPairInv(g1.One(), s)
+	return engine.Check()
+}
+
+// augment prefixes the message with the public key of its signer.
+func augment(pubKey PubKey, msg []byte) []byte {
+	return append(append(make([]byte, 0, len(pubKey)+len(msg)), pubKey...), msg...)
+}
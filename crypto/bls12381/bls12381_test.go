@@ -0,0 +1,91 @@
+package bls12381_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func TestSignAndValidateBls12381(t *testing.T) {
+	privKey := bls12381.GenPrivKey()
+	pubKey := privKey.PubKey()
+	require.Len(t, pubKey.Bytes(), bls12381.PubKeySize)
+
+	msg := crypto.CRandBytes(128)
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, bls12381.SignatureSize)
+
+	// Test the signature
+	assert.True(t, pubKey.VerifySignature(msg, sig))
+	assert.False(t, bls12381.GenPrivKey().PubKey().VerifySignature(msg, sig))
+	assert.False(t, pubKey.VerifySignature(msg[1:], sig))
+
+	// Mutate the signature, just one bit.
+	sig[7] ^= byte(0x01)
+
+	assert.False(t, pubKey.VerifySignature(msg, sig))
+}
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	secret := []byte("secret")
+	privKey := bls12381.GenPrivKeyFromSecret(secret)
+	require.Len(t, privKey.Bytes(), bls12381.PrivKeySize)
+	assert.True(t, privKey.Equals(bls12381.GenPrivKeyFromSecret(secret)))
+	assert.False(t, privKey.Equals(bls12381.GenPrivKeyFromSecret([]byte("other"))))
+}
+
+func TestInvalidPrivKey(t *testing.T) {
+	_, err := bls12381.PrivKey(make([]byte, bls12381.PrivKeySize)).Sign([]byte("msg"))
+	assert.Error(t, err)
+
+	outOfRange := make([]byte, bls12381.PrivKeySize)
+	for i := range outOfRange {
+		outOfRange[i] = 0xff
+	}
+	_, err = bls12381.PrivKey(outOfRange).Sign([]byte("msg"))
+	assert.Error(t, err)
+}
+
+func TestAggregateSignatures(t *testing.T) {
+	const n = 5
+	pubKeys := make([]crypto.PubKey, n)
+	msgs := make([][]byte, n)
+	sigs := make([][]byte, n)
+	for i := 0; i < n; i++ {
+		privKey := bls12381.GenPrivKey()
+		pubKeys[i] = privKey.PubKey()
+		// the same message may be signed more than once
+		msgs[i] = []byte{byte(i % 2)}
+
+		var err error
+		sigs[i], err = privKey.Sign(msgs[i])
+		require.NoError(t, err)
+	}
+
+	agg, err := bls12381.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	require.Len(t, agg, bls12381.SignatureSize)
+	assert.True(t, bls12381.VerifyAggregateSignature(pubKeys, msgs, agg))
+
+	// any missing or wrong key, message or signature fails verification
+	assert.False(t, bls12381.VerifyAggregateSignature(pubKeys[1:], msgs[1:], agg))
+	assert.False(t, bls12381.VerifyAggregateSignature(pubKeys, append([][]byte{{2}}, msgs[1:]...), agg))
+	partial, err := bls12381.AggregateSignatures(sigs[1:])
+	require.NoError(t, err)
+	assert.False(t, bls12381.VerifyAggregateSignature(pubKeys, msgs, partial))
+	assert.True(t, bls12381.VerifyAggregateSignature(pubKeys[1:], msgs[1:], partial))
+
+	wrongKeys := append([]crypto.PubKey{ed25519.GenPrivKey().PubKey()}, pubKeys[1:]...)
+	assert.False(t, bls12381.VerifyAggregateSignature(wrongKeys, msgs, agg))
+
+	_, err = bls12381.AggregateSignatures(nil)
+	assert.Error(t, err)
+	_, err = bls12381.AggregateSignatures([][]byte{sigs[0][1:]})
+	assert.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/crypto/sr25519"
@@ -39,6 +40,12 @@ func PubKeyToProto(k crypto.PubKey) (cryptoproto.PublicKey, error) {
 				Sr25519: k,
 			},
 		}
+	case bls12381.PubKey:
+		kp = cryptoproto.PublicKey{
+			Sum: &cryptoproto.PublicKey_Bls12381{
+				Bls12381: k,
+			},
+		}
 	default:
 		return kp, fmt.Errorf("toproto: key type %v is not supported", k)
 	}
@@ -72,6 +79,14 @@ func PubKeyFromProto(k cryptoproto.PublicKey) (crypto.PubKey, error) {
 		pk := make(sr25519.PubKey, sr25519.PubKeySize)
 		copy(pk, k.Sr25519)
 		return pk, nil
+	case *cryptoproto.PublicKey_Bls12381:
+		if len(k.Bls12381) != bls12381.PubKeySize {
+			return nil, fmt.Errorf("invalid size for PubKeyBls12381. Got %d, expected %d",
+				len(k.Bls12381), bls12381.PubKeySize)
+		}
+		pk := make(bls12381.PubKey, bls12381.PubKeySize)
+		copy(pk, k.Bls12381)
+		return pk, nil
 	default:
 		return nil, fmt.Errorf("fromproto: key type %v is not supported", k)
 	}
@@ -0,0 +1,238 @@
+package merkle
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
+)
+
+// MultiProof represents a Merkle proof of several leaves of a tree at once.
+// The inner hashes that the proofs of the individual leaves would have in
+// common are only included once: Aunts holds the hashes of the largest
+// subtrees that don't contain any of the proven leaves, in depth-first order.
+// NOTE: Like Proof, a MultiProof includes the leaf hashes but excludes the
+// root hash.
+type MultiProof struct {
+	Total      int64    `json:"total"`       // Total number of items.
+	Indices    []int64  `json:"indices"`     // Indices of items to prove, in increasing order.
+	LeafHashes [][]byte `json:"leaf_hashes"` // Hashes of item values, in the order of Indices.
+	Aunts      [][]byte `json:"aunts"`       // Hashes of the subtrees without any item to prove.
+}
+
+// MultiProofFromByteSlices computes an inclusion proof of the items at the
+// given indices, which may be given in any order and may repeat. The
+// indices of the proof are sorted and deduplicated.
+func MultiProofFromByteSlices(items [][]byte, indices []int64) (rootHash []byte, proof *MultiProof, err error) {
+	if len(indices) == 0 {
+		return nil, nil, errors.New("no indices to prove")
+	}
+
+	sorted := make([]int64, len(indices))
+	copy(sorted, indices)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	unique := sorted[:1]
+	for _, index := range sorted[1:] {
+		if index != unique[len(unique)-1] {
+			unique = append(unique, index)
+		}
+	}
+	if unique[0] < 0 || unique[len(unique)-1] >= int64(len(items)) {
+		return nil, nil, fmt.Errorf("indices must be in [0, %d), got %v", len(items), indices)
+	}
+
+	proof = &MultiProof{
+		Total:   int64(len(items)),
+		Indices: unique,
+	}
+	rootHash = proof.build(items, 0, unique)
+	return rootHash, proof, nil
+}
+
+// build appends the leaf hashes and aunts of the subtree of items, whose
+// first item is at offset, to the proof, and returns its hash.
+func (mp *MultiProof) build(items [][]byte, offset int64, indices []int64) []byte {
+	if len(indices) == 0 {
+		hash := HashFromByteSlices(items)
+		mp.Aunts = append(mp.Aunts, hash)
+		return hash
+	}
+	if len(items) == 1 {
+		hash := leafHash(items[0])
+		mp.LeafHashes = append(mp.LeafHashes, hash)
+		return hash
+	}
+
+	k := getSplitPoint(int64(len(items)))
+	split := sort.Search(len(indices), func(i int) bool { return indices[i] >= offset+k })
+	left := mp.build(items[:k], offset, indices[:split])
+	right := mp.build(items[k:], offset+k, indices[split:])
+	return innerHash(left, right)
+}
+
+// Verify that the MultiProof proves the root hash. leaves are the items at
+// mp.Indices, in the same order.
+func (mp *MultiProof) Verify(rootHash []byte, leaves [][]byte) error {
+	if len(leaves) != len(mp.LeafHashes) {
+		return fmt.Errorf("expected %d leaves, got %d", len(mp.LeafHashes), len(leaves))
+	}
+	for i, leaf := range leaves {
+		leafHash := leafHash(leaf)
+		if !bytes.Equal(mp.LeafHashes[i], leafHash) {
+			return fmt.Errorf("invalid leaf hash #%d: wanted %X got %X", i, leafHash, mp.LeafHashes[i])
+		}
+	}
+	computedHash, err := mp.ComputeRootHash()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(computedHash, rootHash) {
+		return fmt.Errorf("invalid root hash: wanted %X got %X", rootHash, computedHash)
+	}
+	return nil
+}
+
+// ComputeRootHash computes the root hash from the leaf hashes and aunts.
+// Does not verify the result.
+func (mp *MultiProof) ComputeRootHash() ([]byte, error) {
+	if err := mp.validateIndices(); err != nil {
+		return nil, err
+	}
+	if len(mp.LeafHashes) != len(mp.Indices) {
+		return nil, fmt.Errorf("expected %d leaf hashes, got %d", len(mp.Indices), len(mp.LeafHashes))
+	}
+
+	leafHashes, aunts := mp.LeafHashes, mp.Aunts
+	var compute func(offset, total int64, indices []int64) ([]byte, error)
+	compute = func(offset, total int64, indices []int64) ([]byte, error) {
+		if len(indices) == 0 {
+			if len(aunts) == 0 {
+				return nil, errors.New("not enough aunts")
+			}
+			hash := aunts[0]
+			aunts = aunts[1:]
+			return hash, nil
+		}
+		if total == 1 {
+			hash := leafHashes[0]
+			leafHashes = leafHashes[1:]
+			return hash, nil
+		}
+
+		k := getSplitPoint(total)
+		split := sort.Search(len(indices), func(i int) bool { return indices[i] >= offset+k })
+		left, err := compute(offset, k, indices[:split])
+		if err != nil {
+			return nil, err
+		}
+		right, err := compute(offset+k, total-k, indices[split:])
+		if err != nil {
+			return nil, err
+		}
+		return innerHash(left, right), nil
+	}
+
+	rootHash, err := compute(0, mp.Total, mp.Indices)
+	if err != nil {
+		return nil, err
+	}
+	if len(aunts) != 0 {
+		return nil, fmt.Errorf("%d unused aunts", len(aunts))
+	}
+	return rootHash, nil
+}
+
+// String implements the stringer interface for MultiProof.
+// It is a wrapper around StringIndented.
+func (mp *MultiProof) String() string {
+	return mp.StringIndented("")
+}
+
+// StringIndented generates a canonical string representation of a MultiProof.
+func (mp *MultiProof) StringIndented(indent string) string {
+	return fmt.Sprintf(`MultiProof{
+%s  Indices: %v
+%s  Aunts:   %X
+%s}`,
+		indent, mp.Indices,
+		indent, mp.Aunts,
+		indent)
+}
+
+// ValidateBasic performs basic validation.
+// NOTE: it expects the elements of LeafHashes and Aunts to be of size
+// tmhash.Size, and it expects at most MaxAunts elements in Aunts per index.
+func (mp *MultiProof) ValidateBasic() error {
+	if err := mp.validateIndices(); err != nil {
+		return err
+	}
+	if len(mp.LeafHashes) != len(mp.Indices) {
+		return fmt.Errorf("expected %d leaf hashes, got %d", len(mp.Indices), len(mp.LeafHashes))
+	}
+	for i, leafHash := range mp.LeafHashes {
+		if len(leafHash) != tmhash.Size {
+			return fmt.Errorf("expected LeafHashes#%d size to be %d, got %d", i, tmhash.Size, len(leafHash))
+		}
+	}
+	if len(mp.Aunts) > MaxAunts*len(mp.Indices) {
+		return fmt.Errorf("expected no more than %d aunts, got %d", MaxAunts*len(mp.Indices), len(mp.Aunts))
+	}
+	for i, auntHash := range mp.Aunts {
+		if len(auntHash) != tmhash.Size {
+			return fmt.Errorf("expected Aunts#%d size to be %d, got %d", i, tmhash.Size, len(auntHash))
+		}
+	}
+	return nil
+}
+
+// validateIndices checks that there are indices, which are in increasing
+// order and less than Total.
+func (mp *MultiProof) validateIndices() error {
+	if mp.Total <= 0 {
+		return errors.New("proof total must be positive")
+	}
+	if len(mp.Indices) == 0 {
+		return errors.New("no indices")
+	}
+	for i, index := range mp.Indices {
+		if index < 0 || index >= mp.Total {
+			return fmt.Errorf("index #%d must be in [0, %d), got %d", i, mp.Total, index)
+		}
+		if i > 0 && index <= mp.Indices[i-1] {
+			return fmt.Errorf("indices must be in increasing order, got %d after %d", index, mp.Indices[i-1])
+		}
+	}
+	return nil
+}
+
+func (mp *MultiProof) ToProto() *tmcrypto.MultiProof {
+	if mp == nil {
+		return nil
+	}
+	pb := new(tmcrypto.MultiProof)
+
+	pb.Total = mp.Total
+	pb.Indices = mp.Indices
+	pb.LeafHashes = mp.LeafHashes
+	pb.Aunts = mp.Aunts
+
+	return pb
+}
+
+func MultiProofFromProto(pb *tmcrypto.MultiProof) (*MultiProof, error) {
+	if pb == nil {
+		return nil, errors.New("nil proof")
+	}
+
+	mp := new(MultiProof)
+
+	mp.Total = pb.Total
+	mp.Indices = pb.Indices
+	mp.LeafHashes = pb.LeafHashes
+	mp.Aunts = pb.Aunts
+
+	return mp, mp.ValidateBasic()
+}
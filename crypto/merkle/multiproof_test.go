@@ -0,0 +1,218 @@
+package merkle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	ctest "github.com/tendermint/tendermint/internal/libs/test"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
+)
+
+func TestMultiProof(t *testing.T) {
+	total := 100
+
+	items := make([][]byte, total)
+	for i := 0; i < total; i++ {
+		items[i] = testItem(tmrand.Bytes(tmhash.Size))
+	}
+	rootHash := HashFromByteSlices(items)
+	_, proofs := ProofsFromByteSlices(items)
+
+	testCases := []struct {
+		testName string
+		indices  []int64
+		expected []int64
+	}{
+		{"single first", []int64{0}, []int64{0}},
+		{"single last", []int64{99}, []int64{99}},
+		{"adjacent", []int64{10, 11, 12}, []int64{10, 11, 12}},
+		{"unsorted with duplicates", []int64{64, 3, 97, 3, 40}, []int64{3, 40, 64, 97}},
+		{"all", nil, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			indices, expected := tc.indices, tc.expected
+			if indices == nil {
+				for i := 0; i < total; i++ {
+					indices = append(indices, int64(i))
+				}
+				expected = indices
+			}
+
+			rootHash2, proof, err := MultiProofFromByteSlices(items, indices)
+			require.NoError(t, err)
+			require.Equal(t, rootHash, rootHash2)
+			require.Equal(t, expected, proof.Indices)
+			require.NoError(t, proof.ValidateBasic())
+
+			leaves := make([][]byte, len(proof.Indices))
+			auntCount := 0
+			for i, index := range proof.Indices {
+				leaves[i] = items[index]
+				auntCount += len(proofs[index].Aunts)
+			}
+			require.NoError(t, proof.Verify(rootHash, leaves))
+
+			// the aunts are shared, so there are no more than in the
+			// individual proofs
+			assert.LessOrEqual(t, len(proof.Aunts), auntCount)
+
+			// Mutating a leaf should make it fail.
+			last := len(leaves) - 1
+			mutated := append([][]byte{}, leaves...)
+			mutated[last] = ctest.MutateByteSlice(leaves[last])
+			require.Error(t, proof.Verify(rootHash, mutated))
+
+			// Missing leaves should make it fail.
+			require.Error(t, proof.Verify(rootHash, leaves[:last]))
+
+			// Mutating the rootHash should make it fail.
+			require.Error(t, proof.Verify(ctest.MutateByteSlice(rootHash), leaves))
+
+			if len(proof.Aunts) > 0 {
+				origAunts := proof.Aunts
+
+				// Trail too long should make it fail
+				proof.Aunts = append(proof.Aunts, tmrand.Bytes(32))
+				require.Error(t, proof.Verify(rootHash, leaves))
+
+				// Trail too short should make it fail
+				proof.Aunts = origAunts[:len(origAunts)-1]
+				require.Error(t, proof.Verify(rootHash, leaves))
+
+				proof.Aunts = origAunts
+			}
+		})
+	}
+
+	_, _, err := MultiProofFromByteSlices(items, []int64{})
+	assert.Error(t, err)
+	_, _, err = MultiProofFromByteSlices(items, []int64{int64(total)})
+	assert.Error(t, err)
+	_, _, err = MultiProofFromByteSlices(items, []int64{-1})
+	assert.Error(t, err)
+}
+
+func TestMultiProofValidateBasic(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		malleateProof func(*MultiProof)
+		errStr        string
+	}{
+		{"Good", func(mp *MultiProof) {}, ""},
+		{"Zero Total", func(mp *MultiProof) { mp.Total = 0 }, "proof total must be positive"},
+		{"No Indices", func(mp *MultiProof) { mp.Indices = nil }, "no indices"},
+		{"Index out of range", func(mp *MultiProof) { mp.Indices[1] = 3 }, "index #1 must be in [0, 3), got 3"},
+		{"Unsorted Indices", func(mp *MultiProof) { mp.Indices[0], mp.Indices[1] = 2, 0 },
+			"indices must be in increasing order, got 0 after 2"},
+		{"Missing LeafHash", func(mp *MultiProof) { mp.LeafHashes = mp.LeafHashes[1:] },
+			"expected 2 leaf hashes, got 1"},
+		{"Invalid LeafHash", func(mp *MultiProof) { mp.LeafHashes[0] = make([]byte, 10) },
+			"expected LeafHashes#0 size to be 32, got 10"},
+		{"Too many Aunts", func(mp *MultiProof) { mp.Aunts = make([][]byte, 2*MaxAunts+1) },
+			"expected no more than 200 aunts, got 201"},
+		{"Invalid Aunt", func(mp *MultiProof) { mp.Aunts[0] = make([]byte, 10) },
+			"expected Aunts#0 size to be 32, got 10"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			_, proof, err := MultiProofFromByteSlices([][]byte{
+				[]byte("apple"),
+				[]byte("watermelon"),
+				[]byte("kiwi"),
+			}, []int64{0, 2})
+			require.NoError(t, err)
+			tc.malleateProof(proof)
+			err = proof.ValidateBasic()
+			if tc.errStr != "" {
+				assert.Contains(t, err.Error(), tc.errStr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMultiProofProtobuf(t *testing.T) {
+	_, proof, err := MultiProofFromByteSlices([][]byte{
+		[]byte("apple"),
+		[]byte("watermelon"),
+		[]byte("kiwi"),
+	}, []int64{0, 2})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		testName string
+		mp       *MultiProof
+		expPass  bool
+	}{
+		{"empty proof", &MultiProof{}, false},
+		{"failure nil", nil, false},
+		{"success", proof, true},
+	}
+	for _, tc := range testCases {
+		pb := tc.mp.ToProto()
+		if pb != nil {
+			bz, err := pb.Marshal()
+			require.NoError(t, err)
+			pb.Reset()
+			require.NoError(t, pb.Unmarshal(bz))
+		}
+
+		mp, err := MultiProofFromProto(pb)
+		if tc.expPass {
+			require.NoError(t, err)
+			require.Equal(t, tc.mp, mp, tc.testName)
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
+
+func TestMultiProofOp(t *testing.T) {
+	items := [][]byte{
+		[]byte("apple"),
+		[]byte("watermelon"),
+		[]byte("kiwi"),
+		[]byte("banana"),
+		[]byte("cherry"),
+	}
+	rootHash, proof, err := MultiProofFromByteSlices(items, []int64{1, 3, 4})
+	require.NoError(t, err)
+	leaves := [][]byte{items[1], items[3], items[4]}
+
+	// the operator round trips through the proof runtime, and can be
+	// chained with other operators
+	op := NewMultiProofOp([]byte("fruits"), proof)
+	popz := ProofOperators{op, NewDominoOp("", string(rootHash), "OUTPUT")}
+	prt := DefaultProofRuntime()
+	prt.RegisterOpDecoder(ProofOpDomino, func(pop tmcrypto.ProofOp) (ProofOperator, error) {
+		var pbop tmcrypto.DominoOp
+		if err := pbop.Unmarshal(pop.Data); err != nil {
+			return nil, err
+		}
+		return NewDominoOp(pbop.Key, pbop.Input, pbop.Output), nil
+	})
+	proofOps := &tmcrypto.ProofOps{Ops: []tmcrypto.ProofOp{popz[0].ProofOp(), popz[1].ProofOp()}}
+
+	require.NoError(t, popz.Verify([]byte("OUTPUT"), "/fruits", leaves))
+	require.NoError(t, prt.Verify(proofOps, []byte("OUTPUT"), "/fruits", leaves))
+
+	// wrong leaves
+	assert.Error(t, prt.Verify(proofOps, []byte("OUTPUT"), "/fruits", [][]byte{items[1], items[3], items[2]}))
+	assert.Error(t, prt.Verify(proofOps, []byte("OUTPUT"), "/fruits", leaves[:2]))
+	// wrong key
+	assert.Error(t, prt.Verify(proofOps, []byte("OUTPUT"), "/vegetables", leaves))
+
+	res, err := op.Run(leaves)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{rootHash}, res)
+}
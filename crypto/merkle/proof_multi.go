@@ -0,0 +1,95 @@
+package merkle
+
+import (
+	"bytes"
+	"fmt"
+
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
+)
+
+const ProofOpMulti = "simple:m"
+
+// MultiProofOp takes the items at the indices of a MultiProof as arguments,
+// in the same order, and produces the root hash of the tree of
+// HashFromByteSlices they are part of. Unlike ValueOp, the items are the
+// leaves of the tree themselves, so that it can prove several transactions
+// of a block, or several roots of a chained proof, at once.
+//
+// If the produced root hash matches the expected hash, the
+// proof is good.
+type MultiProofOp struct {
+	// Encoded in ProofOp.Key.
+	key []byte
+
+	// To encode in ProofOp.Data
+	Proof *MultiProof `json:"proof"`
+}
+
+var _ ProofOperator = MultiProofOp{}
+
+func NewMultiProofOp(key []byte, proof *MultiProof) MultiProofOp {
+	return MultiProofOp{
+		key:   key,
+		Proof: proof,
+	}
+}
+
+func MultiProofOpDecoder(pop tmcrypto.ProofOp) (ProofOperator, error) {
+	if pop.Type != ProofOpMulti {
+		return nil, fmt.Errorf("unexpected ProofOp.Type; got %v, want %v", pop.Type, ProofOpMulti)
+	}
+	var pbop tmcrypto.MultiProofOp
+	err := pbop.Unmarshal(pop.Data)
+	if err != nil {
+		return nil, fmt.Errorf("decoding ProofOp.Data into MultiProofOp: %w", err)
+	}
+
+	mp, err := MultiProofFromProto(pbop.Proof)
+	if err != nil {
+		return nil, err
+	}
+	return NewMultiProofOp(pop.Key, mp), nil
+}
+
+func (op MultiProofOp) ProofOp() tmcrypto.ProofOp {
+	pbop := tmcrypto.MultiProofOp{
+		Key:   op.key,
+		Proof: op.Proof.ToProto(),
+	}
+	bz, err := pbop.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmcrypto.ProofOp{
+		Type: ProofOpMulti,
+		Key:  op.key,
+		Data: bz,
+	}
+}
+
+func (op MultiProofOp) String() string {
+	return fmt.Sprintf("MultiProofOp{%v}", op.GetKey())
+}
+
+func (op MultiProofOp) Run(args [][]byte) ([][]byte, error) {
+	if len(args) != len(op.Proof.Indices) {
+		return nil, fmt.Errorf("expected %v args, got %v", len(op.Proof.Indices), len(args))
+	}
+	// computing the root hash first checks the leaf hashes match the indices
+	rootHash, err := op.Proof.ComputeRootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, arg := range args {
+		if hash := leafHash(arg); !bytes.Equal(hash, op.Proof.LeafHashes[i]) {
+			return nil, fmt.Errorf("leaf hash mismatch #%d: want %X got %X", i, op.Proof.LeafHashes[i], hash)
+		}
+	}
+
+	return [][]byte{rootHash}, nil
+}
+
+func (op MultiProofOp) GetKey() []byte {
+	return op.key
+}
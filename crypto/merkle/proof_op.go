@@ -129,11 +129,12 @@ func (prt *ProofRuntime) Verify(proof *tmcrypto.ProofOps, root []byte, keypath s
 	return poz.Verify(root, keypath, args)
 }
 
-// DefaultProofRuntime only knows about value proofs.
+// DefaultProofRuntime only knows about value proofs and multiproofs.
 // To use e.g. IAVL proofs, register op-decoders as
 // defined in the IAVL package.
 func DefaultProofRuntime() (prt *ProofRuntime) {
 	prt = NewProofRuntime()
 	prt.RegisterOpDecoder(ProofOpValue, ValueOpDecoder)
+	prt.RegisterOpDecoder(ProofOpMulti, MultiProofOpDecoder)
 	return
 }
@@ -0,0 +1,82 @@
+package secp256k1
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/tendermint/tendermint/crypto"
+)
+
+var _ crypto.BatchVerifier = &BatchVerifier{}
+
+// BatchVerifier implements batch verification for secp256k1.
+//
+// ECDSA signatures can't be verified together like Schnorr signatures can,
+// as the R || S form lacks the y-coordinate of the nonce point that a
+// randomized linear combination of the verification equations would need.
+// The signatures of a batch are instead verified in parallel.
+type BatchVerifier struct {
+	entries []batchEntry
+}
+
+type batchEntry struct {
+	pubKey    PubKey
+	msg       []byte
+	signature []byte
+}
+
+func NewBatchVerifier() crypto.BatchVerifier {
+	return &BatchVerifier{}
+}
+
+func (b *BatchVerifier) Add(key crypto.PubKey, msg, signature []byte) error {
+	pk, ok := key.(PubKey)
+	if !ok {
+		return fmt.Errorf("secp256k1: pubkey is not secp256k1")
+	}
+
+	if l := len(pk); l != PubKeySize {
+		return fmt.Errorf("secp256k1: pubkey size is incorrect; expected: %d, got %d", PubKeySize, l)
+	}
+
+	// check that the signature is the correct length
+	if len(signature) != 64 {
+		return fmt.Errorf("secp256k1: invalid signature")
+	}
+
+	b.entries = append(b.entries, batchEntry{pubKey: pk, msg: msg, signature: signature})
+
+	return nil
+}
+
+func (b *BatchVerifier) Verify() (bool, []bool) {
+	var (
+		valid   = make([]bool, len(b.entries))
+		workers = runtime.GOMAXPROCS(0)
+		wg      sync.WaitGroup
+	)
+	if workers > len(b.entries) {
+		workers = len(b.entries)
+	}
+
+	// each worker verifies every workers-th entry, so that no two workers
+	// write to the same element of valid.
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(start int) {
+			defer wg.Done()
+			for i := start; i < len(b.entries); i += workers {
+				e := b.entries[i]
+				valid[i] = e.pubKey.VerifySignature(e.msg, e.signature)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	ok := len(valid) > 0
+	for _, v := range valid {
+		ok = ok && v
+	}
+	return ok, valid
+}
@@ -513,7 +513,12 @@ FOR_LOOP:
 				didProcessCh <- struct{}{}
 			}
 
-			firstParts, err := first.MakePartSet(types.BlockPartSizeBytes)
+			// the parts are erasure coded like the ones of the proposal
+			// the commit is for
+			firstParts, err := first.MakeErasureCodedPartSet(
+				types.BlockPartSizeBytes,
+				second.LastCommit.BlockID.PartSetHeader.Parity,
+			)
 			if err != nil {
 				r.logger.Error("failed to make ",
 					"height", first.Height,
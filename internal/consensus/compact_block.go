@@ -0,0 +1,325 @@
+package consensus
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	cstypes "github.com/tendermint/tendermint/internal/consensus/types"
+	"github.com/tendermint/tendermint/internal/p2p"
+	tmtime "github.com/tendermint/tendermint/libs/time"
+	tmcons "github.com/tendermint/tendermint/proto/tendermint/consensus"
+	"github.com/tendermint/tendermint/types"
+)
+
+// blockTxOverheadBytes is an upper bound on the bytes a transaction and its
+// index add to a BlockTxs message, on top of the transaction itself.
+const blockTxOverheadBytes = 16
+
+// compactBlock holds the state of the last compact block received. Its
+// transactions are either found in the mempool or fetched from the peer that
+// sent it.
+type compactBlock struct {
+	peerID        types.NodeID
+	msg           *CompactBlockMessage
+	partSetHeader types.PartSetHeader
+
+	txs     types.Txs
+	missing map[uint32]struct{}
+}
+
+// shouldSendCompactBlock returns true if the peer supports compact blocks and
+// is yet to receive the proposal block we have in full.
+func (r *Reactor) shouldSendCompactBlock(rs *cstypes.RoundState, prs *cstypes.PeerRoundState, ps *PeerState) bool {
+	if !ps.CompactBlocks() {
+		return false
+	}
+
+	if rs.Height != prs.Height || rs.Round != prs.Round || !prs.Proposal {
+		return false
+	}
+
+	// the proposal block is only set once all of its parts are received
+	if rs.ProposalBlock == nil || !rs.ProposalBlockParts.HasHeader(prs.ProposalBlockPartSetHeader) {
+		return false
+	}
+
+	return !prs.ProposalBlockParts.IsFull()
+}
+
+// sendCompactBlock sends the compact block of our proposal block to the peer,
+// unless it was already sent. Compact blocks that exceed the maximum message
+// size are not sent.
+func (r *Reactor) sendCompactBlock(ctx context.Context, rs *cstypes.RoundState, ps *PeerState) error {
+	if !ps.SetCompactBlockSent(rs.Height, rs.Round) {
+		return nil
+	}
+
+	block := rs.ProposalBlock
+	txKeys := make([]types.TxKey, len(block.Txs))
+	for i, tx := range block.Txs {
+		txKeys[i] = tx.Key()
+	}
+
+	pb, err := MsgToProto(&CompactBlockMessage{
+		Height:     rs.Height,
+		Round:      rs.Round,
+		Header:     block.Header,
+		TxKeys:     txKeys,
+		Evidence:   block.Evidence.Evidence,
+		LastCommit: block.LastCommit,
+	})
+	if err != nil {
+		r.logger.Error("failed to convert compact block to proto", "err", err)
+		return nil
+	}
+
+	if pb.Size() > maxMsgSize {
+		r.logger.Debug("compact block exceeds the maximum message size", "height", rs.Height, "round", rs.Round)
+		return nil
+	}
+
+	r.logger.Debug("sending compact block", "height", rs.Height, "round", rs.Round, "peer", ps.peerID)
+	return r.dataCh.Send(ctx, p2p.Envelope{
+		To:      ps.peerID,
+		Message: pb.GetCompactBlock(),
+	})
+}
+
+// handleCompactBlock rebuilds the proposal block from a compact block sent by
+// the peer, looking up its transactions in the mempool. The missing
+// transactions are requested from the peer. A compact block is ignored if we
+// already have the proposal block, if it does not follow a proposal of the
+// peer, or if another compact block of the same height and round was received.
+func (r *Reactor) handleCompactBlock(ctx context.Context, ps *PeerState, msg *CompactBlockMessage) error {
+	prs := ps.GetRoundState()
+	if prs.Height != msg.Height || prs.Round != msg.Round || !prs.Proposal {
+		return nil
+	}
+
+	rs := r.state.GetRoundState()
+	if rs.Height != msg.Height ||
+		(rs.ProposalBlock != nil && rs.ProposalBlockParts.HasHeader(prs.ProposalBlockPartSetHeader)) {
+		return nil
+	}
+
+	cb := &compactBlock{
+		peerID:        ps.peerID,
+		msg:           msg,
+		partSetHeader: prs.ProposalBlockPartSetHeader,
+		txs:           make(types.Txs, len(msg.TxKeys)),
+		missing:       make(map[uint32]struct{}),
+	}
+
+	r.compactMtx.Lock()
+	prev := r.compactBlock
+	if prev != nil && prev.msg.Height == msg.Height && prev.msg.Round == msg.Round {
+		r.compactMtx.Unlock()
+		return nil
+	}
+	if prev != nil && len(prev.missing) > 0 {
+		r.Metrics.CompactBlocks.With("outcome", "failed").Add(1)
+	}
+	r.compactBlock = cb
+
+	for i, key := range msg.TxKeys {
+		if r.mempool != nil {
+			if tx, ok := r.mempool.GetTxByKey(key); ok {
+				cb.txs[i] = tx
+				continue
+			}
+		}
+
+		cb.missing[uint32(i)] = struct{}{}
+	}
+
+	indexes := make([]uint32, 0, len(cb.missing))
+	for index := range cb.missing {
+		indexes = append(indexes, index)
+	}
+	r.compactMtx.Unlock()
+
+	r.Metrics.CompactBlockTxs.Add(float64(len(msg.TxKeys)))
+	r.Metrics.CompactBlockMissingTxs.Add(float64(len(indexes)))
+
+	if len(indexes) == 0 {
+		return r.completeCompactBlock(ctx, ps, cb, "mempool")
+	}
+
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+
+	r.logger.Debug(
+		"requesting missing compact block txs",
+		"height", msg.Height,
+		"round", msg.Round,
+		"peer", ps.peerID,
+		"num_txs", len(msg.TxKeys),
+		"num_missing", len(indexes),
+	)
+	return r.compactCh.Send(ctx, p2p.Envelope{
+		To: ps.peerID,
+		Message: &tmcons.BlockTxsRequest{
+			Height:  msg.Height,
+			Round:   msg.Round,
+			Indexes: indexes,
+		},
+	})
+}
+
+// completeCompactBlock makes the block parts of a compact block with all of
+// its transactions, and passes them to the consensus state as if the peer sent
+// them. The block is dropped if its parts do not match the proposal of the
+// peer.
+func (r *Reactor) completeCompactBlock(ctx context.Context, ps *PeerState, cb *compactBlock, outcome string) error {
+	parts, err := cb.msg.Block(cb.txs).MakeErasureCodedPartSet(types.BlockPartSizeBytes, cb.partSetHeader.Parity)
+	if err != nil {
+		r.Metrics.CompactBlocks.With("outcome", "failed").Add(1)
+		return err
+	}
+
+	if !parts.HasHeader(cb.partSetHeader) {
+		r.Metrics.CompactBlocks.With("outcome", "failed").Add(1)
+		r.logger.Debug(
+			"compact block does not match the proposal",
+			"height", cb.msg.Height,
+			"round", cb.msg.Round,
+			"peer", ps.peerID,
+			"block_part_set_header", parts.Header(),
+			"proposal_block_part_set_header", cb.partSetHeader,
+		)
+		return nil
+	}
+
+	r.Metrics.CompactBlocks.With("outcome", outcome).Add(1)
+
+	for i := 0; i < int(parts.Total()); i++ {
+		ps.SetHasProposalBlockPart(cb.msg.Height, cb.msg.Round, i)
+
+		msg := &BlockPartMessage{
+			Height: cb.msg.Height,
+			Round:  cb.msg.Round,
+			Part:   parts.GetPart(i),
+		}
+		select {
+		case r.state.peerMsgQueue <- msgInfo{msg, ps.peerID, tmtime.Now()}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// handleCompactBlockMessage handles envelopes sent from peers on the
+// CompactBlockChannel. If we fail to find the peer state for the envelope
+// sender, we perform a no-op and return. This can happen when we process the
+// envelope after the peer is removed.
+func (r *Reactor) handleCompactBlockMessage(ctx context.Context, envelope *p2p.Envelope, msgI Message) error {
+	logger := r.logger.With("peer", envelope.From, "ch_id", "CompactBlockChannel")
+
+	ps, ok := r.GetPeerState(envelope.From)
+	if !ok || ps == nil {
+		r.logger.Debug("failed to find peer state")
+		return nil
+	}
+
+	if r.WaitSync() {
+		logger.Info("ignoring message received during sync", "msg", msgI)
+		return nil
+	}
+
+	switch msg := envelope.Message.(type) {
+	case *tmcons.BlockTxsRequest:
+		return r.sendBlockTxs(ctx, envelope.From, msgI.(*BlockTxsRequestMessage))
+
+	case *tmcons.BlockTxs:
+		return r.handleBlockTxs(ctx, ps, msgI.(*BlockTxsMessage))
+
+	default:
+		return fmt.Errorf("received unknown message on CompactBlockChannel: %T", msg)
+	}
+}
+
+// sendBlockTxs sends the requested transactions of our proposal block to the
+// peer, in as many messages as needed to stay under the maximum message size.
+// Transactions that exceed it on their own are not sent, in which case the
+// peer falls back to the block parts.
+func (r *Reactor) sendBlockTxs(ctx context.Context, peerID types.NodeID, msg *BlockTxsRequestMessage) error {
+	rs := r.state.GetRoundState()
+	if rs.Height != msg.Height || rs.Round != msg.Round || rs.ProposalBlock == nil {
+		return nil
+	}
+
+	txs := rs.ProposalBlock.Txs
+	resp := &tmcons.BlockTxs{Height: msg.Height, Round: msg.Round}
+	size := 0
+
+	for _, index := range msg.Indexes {
+		if int(index) >= len(txs) {
+			return fmt.Errorf("requested tx index %d out of range [0, %d)", index, len(txs))
+		}
+
+		txSize := len(txs[index]) + blockTxOverheadBytes
+		if txSize > maxMsgSize-blockTxOverheadBytes {
+			continue
+		}
+
+		if size+txSize > maxMsgSize-blockTxOverheadBytes {
+			if err := r.compactCh.Send(ctx, p2p.Envelope{To: peerID, Message: resp}); err != nil {
+				return err
+			}
+
+			resp = &tmcons.BlockTxs{Height: msg.Height, Round: msg.Round}
+			size = 0
+		}
+
+		resp.Indexes = append(resp.Indexes, index)
+		resp.Txs = append(resp.Txs, txs[index])
+		size += txSize
+	}
+
+	if len(resp.Txs) == 0 {
+		return nil
+	}
+
+	return r.compactCh.Send(ctx, p2p.Envelope{To: peerID, Message: resp})
+}
+
+// handleBlockTxs adds the transactions sent by the peer to the compact block
+// they were requested for, and completes it once none are missing. An error is
+// returned if the peer sends transactions that were not requested, or that do
+// not match the keys of the compact block.
+func (r *Reactor) handleBlockTxs(ctx context.Context, ps *PeerState, msg *BlockTxsMessage) error {
+	r.compactMtx.Lock()
+
+	cb := r.compactBlock
+	if cb == nil || cb.peerID != ps.peerID || cb.msg.Height != msg.Height || cb.msg.Round != msg.Round ||
+		len(cb.missing) == 0 {
+		r.compactMtx.Unlock()
+		return nil
+	}
+
+	for i, index := range msg.Indexes {
+		if _, ok := cb.missing[index]; !ok {
+			r.compactMtx.Unlock()
+			return fmt.Errorf("received unrequested tx at index %d", index)
+		}
+
+		if msg.Txs[i].Key() != cb.msg.TxKeys[index] {
+			r.compactMtx.Unlock()
+			return fmt.Errorf("received tx at index %d does not match its key", index)
+		}
+
+		cb.txs[index] = msg.Txs[i]
+		delete(cb.missing, index)
+	}
+
+	complete := len(cb.missing) == 0
+	r.compactMtx.Unlock()
+
+	if !complete {
+		return nil
+	}
+
+	return r.completeCompactBlock(ctx, ps, cb, "fetched")
+}
@@ -0,0 +1,86 @@
+package consensus
+
+import (
+	"fmt"
+	"time"
+)
+
+// HaltExitCode is the status code a node exits with once consensus halted at
+// the configured halt height or halt time, so that process supervisors can
+// tell a planned halt apart from a failure.
+const HaltExitCode = 3
+
+// HaltError reports that consensus stopped after committing the block at
+// Height, whose time is Time, because the halt height or halt time was reached.
+type HaltError struct {
+	Height int64
+	Time   time.Time
+}
+
+func (e HaltError) Error() string {
+	return fmt.Sprintf("consensus halted after committing block %d at %v", e.Height, e.Time)
+}
+
+// ExitCode returns HaltExitCode. It implements cli.ExitCoder.
+func (e HaltError) ExitCode() int { return HaltExitCode }
+
+// Halted returns a channel that is closed once the state machine committed
+// the halt height or a block past the halt time and stopped participating in
+// consensus.
+func (cs *State) Halted() <-chan struct{} {
+	return cs.halted
+}
+
+// HaltError returns a HaltError once Halted is closed, and nil before.
+func (cs *State) HaltError() error {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+	return cs.haltErr
+}
+
+// shouldHalt reports whether the last committed block reached the halt
+// height or halt time. The halt height set by the consensus params only halts
+// the node right after that height is committed, and again on restart, unless
+// the app was upgraded meanwhile: it then reports a version other than the
+// app version of the chain, see StateAppVersion, and consensus resumes. The
+// local halt height and halt time are cleared from the config instead.
+func (cs *State) shouldHalt() bool {
+	height := cs.state.LastBlockHeight
+	if height == 0 {
+		return false
+	}
+	if h := cs.config.HaltHeight; h > 0 && height >= h {
+		return true
+	}
+	if t := cs.config.HaltTime; t > 0 && !cs.state.LastBlockTime.Before(time.Unix(t, 0)) {
+		return true
+	}
+	if h := cs.state.ConsensusParams.Block.HaltHeight; h > 0 && height == h {
+		return cs.appVersion == 0 || cs.appVersion == cs.state.Version.Consensus.App
+	}
+	return false
+}
+
+// isHalted reports whether halt was called. The caller must hold cs.mtx or
+// run on the receive routine.
+func (cs *State) isHalted() bool {
+	return cs.haltErr != nil
+}
+
+// halt stops the state machine from proposing or voting for any further
+// height and flushes the WAL. The block and state stores are written
+// synchronously on commit and are closed when the node stops. It closes
+// Halted so that the node can shut down.
+func (cs *State) halt() {
+	if cs.isHalted() {
+		return
+	}
+
+	if err := cs.wal.FlushAndSync(); err != nil {
+		cs.logger.Error("failed to flush WAL on halt", "err", err)
+	}
+
+	cs.haltErr = HaltError{Height: cs.state.LastBlockHeight, Time: cs.state.LastBlockTime}
+	cs.logger.Info("halting consensus", "height", cs.state.LastBlockHeight, "time", cs.state.LastBlockTime)
+	close(cs.halted)
+}
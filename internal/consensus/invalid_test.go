@@ -119,6 +119,7 @@ func invalidDoPrevoteFunc(
 		require.NoError(t, err)
 
 		precommit.Signature = p.Signature
+		precommit.ExtensionSignature = p.ExtensionSignature
 		cs.privValidator = nil // disable priv val so we don't do normal votes
 		cs.mtx.Unlock()
 
@@ -62,6 +62,15 @@ type Metrics struct {
 	// Number of blockparts transmitted by peer.
 	BlockParts metrics.Counter
 
+	// Number of compact blocks received, by outcome: rebuilt from the mempool
+	// alone, rebuilt after fetching the missing transactions, or not rebuilt.
+	CompactBlocks metrics.Counter
+	// Number of transactions in the compact blocks received.
+	CompactBlockTxs metrics.Counter
+	// Number of transactions in the compact blocks received that were missing
+	// from the mempool.
+	CompactBlockMissingTxs metrics.Counter
+
 	// Histogram of time taken per step annotated with reason that the step proceeded.
 	StepTime metrics.Histogram
 }
@@ -190,6 +199,24 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "block_parts",
 			Help:      "Number of blockparts transmitted by peer.",
 		}, append(labels, "peer_id")).With(labelsAndValues...),
+		CompactBlocks: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "compact_blocks",
+			Help:      "Number of compact blocks received, by outcome (mempool, fetched or failed).",
+		}, append(labels, "outcome")).With(labelsAndValues...),
+		CompactBlockTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "compact_block_txs",
+			Help:      "Number of transactions in the compact blocks received.",
+		}, labels).With(labelsAndValues...),
+		CompactBlockMissingTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "compact_block_missing_txs",
+			Help:      "Number of transactions in the compact blocks received that were missing from the mempool.",
+		}, labels).With(labelsAndValues...),
 		StepTime: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -226,6 +253,10 @@ func NopMetrics() *Metrics {
 		BlockSyncing:    discard.NewGauge(),
 		StateSyncing:    discard.NewGauge(),
 		BlockParts:      discard.NewCounter(),
+
+		CompactBlocks:          discard.NewCounter(),
+		CompactBlockTxs:        discard.NewCounter(),
+		CompactBlockMissingTxs: discard.NewCounter(),
 	}
 }
 
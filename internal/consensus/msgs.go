@@ -30,6 +30,10 @@ func init() {
 	tmjson.RegisterType(&HasVoteMessage{}, "tendermint/HasVote")
 	tmjson.RegisterType(&VoteSetMaj23Message{}, "tendermint/VoteSetMaj23")
 	tmjson.RegisterType(&VoteSetBitsMessage{}, "tendermint/VoteSetBits")
+	tmjson.RegisterType(&CompactBlockMessage{}, "tendermint/CompactBlock")
+	tmjson.RegisterType(&BlockTxsRequestMessage{}, "tendermint/BlockTxsRequest")
+	tmjson.RegisterType(&BlockTxsMessage{}, "tendermint/BlockTxs")
+	tmjson.RegisterType(&CommitMessage{}, "tendermint/Commit")
 }
 
 // NewRoundStepMessage is sent for every step taken in the ConsensusState.
@@ -119,8 +123,9 @@ func (m *NewValidBlockMessage) ValidateBasic() error {
 			m.BlockParts.Size(),
 			m.BlockPartSetHeader.Total)
 	}
-	if m.BlockParts.Size() > int(types.MaxBlockPartsCount) {
-		return fmt.Errorf("blockParts bit array is too big: %d, max: %d", m.BlockParts.Size(), types.MaxBlockPartsCount)
+	if m.BlockPartSetHeader.DataTotal() > types.MaxBlockPartsCount {
+		return fmt.Errorf("blockParts bit array is too big: %d data parts, max: %d",
+			m.BlockPartSetHeader.DataTotal(), types.MaxBlockPartsCount)
 	}
 	return nil
 }
@@ -312,6 +317,137 @@ func (m *VoteSetBitsMessage) String() string {
 	return fmt.Sprintf("[VSB %v/%02d/%v %v %v]", m.Height, m.Round, m.Type, m.BlockID, m.Votes)
 }
 
+// CompactBlockMessage is sent after a proposal to the peers that support
+// compact blocks. It carries the proposal block with its transactions replaced
+// by their keys, so the peer can rebuild the block from its mempool.
+type CompactBlockMessage struct {
+	Height     int64
+	Round      int32
+	Header     types.Header
+	TxKeys     []types.TxKey
+	Evidence   types.EvidenceList
+	LastCommit *types.Commit
+}
+
+// ValidateBasic performs basic validation.
+func (m *CompactBlockMessage) ValidateBasic() error {
+	if m.Height < 0 {
+		return errors.New("negative Height")
+	}
+	if m.Round < 0 {
+		return errors.New("negative Round")
+	}
+	if err := m.Header.ValidateBasic(); err != nil {
+		return fmt.Errorf("wrong Header: %w", err)
+	}
+	if m.Header.Height != m.Height {
+		return fmt.Errorf("header height %d does not match height %d", m.Header.Height, m.Height)
+	}
+	if m.LastCommit != nil {
+		if err := m.LastCommit.ValidateBasic(); err != nil {
+			return fmt.Errorf("wrong LastCommit: %w", err)
+		}
+	}
+	return nil
+}
+
+// Block returns the block the compact block was made from, given its
+// transactions.
+func (m *CompactBlockMessage) Block(txs types.Txs) *types.Block {
+	return &types.Block{
+		Header:     m.Header,
+		Data:       types.Data{Txs: txs},
+		Evidence:   types.EvidenceData{Evidence: m.Evidence},
+		LastCommit: m.LastCommit,
+	}
+}
+
+// String returns a string representation.
+func (m *CompactBlockMessage) String() string {
+	return fmt.Sprintf("[CompactBlock H:%v R:%v NTxs:%v]", m.Height, m.Round, len(m.TxKeys))
+}
+
+// BlockTxsRequestMessage is sent to request the transactions of a compact
+// block, identified by their index in the block, that are missing from the
+// mempool.
+type BlockTxsRequestMessage struct {
+	Height  int64
+	Round   int32
+	Indexes []uint32
+}
+
+// ValidateBasic performs basic validation.
+func (m *BlockTxsRequestMessage) ValidateBasic() error {
+	if m.Height < 0 {
+		return errors.New("negative Height")
+	}
+	if m.Round < 0 {
+		return errors.New("negative Round")
+	}
+	if len(m.Indexes) == 0 {
+		return errors.New("empty Indexes")
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *BlockTxsRequestMessage) String() string {
+	return fmt.Sprintf("[BlockTxsRequest H:%v R:%v NTxs:%v]", m.Height, m.Round, len(m.Indexes))
+}
+
+// BlockTxsMessage is sent in response to a BlockTxsRequestMessage.
+type BlockTxsMessage struct {
+	Height  int64
+	Round   int32
+	Indexes []uint32
+	Txs     types.Txs
+}
+
+// ValidateBasic performs basic validation.
+func (m *BlockTxsMessage) ValidateBasic() error {
+	if m.Height < 0 {
+		return errors.New("negative Height")
+	}
+	if m.Round < 0 {
+		return errors.New("negative Round")
+	}
+	if len(m.Indexes) != len(m.Txs) {
+		return fmt.Errorf("number of indexes %d does not match number of txs %d", len(m.Indexes), len(m.Txs))
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *BlockTxsMessage) String() string {
+	return fmt.Sprintf("[BlockTxs H:%v R:%v NTxs:%v]", m.Height, m.Round, len(m.Txs))
+}
+
+// CommitMessage is sent to help a peer catch up with a commit whose precommits
+// can't be sent as individual votes: those of a stored commit carry no vote
+// extension, and those of an aggregated commit no signature of their own.
+type CommitMessage struct {
+	Commit *types.Commit
+}
+
+// ValidateBasic performs basic validation.
+func (m *CommitMessage) ValidateBasic() error {
+	if m.Commit == nil {
+		return errors.New("nil Commit")
+	}
+	if m.Commit.Height < 1 {
+		return errors.New("invalid Height")
+	}
+	if err := m.Commit.ValidateBasic(); err != nil {
+		return fmt.Errorf("wrong Commit: %w", err)
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *CommitMessage) String() string {
+	return fmt.Sprintf("[Commit H:%v R:%v B:%v]", m.Commit.Height, m.Commit.Round, m.Commit.BlockID)
+}
+
 // MsgToProto takes a consensus message type and returns the proto defined
 // consensus message.
 //
@@ -436,6 +572,63 @@ func MsgToProto(msg Message) (*tmcons.Message, error) {
 			Sum: vsb,
 		}
 
+	case *CompactBlockMessage:
+		evidence := types.EvidenceData{Evidence: msg.Evidence}
+		pbEvidence, err := evidence.ToProto()
+		if err != nil {
+			return nil, fmt.Errorf("msg to proto error: %w", err)
+		}
+
+		txKeys := make([][]byte, len(msg.TxKeys))
+		for i := range msg.TxKeys {
+			txKeys[i] = msg.TxKeys[i][:]
+		}
+
+		pb = tmcons.Message{
+			Sum: &tmcons.Message_CompactBlock{
+				CompactBlock: &tmcons.CompactBlock{
+					Height:     msg.Height,
+					Round:      msg.Round,
+					Header:     *msg.Header.ToProto(),
+					TxKeys:     txKeys,
+					Evidence:   *pbEvidence,
+					LastCommit: msg.LastCommit.ToProto(),
+				},
+			},
+		}
+
+	case *BlockTxsRequestMessage:
+		pb = tmcons.Message{
+			Sum: &tmcons.Message_BlockTxsRequest{
+				BlockTxsRequest: &tmcons.BlockTxsRequest{
+					Height:  msg.Height,
+					Round:   msg.Round,
+					Indexes: msg.Indexes,
+				},
+			},
+		}
+
+	case *BlockTxsMessage:
+		pb = tmcons.Message{
+			Sum: &tmcons.Message_BlockTxs{
+				BlockTxs: &tmcons.BlockTxs{
+					Height:  msg.Height,
+					Round:   msg.Round,
+					Indexes: msg.Indexes,
+					Txs:     msg.Txs.ToSliceOfBytes(),
+				},
+			},
+		}
+
+	case *CommitMessage:
+		pb = tmcons.Message{
+			Sum: &tmcons.Message_Commit{
+				Commit: &tmcons.Commit{
+					Commit: msg.Commit.ToProto(),
+				},
+			},
+		}
+
 	default:
 		return nil, fmt.Errorf("consensus: message not recognized: %T", msg)
 	}
@@ -558,6 +751,63 @@ func MsgFromProto(msg *tmcons.Message) (Message, error) {
 			BlockID: *bi,
 			Votes:   bits,
 		}
+	case *tmcons.Message_CompactBlock:
+		header, err := types.HeaderFromProto(&msg.CompactBlock.Header)
+		if err != nil {
+			return nil, fmt.Errorf("header to proto error: %w", err)
+		}
+
+		txKeys := make([]types.TxKey, len(msg.CompactBlock.TxKeys))
+		for i, bz := range msg.CompactBlock.TxKeys {
+			if len(bz) != len(txKeys[i]) {
+				return nil, fmt.Errorf("invalid tx key length: %d", len(bz))
+			}
+			copy(txKeys[i][:], bz)
+		}
+
+		var evidence types.EvidenceData
+		if err := evidence.FromProto(&msg.CompactBlock.Evidence); err != nil {
+			return nil, fmt.Errorf("evidence to proto error: %w", err)
+		}
+
+		var lastCommit *types.Commit
+		if msg.CompactBlock.LastCommit != nil {
+			lastCommit, err = types.CommitFromProto(msg.CompactBlock.LastCommit)
+			if err != nil {
+				return nil, fmt.Errorf("last commit to proto error: %w", err)
+			}
+		}
+
+		pb = &CompactBlockMessage{
+			Height:     msg.CompactBlock.Height,
+			Round:      msg.CompactBlock.Round,
+			Header:     header,
+			TxKeys:     txKeys,
+			Evidence:   evidence.Evidence,
+			LastCommit: lastCommit,
+		}
+	case *tmcons.Message_BlockTxsRequest:
+		pb = &BlockTxsRequestMessage{
+			Height:  msg.BlockTxsRequest.Height,
+			Round:   msg.BlockTxsRequest.Round,
+			Indexes: msg.BlockTxsRequest.Indexes,
+		}
+	case *tmcons.Message_BlockTxs:
+		pb = &BlockTxsMessage{
+			Height:  msg.BlockTxs.Height,
+			Round:   msg.BlockTxs.Round,
+			Indexes: msg.BlockTxs.Indexes,
+			Txs:     types.ToTxs(msg.BlockTxs.Txs),
+		}
+	case *tmcons.Message_Commit:
+		commit, err := types.CommitFromProto(msg.Commit.Commit)
+		if err != nil {
+			return nil, fmt.Errorf("commit msg to proto error: %w", err)
+		}
+
+		pb = &CommitMessage{
+			Commit: commit,
+		}
 	default:
 		return nil, fmt.Errorf("consensus: message not recognized: %T", msg)
 	}
@@ -592,8 +842,9 @@ func WALToProto(msg WALMessage) (*tmcons.WALMessage, error) {
 		pb = tmcons.WALMessage{
 			Sum: &tmcons.WALMessage_MsgInfo{
 				MsgInfo: &tmcons.MsgInfo{
-					Msg:    *consMsg,
-					PeerID: string(msg.PeerID),
+					Msg:         *consMsg,
+					PeerID:      string(msg.PeerID),
+					ReceiveTime: msg.ReceiveTime,
 				},
 			},
 		}
@@ -649,8 +900,9 @@ func WALFromProto(msg *tmcons.WALMessage) (WALMessage, error) {
 			return nil, fmt.Errorf("msgInfo from proto error: %w", err)
 		}
 		pb = msgInfo{
-			Msg:    walMsg,
-			PeerID: types.NodeID(msg.MsgInfo.PeerID),
+			Msg:         walMsg,
+			PeerID:      types.NodeID(msg.MsgInfo.PeerID),
+			ReceiveTime: msg.MsgInfo.ReceiveTime,
 		}
 
 	case *tmcons.WALMessage_TimeoutInfo:
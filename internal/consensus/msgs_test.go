@@ -187,6 +187,34 @@ func TestMsgToProto(t *testing.T) {
 				},
 			},
 		}, false},
+		{"successful BlockTxsRequest", &BlockTxsRequestMessage{
+			Height:  1,
+			Round:   1,
+			Indexes: []uint32{0, 2},
+		}, &tmcons.Message{
+			Sum: &tmcons.Message_BlockTxsRequest{
+				BlockTxsRequest: &tmcons.BlockTxsRequest{
+					Height:  1,
+					Round:   1,
+					Indexes: []uint32{0, 2},
+				},
+			},
+		}, false},
+		{"successful BlockTxs", &BlockTxsMessage{
+			Height:  1,
+			Round:   1,
+			Indexes: []uint32{0, 2},
+			Txs:     types.Txs{types.Tx("a"), types.Tx("c")},
+		}, &tmcons.Message{
+			Sum: &tmcons.Message_BlockTxs{
+				BlockTxs: &tmcons.BlockTxs{
+					Height:  1,
+					Round:   1,
+					Indexes: []uint32{0, 2},
+					Txs:     [][]byte{[]byte("a"), []byte("c")},
+				},
+			},
+		}, false},
 		{"failure", nil, &tmcons.Message{}, true},
 	}
 	for _, tt := range testsCases {
@@ -212,6 +240,52 @@ func TestMsgToProto(t *testing.T) {
 	}
 }
 
+func TestCompactBlockMessageBlock(t *testing.T) {
+	header, err := factory.MakeHeader(&types.Header{Height: 2})
+	require.NoError(t, err)
+
+	txs := types.Txs{types.Tx("a"), types.Tx("b"), types.Tx("c")}
+	block := &types.Block{
+		Header:     *header,
+		Data:       types.Data{Txs: txs},
+		LastCommit: &types.Commit{},
+	}
+	parts, err := block.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+
+	txKeys := make([]types.TxKey, len(txs))
+	for i, tx := range txs {
+		txKeys[i] = tx.Key()
+	}
+
+	pb, err := MsgToProto(&CompactBlockMessage{
+		Height:     2,
+		Round:      1,
+		Header:     block.Header,
+		TxKeys:     txKeys,
+		LastCommit: block.LastCommit,
+	})
+	require.NoError(t, err)
+
+	msg, err := MsgFromProto(pb)
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+
+	cbMsg, ok := msg.(*CompactBlockMessage)
+	require.True(t, ok)
+	require.Equal(t, txKeys, cbMsg.TxKeys)
+
+	// the block rebuilt from the transactions has the same parts
+	rebuilt, err := cbMsg.Block(txs).MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+	require.Equal(t, parts.Header(), rebuilt.Header())
+
+	// a tx key of the wrong length is rejected
+	pb.GetCompactBlock().TxKeys[0] = []byte("a")
+	_, err = MsgFromProto(pb)
+	require.Error(t, err)
+}
+
 func TestWALMsgProto(t *testing.T) {
 
 	parts := types.Part{
@@ -698,6 +772,79 @@ func TestBlockPartMessageValidateBasic(t *testing.T) {
 	assert.Equal(t, true, message.ValidateBasic() != nil, "Validate Basic had an unexpected result")
 }
 
+func TestBlockTxsMessageValidateBasic(t *testing.T) {
+	testCases := []struct {
+		testName  string
+		message   BlockTxsMessage
+		expectErr bool
+	}{
+		{"Valid Message", BlockTxsMessage{Indexes: []uint32{0}, Txs: types.Txs{types.Tx("a")}}, false},
+		{"Invalid Height", BlockTxsMessage{Height: -1}, true},
+		{"Invalid Round", BlockTxsMessage{Round: -1}, true},
+		{"Missing Tx", BlockTxsMessage{Indexes: []uint32{0, 1}, Txs: types.Txs{types.Tx("a")}}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			assert.Equal(t, tc.expectErr, tc.message.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+		})
+	}
+}
+
+func TestCommitMessageValidateBasic(t *testing.T) {
+	makeCommit := func(aggregated bool) *types.Commit {
+		commit := &types.Commit{
+			Height: 1,
+			BlockID: types.BlockID{
+				Hash:          tmrand.Bytes(tmhash.Size),
+				PartSetHeader: types.PartSetHeader{Total: 1, Hash: tmrand.Bytes(tmhash.Size)},
+			},
+			Signatures: []types.CommitSig{{
+				BlockIDFlag:      types.BlockIDFlagCommit,
+				ValidatorAddress: tmrand.Bytes(20),
+				Timestamp:        time.Now(),
+				Signature:        tmrand.Bytes(64),
+			}},
+		}
+		if aggregated {
+			commit.Signatures[0].Signature = nil
+			commit.AggregatedSignature = tmrand.Bytes(96)
+			commit.AggregatedSigners = bits.NewBitArray(1)
+			commit.AggregatedSigners.SetIndex(0, true)
+		}
+		return commit
+	}
+
+	testCases := []struct {
+		testName   string
+		malleateFn func(*types.Commit) *types.Commit
+		aggregated bool
+		expectErr  bool
+	}{
+		{"Valid Message", func(c *types.Commit) *types.Commit { return c }, true, false},
+		{"Nil Commit", func(*types.Commit) *types.Commit { return nil }, true, true},
+		{"Invalid Height", func(c *types.Commit) *types.Commit { c.Height = 0; return c }, true, true},
+		{"Valid Not Aggregated", func(c *types.Commit) *types.Commit { return c }, false, false},
+		{"Missing Signature", func(c *types.Commit) *types.Commit {
+			c.Signatures[0].Signature = nil
+			return c
+		}, false, true},
+		{"Wrong Signers", func(c *types.Commit) *types.Commit {
+			c.AggregatedSigners.SetIndex(0, false)
+			return c
+		}, true, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			message := CommitMessage{Commit: tc.malleateFn(makeCommit(tc.aggregated))}
+			assert.Equal(t, tc.expectErr, message.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+		})
+	}
+}
+
 func TestHasVoteMessageValidateBasic(t *testing.T) {
 	const (
 		validSignedMsgType   tmproto.SignedMsgType = 0x01
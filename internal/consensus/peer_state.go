@@ -45,6 +45,15 @@ type PeerState struct {
 	PRS     cstypes.PeerRoundState `json:"round_state"`
 	Stats   *peerStateStats        `json:"stats"`
 
+	// compactBlocks is true if the peer opened the CompactBlockChannel, and
+	// compactBlockSent holds the height and round of the last compact block
+	// sent to the peer.
+	compactBlocks    bool
+	compactBlockSent struct {
+		height int64
+		round  int32
+	}
+
 	broadcastWG sync.WaitGroup
 	closer      *tmsync.Closer
 }
@@ -82,6 +91,37 @@ func (ps *PeerState) IsRunning() bool {
 	return ps.running
 }
 
+// SetCompactBlocks sets whether the peer supports compact blocks.
+func (ps *PeerState) SetCompactBlocks(v bool) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	ps.compactBlocks = v
+}
+
+// CompactBlocks returns true if the peer supports compact blocks.
+func (ps *PeerState) CompactBlocks() bool {
+	ps.mtx.RLock()
+	defer ps.mtx.RUnlock()
+
+	return ps.compactBlocks
+}
+
+// SetCompactBlockSent records that the compact block for the given height and
+// round is sent to the peer. It returns false if it was already sent.
+func (ps *PeerState) SetCompactBlockSent(height int64, round int32) bool {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if ps.compactBlockSent.height == height && ps.compactBlockSent.round == round {
+		return false
+	}
+
+	ps.compactBlockSent.height = height
+	ps.compactBlockSent.round = round
+	return true
+}
+
 // GetRoundState returns a shallow copy of the PeerRoundState. There's no point
 // in mutating it since it won't change PeerState.
 func (ps *PeerState) GetRoundState() *cstypes.PeerRoundState {
@@ -202,6 +242,28 @@ func (ps *PeerState) PickVoteToSend(votes types.VoteSetReader) (*types.Vote, boo
 	return nil, false
 }
 
+// PickCommitToSend returns true if the peer lacks any of the precommits of the
+// aggregated commit, which are all sent at once with the commit.
+func (ps *PeerState) PickCommitToSend(commit *types.Commit) bool {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if commit.Size() == 0 {
+		return false
+	}
+
+	ps.ensureCatchupCommitRound(commit.Height, commit.Round, commit.Size())
+	ps.ensureVoteBitArrays(commit.Height, commit.Size())
+
+	psVotes := ps.getVoteBitArray(commit.Height, commit.Round, tmproto.PrecommitType)
+	if psVotes == nil {
+		return false // not something worth sending
+	}
+
+	_, ok := commit.BitArray().Sub(psVotes).PickRandom()
+	return ok
+}
+
 func (ps *PeerState) getVoteBitArray(height int64, round int32, votesType tmproto.SignedMsgType) *bits.BitArray {
 	if !types.IsVoteTypeValid(votesType) {
 		return nil
@@ -370,6 +432,18 @@ func (ps *PeerState) SetHasVote(vote *types.Vote) {
 	ps.setHasVote(vote.Height, vote.Round, vote.Type, vote.ValidatorIndex)
 }
 
+// SetHasCommit sets the given commit's precommits as known for the peer.
+func (ps *PeerState) SetHasCommit(commit *types.Commit) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	for idx, commitSig := range commit.Signatures {
+		if !commitSig.Absent() {
+			ps.setHasVote(commit.Height, commit.Round, tmproto.PrecommitType, int32(idx))
+		}
+	}
+}
+
 func (ps *PeerState) setHasVote(height int64, round int32, voteType tmproto.SignedMsgType, index int32) {
 	logger := ps.logger.With(
 		"peerH/R", fmt.Sprintf("%d/%d", ps.PRS.Height, ps.PRS.Round),
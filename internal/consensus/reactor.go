@@ -10,12 +10,14 @@ import (
 
 	cstypes "github.com/tendermint/tendermint/internal/consensus/types"
 	"github.com/tendermint/tendermint/internal/eventbus"
+	"github.com/tendermint/tendermint/internal/mempool"
 	"github.com/tendermint/tendermint/internal/p2p"
 	sm "github.com/tendermint/tendermint/internal/state"
 	"github.com/tendermint/tendermint/libs/bits"
 	tmevents "github.com/tendermint/tendermint/libs/events"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/libs/service"
+	tmtime "github.com/tendermint/tendermint/libs/time"
 	tmcons "github.com/tendermint/tendermint/proto/tendermint/consensus"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	"github.com/tendermint/tendermint/types"
@@ -65,6 +67,14 @@ func GetChannelDescriptors() []*p2p.ChannelDescriptor {
 			RecvBufferCapacity:  128,
 			RecvMessageCapacity: maxMsgSize,
 		},
+		{
+			ID:                  CompactBlockChannel,
+			MessageType:         new(tmcons.Message),
+			Priority:            12,
+			SendQueueCapacity:   64,
+			RecvBufferCapacity:  128,
+			RecvMessageCapacity: maxMsgSize,
+		},
 	}
 }
 
@@ -74,6 +84,11 @@ const (
 	VoteChannel        = p2p.ChannelID(0x22)
 	VoteSetBitsChannel = p2p.ChannelID(0x23)
 
+	// CompactBlockChannel carries the requests for the transactions of compact
+	// blocks, and their responses. Peers that open it also receive compact
+	// blocks on the DataChannel.
+	CompactBlockChannel = p2p.ChannelID(0x24)
+
 	maxMsgSize = 1048576 // 1MB; NOTE: keep in sync with types.PartSet sizes.
 
 	blocksToContributeToBecomeGoodPeer = 10000
@@ -115,6 +130,7 @@ type Reactor struct {
 
 	state    *State
 	eventBus *eventbus.EventBus
+	mempool  mempool.Mempool
 	Metrics  *Metrics
 
 	mtx      sync.RWMutex
@@ -125,7 +141,12 @@ type Reactor struct {
 	dataCh        *p2p.Channel
 	voteCh        *p2p.Channel
 	voteSetBitsCh *p2p.Channel
+	compactCh     *p2p.Channel
 	peerUpdates   *p2p.PeerUpdates
+
+	// compactMtx guards compactBlock, the last compact block received.
+	compactMtx   sync.Mutex
+	compactBlock *compactBlock
 }
 
 // NewReactor returns a reference to a new consensus reactor, which implements
@@ -139,6 +160,7 @@ func NewReactor(
 	dataCh *p2p.Channel,
 	voteCh *p2p.Channel,
 	voteSetBitsCh *p2p.Channel,
+	compactCh *p2p.Channel,
 	peerUpdates *p2p.PeerUpdates,
 	waitSync bool,
 	options ...ReactorOption,
@@ -154,6 +176,7 @@ func NewReactor(
 		dataCh:        dataCh,
 		voteCh:        voteCh,
 		voteSetBitsCh: voteSetBitsCh,
+		compactCh:     compactCh,
 		peerUpdates:   peerUpdates,
 	}
 	r.BaseService = *service.NewBaseService(logger, "Consensus", r)
@@ -190,6 +213,7 @@ func (r *Reactor) OnStart(ctx context.Context) error {
 	go r.processDataCh(ctx)
 	go r.processVoteCh(ctx)
 	go r.processVoteSetBitsCh(ctx)
+	go r.processCompactCh(ctx)
 	go r.processPeerUpdates(ctx)
 
 	return nil
@@ -241,6 +265,13 @@ func ReactorMetrics(metrics *Metrics) ReactorOption {
 	return func(r *Reactor) { r.Metrics = metrics }
 }
 
+// ReactorMempool sets the mempool the reactor looks up the transactions of
+// compact blocks in as an option function. Without it, all the transactions
+// of compact blocks are fetched from the peer.
+func ReactorMempool(mp mempool.Mempool) ReactorOption {
+	return func(r *Reactor) { r.mempool = mp }
+}
+
 // SwitchToConsensus switches from block-sync mode to consensus mode. It resets
 // the state, turns off block-sync, and starts the consensus state-machine.
 func (r *Reactor) SwitchToConsensus(ctx context.Context, state sm.State, skipWAL bool) {
@@ -511,6 +542,14 @@ OUTER_LOOP:
 		rs := r.state.GetRoundState()
 		prs := ps.GetRoundState()
 
+		// Send a compact block of the proposal block? The peer still receives
+		// the block parts, in case it fails to rebuild the block.
+		if r.shouldSendCompactBlock(rs, prs, ps) {
+			if err := r.sendCompactBlock(ctx, rs, ps); err != nil {
+				return
+			}
+		}
+
 		// Send proposal Block parts?
 		if rs.ProposalBlockParts.HasHeader(prs.ProposalBlockPartSetHeader) {
 			if index, ok := rs.ProposalBlockParts.BitArray().Sub(prs.ProposalBlockParts.Copy()).PickRandom(); ok {
@@ -648,6 +687,12 @@ OUTER_LOOP:
 // pickSendVote picks a vote and sends it to the peer. It will return true if
 // there is a vote to send and false otherwise.
 func (r *Reactor) pickSendVote(ctx context.Context, ps *PeerState, votes types.VoteSetReader) (bool, error) {
+	// the precommits of a commit can't be sent as individual votes, so the
+	// commit is sent in their place
+	if commit := catchupCommit(votes); commit != nil {
+		return r.pickSendCommit(ctx, ps, commit)
+	}
+
 	vote, ok := ps.PickVoteToSend(votes)
 	if !ok {
 		return false, nil
@@ -667,6 +712,47 @@ func (r *Reactor) pickSendVote(ctx context.Context, ps *PeerState, votes types.V
 	return true, nil
 }
 
+// pickSendCommit sends a commit to the peer if it lacks any of its precommits.
+func (r *Reactor) pickSendCommit(ctx context.Context, ps *PeerState, commit *types.Commit) (bool, error) {
+	if !ps.PickCommitToSend(commit) {
+		return false, nil
+	}
+
+	r.logger.Debug("sending commit message", "ps", ps, "height", commit.Height, "round", commit.Round)
+	if err := r.voteCh.Send(ctx, p2p.Envelope{
+		To: ps.peerID,
+		Message: &tmcons.Commit{
+			Commit: commit.ToProto(),
+		},
+	}); err != nil {
+		return false, err
+	}
+
+	ps.SetHasCommit(commit)
+	return true, nil
+}
+
+// catchupCommit returns the commit to send in place of the votes, if their
+// precommits can't be sent as individual votes. Those of a stored commit, or
+// of a last commit reconstructed from one, carry no vote extension, which
+// peers require of individual precommits, and those of an aggregated commit
+// have no signature of their own.
+func catchupCommit(votes types.VoteSetReader) *types.Commit {
+	switch votes := votes.(type) {
+	case *types.Commit:
+		return votes
+	case *types.VoteSet:
+		if commit := votes.AggregatedCommit(); commit != nil {
+			return commit
+		}
+		if votes.Type() == byte(tmproto.PrecommitType) && !votes.ExtensionsEnabled() &&
+			votes.HasTwoThirdsMajority() {
+			return votes.MakeCommit()
+		}
+	}
+	return nil
+}
+
 func (r *Reactor) gossipVotesForHeight(
 	ctx context.Context,
 	rs *cstypes.RoundState,
@@ -1023,6 +1109,7 @@ func (r *Reactor) processPeerUpdate(ctx context.Context, peerUpdate p2p.PeerUpda
 			ps = NewPeerState(r.logger, peerUpdate.NodeID)
 			r.peers[peerUpdate.NodeID] = ps
 		}
+		ps.SetCompactBlocks(peerUpdate.Channels.Contains(CompactBlockChannel))
 
 		if !ps.IsRunning() {
 			// Set the peer state's closer to signal to all spawned goroutines to exit
@@ -1178,7 +1265,7 @@ func (r *Reactor) handleDataMessage(ctx context.Context, envelope *p2p.Envelope,
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case r.state.peerMsgQueue <- msgInfo{pMsg, envelope.From}:
+		case r.state.peerMsgQueue <- msgInfo{pMsg, envelope.From, tmtime.Now()}:
 		}
 	case *tmcons.ProposalPOL:
 		ps.ApplyProposalPOLMessage(msgI.(*ProposalPOLMessage))
@@ -1188,11 +1275,13 @@ func (r *Reactor) handleDataMessage(ctx context.Context, envelope *p2p.Envelope,
 		ps.SetHasProposalBlockPart(bpMsg.Height, bpMsg.Round, int(bpMsg.Part.Index))
 		r.Metrics.BlockParts.With("peer_id", string(envelope.From)).Add(1)
 		select {
-		case r.state.peerMsgQueue <- msgInfo{bpMsg, envelope.From}:
+		case r.state.peerMsgQueue <- msgInfo{bpMsg, envelope.From, tmtime.Now()}:
 			return nil
 		case <-ctx.Done():
 			return ctx.Err()
 		}
+	case *tmcons.CompactBlock:
+		return r.handleCompactBlock(ctx, ps, msgI.(*CompactBlockMessage))
 
 	default:
 		return fmt.Errorf("received unknown message on DataChannel: %T", msg)
@@ -1232,7 +1321,19 @@ func (r *Reactor) handleVoteMessage(ctx context.Context, envelope *p2p.Envelope,
 		ps.SetHasVote(vMsg.Vote)
 
 		select {
-		case r.state.peerMsgQueue <- msgInfo{vMsg, envelope.From}:
+		case r.state.peerMsgQueue <- msgInfo{vMsg, envelope.From, tmtime.Now()}:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	case *tmcons.Commit:
+		cMsg := msgI.(*CommitMessage)
+
+		ps.EnsureVoteBitArrays(cMsg.Commit.Height, cMsg.Commit.Size())
+		ps.SetHasCommit(cMsg.Commit)
+
+		select {
+		case r.state.peerMsgQueue <- msgInfo{cMsg, envelope.From, tmtime.Now()}:
 			return nil
 		case <-ctx.Done():
 			return ctx.Err()
@@ -1346,6 +1447,9 @@ func (r *Reactor) handleMessage(ctx context.Context, chID p2p.ChannelID, envelop
 	case VoteSetBitsChannel:
 		err = r.handleVoteSetBitsMessage(ctx, envelope, msgI)
 
+	case CompactBlockChannel:
+		err = r.handleCompactBlockMessage(ctx, envelope, msgI)
+
 	default:
 		err = fmt.Errorf("unknown channel ID (%d) for envelope (%v)", chID, envelope)
 	}
@@ -1442,6 +1546,27 @@ func (r *Reactor) processVoteSetBitsCh(ctx context.Context) {
 	}
 }
 
+// processCompactCh initiates a blocking process where we listen for and handle
+// envelopes on the CompactBlockChannel. Any error encountered during message
+// execution will result in a PeerError being sent on the CompactBlockChannel.
+// When the reactor is stopped, we will catch the signal and close the p2p
+// Channel gracefully.
+func (r *Reactor) processCompactCh(ctx context.Context) {
+	iter := r.compactCh.Receive(ctx)
+	for iter.Next(ctx) {
+		envelope := iter.Envelope()
+		if err := r.handleMessage(ctx, r.compactCh.ID, envelope); err != nil {
+			r.logger.Error("failed to process message", "ch_id", r.compactCh.ID, "envelope", envelope, "err", err)
+			if serr := r.compactCh.SendError(ctx, p2p.PeerError{
+				NodeID: envelope.From,
+				Err:    err,
+			}); serr != nil {
+				return
+			}
+		}
+	}
+}
+
 // processPeerUpdates initiates a blocking process where we listen for and handle
 // PeerUpdate messages. When the reactor is stopped, we will catch the signal and
 // close the p2p PeerUpdatesCh gracefully.
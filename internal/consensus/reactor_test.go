@@ -31,6 +31,7 @@ import (
 	"github.com/tendermint/tendermint/internal/test/factory"
 	"github.com/tendermint/tendermint/libs/log"
 	tmcons "github.com/tendermint/tendermint/proto/tendermint/consensus"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	"github.com/tendermint/tendermint/types"
 )
 
@@ -48,6 +49,7 @@ type reactorTestSuite struct {
 	dataChannels        map[types.NodeID]*p2p.Channel
 	voteChannels        map[types.NodeID]*p2p.Channel
 	voteSetBitsChannels map[types.NodeID]*p2p.Channel
+	compactChannels     map[types.NodeID]*p2p.Channel
 }
 
 func chDesc(chID p2p.ChannelID, size int) *p2p.ChannelDescriptor {
@@ -79,6 +81,7 @@ func setup(
 	rts.dataChannels = rts.network.MakeChannelsNoCleanup(ctx, t, chDesc(DataChannel, size))
 	rts.voteChannels = rts.network.MakeChannelsNoCleanup(ctx, t, chDesc(VoteChannel, size))
 	rts.voteSetBitsChannels = rts.network.MakeChannelsNoCleanup(ctx, t, chDesc(VoteSetBitsChannel, size))
+	rts.compactChannels = rts.network.MakeChannelsNoCleanup(ctx, t, chDesc(CompactBlockChannel, size))
 
 	ctx, cancel := context.WithCancel(ctx)
 	// Canceled during cleanup (see below).
@@ -94,8 +97,10 @@ func setup(
 			rts.dataChannels[nodeID],
 			rts.voteChannels[nodeID],
 			rts.voteSetBitsChannels[nodeID],
+			rts.compactChannels[nodeID],
 			node.MakePeerUpdates(ctx, t),
 			true,
+			ReactorMempool(assertMempool(state.txNotifier)),
 		)
 
 		reactor.SetEventBus(state.eventBus)
@@ -585,6 +590,86 @@ func TestReactorRecordsVotesAndBlockParts(t *testing.T) {
 	require.Greater(t, ps.VotesSent(), 0, "number of votes sent should've increased")
 }
 
+func TestReactorCompactBlocks(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg := configSetup(t)
+
+	n := 4
+	states, cleanup := randConsensusState(ctx, t,
+		cfg, n, "consensus_reactor_test",
+		newMockTickerFunc(true), newKVStore)
+	t.Cleanup(cleanup)
+
+	rts := setup(ctx, t, n, states, 100) // buffer must be large enough to not deadlock
+
+	for _, reactor := range rts.reactors {
+		state := reactor.state.GetState()
+		reactor.SwitchToConsensus(ctx, state, false)
+	}
+
+	blocksSubs := []eventbus.Subscription{}
+	for _, sub := range rts.subs {
+		blocksSubs = append(blocksSubs, sub)
+	}
+
+	// wait till everyone makes the first new block, which has no last commit
+	var wg sync.WaitGroup
+	for _, sub := range blocksSubs {
+		wg.Add(1)
+
+		go func(s eventbus.Subscription) {
+			defer wg.Done()
+			_, err := s.Next(ctx)
+			if !assert.NoError(t, err) {
+				cancel()
+			}
+		}(sub)
+	}
+
+	wg.Wait()
+
+	// the last node does not have the txs, so it fetches them when rebuilding
+	// compact blocks
+	txs := [][]byte{[]byte("a=1"), []byte("b=2"), []byte("c=3")}
+	for _, state := range states[:n-1] {
+		for _, tx := range txs {
+			require.NoError(t, assertMempool(state.txNotifier).CheckTx(ctx, tx, nil, mempool.TxInfo{}))
+		}
+	}
+
+	activeVals := make(map[string]struct{})
+	for i := 0; i < n; i++ {
+		pubKey, err := states[i].privValidator.GetPubKey(ctx)
+		require.NoError(t, err)
+		activeVals[string(pubKey.Address())] = struct{}{}
+	}
+
+	waitForAndValidateBlockWithTx(ctx, t, n, activeVals, blocksSubs, states, txs...)
+
+	var compactBlocksSent, compactBlocksRebuilt bool
+	for _, reactor := range rts.reactors {
+		for _, ps := range reactor.peers {
+			require.True(t, ps.CompactBlocks())
+
+			ps.mtx.RLock()
+			if ps.compactBlockSent.height > 0 {
+				compactBlocksSent = true
+			}
+			ps.mtx.RUnlock()
+		}
+
+		reactor.compactMtx.Lock()
+		if reactor.compactBlock != nil && len(reactor.compactBlock.missing) == 0 {
+			compactBlocksRebuilt = true
+		}
+		reactor.compactMtx.Unlock()
+	}
+	require.True(t, compactBlocksSent, "no compact block was sent")
+	require.True(t, compactBlocksRebuilt, "no compact block was rebuilt")
+}
+
 func TestReactorVotingPowerChange(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -834,3 +919,26 @@ func TestReactorValidatorSetChanges(t *testing.T) {
 
 	waitForBlockWithUpdatedValsAndValidateIt(ctx, t, nPeers, activeVals, blocksSubs, states)
 }
+
+func TestReactorCatchupCommit(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const chainID = "test_chain_id"
+	valSet, privVals := factory.RandValidatorSet(ctx, 4, 1)
+	voteSet := types.NewVoteSet(chainID, 1, 0, tmproto.PrecommitType, valSet)
+	commit, err := factory.MakeCommit(ctx, factory.MakeBlockID(), 1, 0, voteSet, privVals, time.Now())
+	require.NoError(t, err)
+
+	// the precommits of a stored commit, or of a last commit reconstructed
+	// from one, have no extension and are sent as a commit
+	assert.Equal(t, commit, catchupCommit(commit))
+	lastCommit := catchupCommit(types.CommitToVoteSet(chainID, commit, valSet))
+	require.NotNil(t, lastCommit)
+	assert.Equal(t, commit.BlockID, lastCommit.BlockID)
+	assert.Equal(t, commit.Signatures, lastCommit.Signatures)
+
+	// while extended precommits and prevotes are sent one by one
+	assert.Nil(t, catchupCommit(types.NewExtendedVoteSet(chainID, 1, 0, tmproto.PrecommitType, valSet)))
+	assert.Nil(t, catchupCommit(types.NewVoteSet(chainID, 1, 0, tmproto.PrevoteType, valSet)))
+}
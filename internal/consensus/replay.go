@@ -79,6 +79,10 @@ func (cs *State) readReplayMessage(ctx context.Context, msg *TimedWALMessage, ne
 			v := msg.Vote
 			cs.logger.Info("Replay: Vote", "height", v.Height, "round", v.Round, "type", v.Type,
 				"blockID", v.BlockID, "peer", peerID)
+		case *CommitMessage:
+			c := msg.Commit
+			cs.logger.Info("Replay: Commit", "height", c.Height, "round", c.Round,
+				"blockID", c.BlockID, "peer", peerID)
 		}
 
 		cs.handleMsg(ctx, m)
@@ -208,7 +212,8 @@ type Handshaker struct {
 	genDoc       *types.GenesisDoc
 	logger       log.Logger
 
-	nBlocks int // number of blocks applied to the state
+	nBlocks    int    // number of blocks applied to the state
+	appVersion uint64 // app version reported by the app
 }
 
 func NewHandshaker(
@@ -236,6 +241,11 @@ func (h *Handshaker) NBlocks() int {
 	return h.nBlocks
 }
 
+// AppVersion returns the version the app reported during the handshake.
+func (h *Handshaker) AppVersion() uint64 {
+	return h.appVersion
+}
+
 // TODO: retry the handshake/replay if it fails ?
 func (h *Handshaker) Handshake(ctx context.Context, proxyApp proxy.AppConns) error {
 
@@ -250,6 +260,7 @@ func (h *Handshaker) Handshake(ctx context.Context, proxyApp proxy.AppConns) err
 		return fmt.Errorf("got a negative last block height (%d) from the app", blockHeight)
 	}
 	appHash := res.LastBlockAppHash
+	h.appVersion = res.AppVersion
 
 	h.logger.Info("ABCI Handshake App Info",
 		"height", blockHeight,
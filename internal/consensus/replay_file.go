@@ -13,11 +13,14 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/tendermint/tendermint/config"
+	cstypes "github.com/tendermint/tendermint/internal/consensus/types"
 	"github.com/tendermint/tendermint/internal/eventbus"
+	auto "github.com/tendermint/tendermint/internal/libs/autofile"
 	"github.com/tendermint/tendermint/internal/proxy"
 	tmpubsub "github.com/tendermint/tendermint/internal/pubsub"
 	sm "github.com/tendermint/tendermint/internal/state"
 	"github.com/tendermint/tendermint/internal/store"
+	tmjson "github.com/tendermint/tendermint/libs/json"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/types"
 )
@@ -78,105 +81,215 @@ func (cs *State) ReplayFile(ctx context.Context, file string, console bool) erro
 		}
 	}()
 
-	// just open the file for reading, no need to use wal
-	fp, err := os.OpenFile(file, os.O_RDONLY, 0600)
+	pb, err := newPlayback(file, cs, cs.state.Copy(), newStepSub)
 	if err != nil {
 		return err
 	}
+	defer pb.wal.Close()
 
-	pb := newPlayback(file, fp, cs, cs.state.Copy())
-	defer pb.fp.Close()
-
-	var nextN int // apply N msgs in a row
-	var msg *TimedWALMessage
-	for {
-		if nextN == 0 && console {
-			nextN, err = pb.replayConsoleLoop(ctx)
-			if err != nil {
-				return err
-			}
-		}
-
-		msg, err = pb.dec.Decode()
-		if err == io.EOF {
-			return nil
-		} else if err != nil {
-			return err
-		}
-
-		if err := pb.cs.readReplayMessage(ctx, msg, newStepSub); err != nil {
-			return err
-		}
-
-		if nextN > 0 {
-			nextN--
-		}
-		pb.count++
-	}
+	return pb.replay(ctx, console)
 }
 
 //------------------------------------------------
 // playback manager
 
 type playback struct {
-	cs *State
+	cs         *State
+	newStepSub eventbus.Subscription
 
-	fp    *os.File
+	wal   io.ReadCloser
 	dec   *WALDecoder
-	count int // how many lines/msgs into the file are we
+	next  *TimedWALMessage // the message to replay next, once decoded
+	count int              // how many lines/msgs into the file are we
 
 	// replays can be reset to beginning
 	fileName     string   // so we can close/reopen the file
 	genesisState sm.State // so the replay session knows where to restart from
+
+	// console
+	in          *bufio.Reader
+	out         io.Writer
+	breakpoints []breakpoint
+	lastBreakID int   // breakpoints are numbered from 1 and never renumbered
+	remaining   int   // msgs to replay before returning to the console, -1 for no limit
+	untilHeight int64 // return to the console once the state reaches this height
+	trace       bool  // print every replayed message as JSON
 }
 
-func newPlayback(fileName string, fp *os.File, cs *State, genState sm.State) *playback {
+func newPlayback(
+	fileName string,
+	cs *State,
+	genState sm.State,
+	newStepSub eventbus.Subscription,
+) (*playback, error) {
+	wal, err := openWALForReplay(fileName)
+	if err != nil {
+		return nil, err
+	}
 	return &playback{
 		cs:           cs,
-		fp:           fp,
+		newStepSub:   newStepSub,
+		wal:          wal,
+		dec:          NewWALDecoder(wal),
 		fileName:     fileName,
 		genesisState: genState,
-		dec:          NewWALDecoder(fp),
+		in:           bufio.NewReader(os.Stdin),
+		out:          os.Stdout,
+	}, nil
+}
+
+// walGroupReader reads all segments of a WAL, oldest first.
+type walGroupReader struct {
+	*auto.GroupReader
+	group *auto.Group
+}
+
+func (r walGroupReader) Close() error {
+	err := r.GroupReader.Close()
+	r.group.Close()
+	return err
+}
+
+// openWALForReplay opens all segments of the WAL for reading, no need to use
+// the wal.
+func openWALForReplay(fileName string) (io.ReadCloser, error) {
+	if _, err := os.Stat(fileName); err != nil {
+		return nil, err
+	}
+	group, err := auto.OpenGroup(log.NewNopLogger(), fileName)
+	if err != nil {
+		return nil, err
 	}
+	gr, err := group.NewReader(group.MinIndex())
+	if err != nil {
+		group.Close()
+		return nil, err
+	}
+	return walGroupReader{GroupReader: gr, group: group}, nil
 }
 
-// go back count steps by resetting the state and running (pb.count - count) steps
-func (pb *playback) replayReset(ctx context.Context, count int, newStepSub eventbus.Subscription) error {
-	if err := pb.cs.Stop(); err != nil {
+// replay replays the messages of the WAL. In console mode, the console is
+// entered before the first message and whenever a run ends: after the
+// requested number of messages, at a breakpoint, at the height given to
+// "until" or at the end of the WAL.
+func (pb *playback) replay(ctx context.Context, console bool) error {
+	resume := false
+	for {
+		msg, err := pb.peek()
+		if err == io.EOF {
+			if !console {
+				return nil
+			}
+			fmt.Fprintln(pb.out, "End of WAL")
+		} else if err != nil {
+			return err
+		}
+
+		if console && (msg == nil || (!resume && pb.shouldStop(msg))) {
+			quit, err := pb.replayConsoleLoop(ctx)
+			if err != nil || quit {
+				return err
+			}
+			// replay the message we stopped at, unless the console went back
+			resume = true
+			continue
+		}
+		resume = false
+
+		if pb.trace {
+			pb.printJSON(msg)
+		}
+		if err := pb.replayNext(ctx); err != nil {
+			return err
+		}
+		if pb.remaining > 0 {
+			pb.remaining--
+		}
+	}
+}
+
+// peek returns the message to replay next without replaying it.
+func (pb *playback) peek() (*TimedWALMessage, error) {
+	if pb.next == nil {
+		msg, err := pb.dec.Decode()
+		if err != nil {
+			return nil, err
+		}
+		pb.next = msg
+	}
+	return pb.next, nil
+}
+
+// replayNext replays the message returned by peek.
+func (pb *playback) replayNext(ctx context.Context) error {
+	msg := pb.next
+	pb.next = nil
+	if err := pb.cs.readReplayMessage(ctx, msg, pb.newStepSub); err != nil {
 		return err
 	}
-	pb.cs.Wait()
+	pb.count++
+	return nil
+}
+
+// shouldStop reports whether a console run stops before msg. A run is
+// finished by replaying the requested number of messages, by reaching the
+// height given to "until" or by a matching breakpoint.
+func (pb *playback) shouldStop(msg *TimedWALMessage) bool {
+	stop := pb.remaining == 0
+	if pb.untilHeight > 0 && pb.cs.Height >= pb.untilHeight {
+		fmt.Fprintf(pb.out, "Reached height %d\n", pb.cs.Height)
+		stop = true
+	}
+	for _, bp := range pb.breakpoints {
+		if bp.matches(msg.Msg) {
+			fmt.Fprintf(pb.out, "Breakpoint %d: %v\n", bp.id, bp)
+			stop = true
+		}
+	}
+	if stop {
+		pb.remaining = 0
+		pb.untilHeight = 0
+	}
+	return stop
+}
+
+// go back count steps by resetting the state and running (pb.count - count) steps
+func (pb *playback) replayReset(ctx context.Context, count int) error {
+	if pb.cs.IsRunning() {
+		if err := pb.cs.Stop(); err != nil {
+			return err
+		}
+		pb.cs.Wait()
+	}
 
 	newCS := NewState(ctx, pb.cs.logger, pb.cs.config, pb.genesisState.Copy(), pb.cs.blockExec,
 		pb.cs.blockStore, pb.cs.txNotifier, pb.cs.evpool)
 	newCS.SetEventBus(pb.cs.eventBus)
 	newCS.startForReplay()
 
-	if err := pb.fp.Close(); err != nil {
+	if err := pb.wal.Close(); err != nil {
 		return err
 	}
-	fp, err := os.OpenFile(pb.fileName, os.O_RDONLY, 0600)
+	wal, err := openWALForReplay(pb.fileName)
 	if err != nil {
 		return err
 	}
-	pb.fp = fp
-	pb.dec = NewWALDecoder(fp)
+	pb.wal = wal
+	pb.dec = NewWALDecoder(wal)
+	pb.next = nil
 	count = pb.count - count
-	fmt.Printf("Reseting from %d to %d\n", pb.count, count)
+	fmt.Fprintf(pb.out, "Reseting from %d to %d\n", pb.count, count)
 	pb.count = 0
 	pb.cs = newCS
-	var msg *TimedWALMessage
-	for i := 0; i < count; i++ {
-		msg, err = pb.dec.Decode()
-		if err == io.EOF {
+	for pb.count < count {
+		if _, err := pb.peek(); err == io.EOF {
 			return nil
 		} else if err != nil {
 			return err
 		}
-		if err := pb.cs.readReplayMessage(ctx, msg, newStepSub); err != nil {
+		if err := pb.replayNext(ctx); err != nil {
 			return err
 		}
-		pb.count++
 	}
 	return nil
 }
@@ -185,20 +298,43 @@ func (cs *State) startForReplay() {
 	cs.logger.Error("Replay commands are disabled until someone updates them and writes tests")
 }
 
-// console function for parsing input and running commands. The integer
-// return value is invalid unless the error is nil.
-func (pb *playback) replayConsoleLoop(ctx context.Context) (int, error) {
+const replayConsoleHelp = `Commands:
+  next [N]                  replay the next N messages (default 1)
+  continue                  replay until a breakpoint or the end of the WAL
+  until <height>            replay until the state reaches the height
+  back [N]                  go back N messages (default 1)
+  break                     list the breakpoints
+  break <cond> <value> ...  stop before messages that match all of the
+                            conditions: height, round, step or msg, one of
+                            step, proposal, block_part, vote, prevote,
+                            precommit, timeout and end_height
+  delete [N]                delete breakpoint N, or all of them
+  rs [short|<field>]        print the round state or one of its fields
+  print                     print the next message as JSON
+  print rs|votes|last_commit
+                            print the round state, the votes of the height
+                            or the last commit as JSON
+  trace on|off              print every replayed message as JSON
+  export <file>             write the round state as JSON to the file
+  n                         print the number of replayed messages
+  quit                      leave the console
+`
+
+// console function for parsing input and running commands. It returns once
+// the replay is to run on, or quit is set once it is to stop.
+func (pb *playback) replayConsoleLoop(ctx context.Context) (quit bool, err error) {
 	for {
-		fmt.Printf("> ")
-		bufReader := bufio.NewReader(os.Stdin)
-		line, more, err := bufReader.ReadLine()
+		fmt.Fprintf(pb.out, "> ")
+		line, more, err := pb.in.ReadLine()
 		if more {
-			return 0, fmt.Errorf("input is too long")
+			return false, fmt.Errorf("input is too long")
+		} else if err == io.EOF {
+			return true, nil
 		} else if err != nil {
-			return 0, err
+			return false, err
 		}
 
-		tokens := strings.Split(string(line), " ")
+		tokens := strings.Fields(string(line))
 		if len(tokens) == 0 {
 			continue
 		}
@@ -209,13 +345,39 @@ func (pb *playback) replayConsoleLoop(ctx context.Context) (int, error) {
 			// "next N" -> replay next N messages
 
 			if len(tokens) == 1 {
-				return 0, nil
+				pb.remaining = 1
+				return false, nil
 			}
 			i, err := strconv.Atoi(tokens[1])
-			if err != nil {
-				fmt.Println("next takes an integer argument")
+			if err != nil || i < 1 {
+				fmt.Fprintln(pb.out, "next takes a positive integer argument")
 			} else {
-				return i, nil
+				pb.remaining = i
+				return false, nil
+			}
+
+		case "continue":
+			// "continue" -> replay until a breakpoint or the end of the WAL
+
+			pb.remaining = -1
+			return false, nil
+
+		case "until":
+			// "until H" -> replay until the state reaches height H
+
+			if len(tokens) != 2 {
+				fmt.Fprintln(pb.out, "until takes a height argument")
+				continue
+			}
+			h, err := strconv.ParseInt(tokens[1], 10, 64)
+			if err != nil || h < 1 {
+				fmt.Fprintln(pb.out, "until takes a height argument")
+			} else if h <= pb.cs.Height {
+				fmt.Fprintf(pb.out, "the state is already at height %d\n", pb.cs.Height)
+			} else {
+				pb.remaining = -1
+				pb.untilHeight = h
+				return false, nil
 			}
 
 		case "back":
@@ -225,37 +387,54 @@ func (pb *playback) replayConsoleLoop(ctx context.Context) (int, error) {
 			// NOTE: "back" is not supported in the state machine design,
 			// so we restart and replay up to
 
-			// ensure all new step events are regenerated as expected
-
-			newStepSub, err := pb.cs.eventBus.SubscribeWithArgs(ctx, tmpubsub.SubscribeArgs{
-				ClientID: subscriber,
-				Query:    types.EventQueryNewRoundStep,
-			})
-			if err != nil {
-				return 0, fmt.Errorf("failed to subscribe %s to %v", subscriber, types.EventQueryNewRoundStep)
-			}
-			defer func() {
-				args := tmpubsub.UnsubscribeArgs{Subscriber: subscriber, Query: types.EventQueryNewRoundStep}
-				if err := pb.cs.eventBus.Unsubscribe(ctx, args); err != nil {
-					pb.cs.logger.Error("error unsubscribing from eventBus", "err", err)
-				}
-			}()
-
 			if len(tokens) == 1 {
-				if err := pb.replayReset(ctx, 1, newStepSub); err != nil {
+				if err := pb.replayReset(ctx, 1); err != nil {
 					pb.cs.logger.Error("Replay reset error", "err", err)
 				}
 			} else {
 				i, err := strconv.Atoi(tokens[1])
 				if err != nil {
-					fmt.Println("back takes an integer argument")
+					fmt.Fprintln(pb.out, "back takes an integer argument")
 				} else if i > pb.count {
-					fmt.Printf("argument to back must not be larger than the current count (%d)\n", pb.count)
-				} else if err := pb.replayReset(ctx, i, newStepSub); err != nil {
+					fmt.Fprintf(pb.out, "argument to back must not be larger than the current count (%d)\n", pb.count)
+				} else if err := pb.replayReset(ctx, i); err != nil {
 					pb.cs.logger.Error("Replay reset error", "err", err)
 				}
 			}
 
+		case "break":
+			// "break" -> list the breakpoints
+			// "break height H round R step S msg T" -> add a breakpoint
+			// with any of the conditions
+
+			if len(tokens) == 1 {
+				for _, bp := range pb.breakpoints {
+					fmt.Fprintf(pb.out, "%d: %v\n", bp.id, bp)
+				}
+				continue
+			}
+			bp, err := parseBreakpoint(tokens[1:])
+			if err != nil {
+				fmt.Fprintln(pb.out, err)
+			} else {
+				pb.lastBreakID++
+				bp.id = pb.lastBreakID
+				pb.breakpoints = append(pb.breakpoints, bp)
+				fmt.Fprintf(pb.out, "Breakpoint %d: %v\n", bp.id, bp)
+			}
+
+		case "delete":
+			// "delete" -> delete all breakpoints
+			// "delete N" -> delete breakpoint N
+
+			if len(tokens) == 1 {
+				pb.breakpoints = nil
+				continue
+			}
+			if !pb.deleteBreakpoint(tokens[1]) {
+				fmt.Fprintln(pb.out, "No breakpoint", tokens[1])
+			}
+
 		case "rs":
 			// "rs" -> print entire round state
 			// "rs short" -> print height/round/step
@@ -263,32 +442,271 @@ func (pb *playback) replayConsoleLoop(ctx context.Context) (int, error) {
 
 			rs := pb.cs.RoundState
 			if len(tokens) == 1 {
-				fmt.Println(rs)
+				fmt.Fprintln(pb.out, rs)
 			} else {
 				switch tokens[1] {
 				case "short":
-					fmt.Printf("%v/%v/%v\n", rs.Height, rs.Round, rs.Step)
+					fmt.Fprintf(pb.out, "%v/%v/%v\n", rs.Height, rs.Round, rs.Step)
 				case "validators":
-					fmt.Println(rs.Validators)
+					fmt.Fprintln(pb.out, rs.Validators)
 				case "proposal":
-					fmt.Println(rs.Proposal)
+					fmt.Fprintln(pb.out, rs.Proposal)
 				case "proposal_block":
-					fmt.Printf("%v %v\n", rs.ProposalBlockParts.StringShort(), rs.ProposalBlock.StringShort())
+					fmt.Fprintf(pb.out, "%v %v\n", rs.ProposalBlockParts.StringShort(), rs.ProposalBlock.StringShort())
 				case "locked_round":
-					fmt.Println(rs.LockedRound)
+					fmt.Fprintln(pb.out, rs.LockedRound)
 				case "locked_block":
-					fmt.Printf("%v %v\n", rs.LockedBlockParts.StringShort(), rs.LockedBlock.StringShort())
+					fmt.Fprintf(pb.out, "%v %v\n", rs.LockedBlockParts.StringShort(), rs.LockedBlock.StringShort())
 				case "votes":
-					fmt.Println(rs.Votes.StringIndented("  "))
+					fmt.Fprintln(pb.out, rs.Votes.StringIndented("  "))
 
 				default:
-					fmt.Println("Unknown option", tokens[1])
+					fmt.Fprintln(pb.out, "Unknown option", tokens[1])
+				}
+			}
+
+		case "print":
+			// "print" -> print the next message as JSON
+			// "print rs" -> print the round state as JSON
+			// "print votes" -> print the votes of the current height as JSON
+			// "print last_commit" -> print the precommits of the last height as JSON
+
+			if len(tokens) == 1 {
+				if pb.next == nil {
+					fmt.Fprintln(pb.out, "End of WAL")
+				} else {
+					pb.printJSON(pb.next)
 				}
+				continue
+			}
+			switch tokens[1] {
+			case "rs":
+				pb.printJSON(&pb.cs.RoundState)
+			case "votes":
+				pb.printJSON(pb.heightVotes())
+			case "last_commit":
+				pb.printJSON(pb.cs.LastCommit.GetVotes())
+			default:
+				fmt.Fprintln(pb.out, "Unknown option", tokens[1])
 			}
+
+		case "trace":
+			// "trace on|off" -> print every replayed message as JSON
+
+			if len(tokens) != 2 || (tokens[1] != "on" && tokens[1] != "off") {
+				fmt.Fprintln(pb.out, "trace takes on or off")
+			} else {
+				pb.trace = tokens[1] == "on"
+			}
+
+		case "export":
+			// "export <file>" -> write the round state as JSON to the file
+
+			if len(tokens) != 2 {
+				fmt.Fprintln(pb.out, "export takes a file argument")
+				continue
+			}
+			bz, err := pb.cs.GetRoundStateJSON()
+			if err == nil {
+				err = os.WriteFile(tokens[1], bz, 0644)
+			}
+			if err != nil {
+				fmt.Fprintln(pb.out, "Failed to export the round state:", err)
+			} else {
+				fmt.Fprintf(pb.out, "Exported %v/%v/%v to %s\n", pb.cs.Height, pb.cs.Round, pb.cs.Step, tokens[1])
+			}
+
 		case "n":
-			fmt.Println(pb.count)
+			fmt.Fprintln(pb.out, pb.count)
+
+		case "help":
+			fmt.Fprint(pb.out, replayConsoleHelp)
+
+		case "quit", "exit":
+			return true, nil
+
+		default:
+			fmt.Fprintf(pb.out, "Unknown command %q, see help\n", tokens[0])
+		}
+	}
+}
+
+// deleteBreakpoint deletes the breakpoint numbered id and reports whether it
+// existed.
+func (pb *playback) deleteBreakpoint(id string) bool {
+	for i, bp := range pb.breakpoints {
+		if strconv.Itoa(bp.id) == id {
+			pb.breakpoints = append(pb.breakpoints[:i], pb.breakpoints[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// heightVotes returns the prevotes and precommits of the current height,
+// ordered by round.
+func (pb *playback) heightVotes() []*types.Vote {
+	votes := []*types.Vote{}
+	for round := int32(0); round <= pb.cs.Votes.Round(); round++ {
+		for _, voteSet := range []*types.VoteSet{pb.cs.Votes.Prevotes(round), pb.cs.Votes.Precommits(round)} {
+			for _, vote := range voteSet.GetVotes() {
+				if vote != nil {
+					votes = append(votes, vote)
+				}
+			}
+		}
+	}
+	return votes
+}
+
+// printJSON prints v as a single line of JSON.
+func (pb *playback) printJSON(v interface{}) {
+	bz, err := tmjson.Marshal(v)
+	if err != nil {
+		fmt.Fprintln(pb.out, "Failed to marshal:", err)
+		return
+	}
+	fmt.Fprintln(pb.out, string(bz))
+}
+
+//--------------------------------------------------------------------------------
+// breakpoints
+
+// breakpoint stops a console run before a message that matches all of its
+// conditions. A condition that is not set matches any message.
+type breakpoint struct {
+	id      int
+	height  int64                 // 0 for any
+	round   int32                 // -1 for any
+	step    cstypes.RoundStepType // 0 for any
+	msgType string                // "" for any
+}
+
+// parseBreakpoint parses the condition and value pairs of a break command.
+func parseBreakpoint(tokens []string) (breakpoint, error) {
+	bp := breakpoint{round: -1}
+	if len(tokens)%2 != 0 {
+		return bp, errors.New("break takes pairs of a condition and a value")
+	}
+	for i := 0; i < len(tokens); i += 2 {
+		cond, value := tokens[i], tokens[i+1]
+		switch cond {
+		case "height":
+			h, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || h < 1 {
+				return bp, fmt.Errorf("invalid height %q", value)
+			}
+			bp.height = h
+		case "round":
+			r, err := strconv.ParseInt(value, 10, 32)
+			if err != nil || r < 0 {
+				return bp, fmt.Errorf("invalid round %q", value)
+			}
+			bp.round = int32(r)
+		case "step":
+			step, ok := parseRoundStep(value)
+			if !ok {
+				return bp, fmt.Errorf("invalid step %q", value)
+			}
+			bp.step = step
+		case "msg":
+			switch value {
+			case "step", "proposal", "block_part", "vote", "prevote", "precommit", "timeout", "end_height":
+				bp.msgType = value
+			default:
+				return bp, fmt.Errorf("invalid message type %q", value)
+			}
+		default:
+			return bp, fmt.Errorf("unknown condition %q", cond)
+		}
+	}
+	return bp, nil
+}
+
+func (bp breakpoint) matches(msg WALMessage) bool {
+	f := replayMsgFieldsOf(msg)
+	switch {
+	case bp.height != 0 && bp.height != f.height:
+		return false
+	case bp.round != -1 && (!f.hasRound || bp.round != f.round):
+		return false
+	case bp.step != 0 && bp.step != f.step:
+		return false
+	case bp.msgType != "" && bp.msgType != f.msgType && bp.msgType != f.voteType:
+		return false
+	}
+	return true
+}
+
+func (bp breakpoint) String() string {
+	var conds []string
+	if bp.height != 0 {
+		conds = append(conds, fmt.Sprintf("height %d", bp.height))
+	}
+	if bp.round != -1 {
+		conds = append(conds, fmt.Sprintf("round %d", bp.round))
+	}
+	if bp.step != 0 {
+		conds = append(conds, fmt.Sprintf("step %v", bp.step))
+	}
+	if bp.msgType != "" {
+		conds = append(conds, "msg "+bp.msgType)
+	}
+	if len(conds) == 0 {
+		return "any message"
+	}
+	return strings.Join(conds, " ")
+}
+
+// replayMsgFields are the fields of a WAL message breakpoints match on.
+type replayMsgFields struct {
+	msgType  string
+	voteType string // prevote or precommit
+	height   int64
+	round    int32
+	hasRound bool
+	step     cstypes.RoundStepType // only set for steps and timeouts
+}
+
+func replayMsgFieldsOf(msg WALMessage) replayMsgFields {
+	switch m := msg.(type) {
+	case types.EventDataRoundState:
+		step, _ := parseRoundStep(m.Step)
+		return replayMsgFields{msgType: "step", height: m.Height, round: m.Round, hasRound: true, step: step}
+	case msgInfo:
+		switch m := m.Msg.(type) {
+		case *ProposalMessage:
+			return replayMsgFields{msgType: "proposal", height: m.Proposal.Height, round: m.Proposal.Round, hasRound: true}
+		case *BlockPartMessage:
+			return replayMsgFields{msgType: "block_part", height: m.Height, round: m.Round, hasRound: true}
+		case *VoteMessage:
+			return replayMsgFields{
+				msgType:  "vote",
+				voteType: voteTypeString(m.Vote.Type),
+				height:   m.Vote.Height,
+				round:    m.Vote.Round,
+				hasRound: true,
+			}
+		}
+	case timeoutInfo:
+		return replayMsgFields{msgType: "timeout", height: m.Height, round: m.Round, hasRound: true, step: m.Step}
+	case EndHeightMessage:
+		return replayMsgFields{msgType: "end_height", height: m.Height}
+	}
+	return replayMsgFields{}
+}
+
+// parseRoundStep parses a step such as "prevote_wait" or its full name,
+// "RoundStepPrevoteWait", ignoring case.
+func parseRoundStep(s string) (cstypes.RoundStepType, bool) {
+	name := strings.ToLower(strings.ReplaceAll(s, "_", ""))
+	for step := cstypes.RoundStepNewHeight; step <= cstypes.RoundStepCommit; step++ {
+		full := strings.ToLower(step.String())
+		if name == full || "roundstep"+name == full {
+			return step, true
 		}
 	}
+	return 0, false
 }
 
 //--------------------------------------------------------------------------------
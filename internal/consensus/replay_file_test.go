@@ -0,0 +1,173 @@
+package consensus
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cstypes "github.com/tendermint/tendermint/internal/consensus/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestParseBreakpoint(t *testing.T) {
+	testCases := []struct {
+		tokens []string
+		bp     breakpoint
+		errMsg string
+	}{
+		{[]string{"height", "5"}, breakpoint{height: 5, round: -1}, ""},
+		{[]string{"round", "0", "step", "prevote_wait"},
+			breakpoint{round: 0, step: cstypes.RoundStepPrevoteWait}, ""},
+		{[]string{"step", "RoundStepCommit", "msg", "timeout"},
+			breakpoint{round: -1, step: cstypes.RoundStepCommit, msgType: "timeout"}, ""},
+		{[]string{"height"}, breakpoint{}, "pairs"},
+		{[]string{"height", "0"}, breakpoint{}, "invalid height"},
+		{[]string{"round", "-1"}, breakpoint{}, "invalid round"},
+		{[]string{"step", "vote"}, breakpoint{}, "invalid step"},
+		{[]string{"msg", "commit"}, breakpoint{}, "invalid message type"},
+		{[]string{"peer", "abc"}, breakpoint{}, "unknown condition"},
+	}
+	for _, tc := range testCases {
+		bp, err := parseBreakpoint(tc.tokens)
+		if tc.errMsg != "" {
+			require.Error(t, err, tc.tokens)
+			assert.Contains(t, err.Error(), tc.errMsg)
+			continue
+		}
+		require.NoError(t, err, tc.tokens)
+		assert.Equal(t, tc.bp, bp)
+	}
+}
+
+func TestBreakpointMatches(t *testing.T) {
+	prevote := msgInfo{Msg: &VoteMessage{&types.Vote{Type: tmproto.PrevoteType, Height: 3, Round: 1}}}
+	step := types.EventDataRoundState{Height: 3, Round: 1, Step: cstypes.RoundStepPrecommit.String()}
+	timeout := timeoutInfo{Height: 3, Round: 1, Step: cstypes.RoundStepPropose}
+	endHeight := EndHeightMessage{Height: 3}
+
+	testCases := []struct {
+		bp      breakpoint
+		matches []WALMessage
+	}{
+		{breakpoint{round: -1}, []WALMessage{prevote, step, timeout, endHeight}},
+		{breakpoint{height: 3, round: -1}, []WALMessage{prevote, step, timeout, endHeight}},
+		{breakpoint{height: 4, round: -1}, nil},
+		{breakpoint{round: 1}, []WALMessage{prevote, step, timeout}},
+		{breakpoint{round: -1, step: cstypes.RoundStepPrecommit}, []WALMessage{step}},
+		{breakpoint{round: -1, msgType: "vote"}, []WALMessage{prevote}},
+		{breakpoint{round: -1, msgType: "prevote"}, []WALMessage{prevote}},
+		{breakpoint{round: -1, msgType: "precommit"}, nil},
+		{breakpoint{height: 3, round: 1, msgType: "timeout"}, []WALMessage{timeout}},
+		{breakpoint{round: -1, msgType: "end_height"}, []WALMessage{endHeight}},
+	}
+	for _, tc := range testCases {
+		var matches []WALMessage
+		for _, msg := range []WALMessage{prevote, step, timeout, endHeight} {
+			if tc.bp.matches(msg) {
+				matches = append(matches, msg)
+			}
+		}
+		assert.Equal(t, tc.matches, matches, tc.bp.String())
+	}
+}
+
+func TestReplayConsole(t *testing.T) {
+	config := configSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cs, vss, err := randState(ctx, t, config, log.TestingLogger(), 2)
+	require.NoError(t, err)
+	height := cs.Height
+
+	vs := vss[1]
+	vs.Height = height
+	vote := signVote(ctx, vs, config, tmproto.PrevoteType, nil, types.PartSetHeader{})
+
+	walFile := filepath.Join(t.TempDir(), "wal")
+	wal, err := NewWAL(log.TestingLogger(), walFile)
+	require.NoError(t, err)
+	require.NoError(t, wal.Start(ctx))
+	for _, msg := range []WALMessage{
+		types.EventDataRoundState{Height: height, Round: 0, Step: cstypes.RoundStepPropose.String()},
+		msgInfo{Msg: &VoteMessage{vote}, PeerID: "peer"},
+		timeoutInfo{Duration: time.Second, Height: height + 5, Round: 0, Step: cstypes.RoundStepPropose},
+		types.EventDataRoundState{Height: height, Round: 1, Step: cstypes.RoundStepPrevote.String()},
+		EndHeightMessage{Height: height},
+	} {
+		require.NoError(t, wal.Write(msg))
+	}
+	require.NoError(t, wal.FlushAndSync())
+	require.NoError(t, wal.Stop())
+	wal.Wait()
+
+	exportFile := filepath.Join(t.TempDir(), "rs.json")
+	commands := []string{
+		"break msg prevote",
+		"break height " + itoa(height) + " round 1",
+		"continue",
+		"print",
+		"next",
+		"print votes",
+		"export " + exportFile,
+		"n",
+		"back 2",
+		"print votes",
+		"delete 1",
+		"until " + itoa(height+1),
+		"trace on",
+		"continue",
+		"quit",
+	}
+
+	pb, err := newPlayback(walFile, cs, cs.state.Copy(), nil)
+	require.NoError(t, err)
+	defer pb.wal.Close()
+	var out bytes.Buffer
+	pb.in = bufio.NewReader(strings.NewReader(strings.Join(commands, "\n") + "\n"))
+	pb.out = &out
+
+	require.NoError(t, pb.replay(ctx, true))
+
+	lines := strings.Split(strings.ReplaceAll(out.String(), "> ", ""), "\n")
+	require.GreaterOrEqual(t, len(lines), 13, out.String())
+
+	assert.Equal(t, "Breakpoint 1: msg prevote", lines[0])
+	assert.Equal(t, "Breakpoint 2: height "+itoa(height)+" round 1", lines[1])
+	// continue stops at the vote
+	assert.Equal(t, "Breakpoint 1: msg prevote", lines[2])
+	assert.Contains(t, lines[3], `"type":"tendermint/wal/MsgInfo"`)
+	assert.Contains(t, lines[3], `"peer_key":"peer"`)
+	// the vote is replayed and added to the round state
+	assert.Contains(t, lines[4], vote.ValidatorAddress.String())
+	assert.Equal(t, "Exported "+itoa(height)+"/0/RoundStepNewHeight to "+exportFile, lines[5])
+	assert.Equal(t, "3", lines[6])
+	// going back drops the vote
+	assert.Equal(t, "Reseting from 3 to 1", lines[7])
+	assert.Equal(t, "[]", lines[8])
+	// the height is not reached before the breakpoint
+	assert.Equal(t, "Breakpoint 2: height "+itoa(height)+" round 1", lines[9])
+	// the traced messages are printed until the end of the WAL
+	assert.Contains(t, lines[10], `"step":"RoundStepPrevote"`)
+	assert.Contains(t, lines[11], `"type":"tendermint/wal/EndHeightMessage"`)
+	assert.Equal(t, "End of WAL", lines[12])
+
+	bz, err := os.ReadFile(exportFile)
+	require.NoError(t, err)
+	assert.Contains(t, string(bz), `"height":"`+itoa(height)+`"`)
+}
+
+func itoa(i int64) string {
+	return strconv.FormatInt(i, 10)
+}
@@ -25,9 +25,11 @@ func (emptyMempool) Size() int { return 0 }
 func (emptyMempool) CheckTx(_ context.Context, _ types.Tx, _ func(*abci.Response), _ mempool.TxInfo) error {
 	return nil
 }
-func (emptyMempool) RemoveTxByKey(txKey types.TxKey) error   { return nil }
-func (emptyMempool) ReapMaxBytesMaxGas(_, _ int64) types.Txs { return types.Txs{} }
-func (emptyMempool) ReapMaxTxs(n int) types.Txs              { return types.Txs{} }
+func (emptyMempool) RemoveTxByKey(txKey types.TxKey) error        { return nil }
+func (emptyMempool) GetTxByKey(types.TxKey) (types.Tx, bool)      { return nil, false }
+func (emptyMempool) ListTxs(mempool.TxFilter) []mempool.PendingTx { return nil }
+func (emptyMempool) ReapMaxBytesMaxGas(_, _ int64) types.Txs      { return types.Txs{} }
+func (emptyMempool) ReapMaxTxs(n int) types.Txs                   { return types.Txs{} }
 func (emptyMempool) Update(
 	_ context.Context,
 	_ int64,
@@ -78,22 +80,20 @@ type mockProxyApp struct {
 	abci.BaseApplication
 
 	appHash       []byte
-	txCount       int
 	abciResponses *tmstate.ABCIResponses
 }
 
-func (mock *mockProxyApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
-	r := mock.abciResponses.DeliverTxs[mock.txCount]
-	mock.txCount++
-	if r == nil {
-		return abci.ResponseDeliverTx{}
+func (mock *mockProxyApp) FinalizeBlock(req abci.RequestFinalizeBlock) abci.ResponseFinalizeBlock {
+	res := *mock.abciResponses.FinalizeBlock
+	res.TxResults = make([]*abci.ResponseDeliverTx, len(req.Txs))
+	for i := range req.Txs {
+		r := mock.abciResponses.FinalizeBlock.TxResults[i]
+		if r == nil {
+			r = &abci.ResponseDeliverTx{}
+		}
+		res.TxResults[i] = r
 	}
-	return *r
-}
-
-func (mock *mockProxyApp) EndBlock(req abci.RequestEndBlock) abci.ResponseEndBlock {
-	mock.txCount = 0
-	return *mock.abciResponses.EndBlock
+	return res
 }
 
 func (mock *mockProxyApp) Commit() abci.ResponseCommit {
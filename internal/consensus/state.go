@@ -46,8 +46,9 @@ var msgQueueSize = 1000
 
 // msgs from the reactor which may update the state
 type msgInfo struct {
-	Msg    Message      `json:"msg"`
-	PeerID types.NodeID `json:"peer_key"`
+	Msg         Message      `json:"msg"`
+	PeerID      types.NodeID `json:"peer_key"`
+	ReceiveTime time.Time    `json:"receive_time"`
 }
 
 // internally generated messages which may update the state
@@ -71,6 +72,8 @@ type txNotifier interface {
 type evidencePool interface {
 	// reports conflicting votes to the evidence pool to be processed into evidence
 	ReportConflictingVotes(voteA, voteB *types.Vote)
+	// reports conflicting proposals to the evidence pool to be processed into evidence
+	ReportConflictingProposals(proposalA, proposalB *types.Proposal)
 }
 
 // State handles execution of the consensus algorithm.
@@ -133,7 +136,7 @@ type State struct {
 	// some functions can be overwritten for testing
 	decideProposal func(ctx context.Context, height int64, round int32)
 	doPrevote      func(ctx context.Context, height int64, round int32)
-	setProposal    func(proposal *types.Proposal) error
+	setProposal    func(proposal *types.Proposal, recvTime time.Time) error
 
 	// closed when we finish shutting down
 	done chan struct{}
@@ -147,6 +150,17 @@ type State struct {
 
 	// wait the channel event happening for shutting down the state gracefully
 	onStopCh chan *cstypes.RoundState
+
+	// closed once the halt height or halt time is reached; see halt.go
+	halted  chan struct{}
+	haltErr error
+
+	// the version the app reported on startup, or 0 if unknown; see halt.go
+	appVersion uint64
+
+	// what happened at the last heights, for diagnosing slow rounds;
+	// see timeline.go
+	timeline *timeline
 }
 
 // StateOption sets an optional parameter on the State.
@@ -181,6 +195,8 @@ func NewState(
 		evsw:             tmevents.NewEventSwitch(logger),
 		metrics:          NopMetrics(),
 		onStopCh:         make(chan *cstypes.RoundState),
+		halted:           make(chan struct{}),
+		timeline:         newTimeline(),
 	}
 
 	// set function defaults (may be overwritten before calling Start)
@@ -216,6 +232,13 @@ func StateMetrics(metrics *Metrics) StateOption {
 	return func(cs *State) { cs.metrics = metrics }
 }
 
+// StateAppVersion sets the version the app reported on startup. A node
+// restarted after halting at the halt height set by the consensus params
+// resumes consensus if it differs from the app version of the chain.
+func StateAppVersion(version uint64) StateOption {
+	return func(cs *State) { cs.appVersion = version }
+}
+
 // String returns a string.
 func (cs *State) String() string {
 	// better not to access shared variables
@@ -412,12 +435,21 @@ func (cs *State) OnStart(ctx context.Context) error {
 		return err
 	}
 
+	// A node restarted after reaching its halt height or halt time must not
+	// resume consensus.
+	halted := cs.shouldHalt()
+	if halted {
+		cs.halt()
+	}
+
 	// now start the receiveRoutine
 	go cs.receiveRoutine(ctx, 0)
 
 	// schedule the first round!
 	// use GetRoundState so we don't race the receiveRoutine for access
-	cs.scheduleRound0(cs.GetRoundState())
+	if !halted {
+		cs.scheduleRound0(cs.GetRoundState())
+	}
 
 	return nil
 }
@@ -454,8 +486,8 @@ func (cs *State) OnStop() {
 	if cs.GetRoundState().Step == cstypes.RoundStepCommit {
 		select {
 		case <-cs.onStopCh:
-		case <-time.After(cs.config.TimeoutCommit):
-			cs.logger.Error("OnStop: timeout waiting for commit to finish", "time", cs.config.TimeoutCommit)
+		case <-time.After(cs.timeoutParams().Commit):
+			cs.logger.Error("OnStop: timeout waiting for commit to finish", "time", cs.timeoutParams().Commit)
 		}
 	}
 
@@ -516,14 +548,14 @@ func (cs *State) AddVote(ctx context.Context, vote *types.Vote, peerID types.Nod
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case cs.internalMsgQueue <- msgInfo{&VoteMessage{vote}, ""}:
+		case cs.internalMsgQueue <- msgInfo{&VoteMessage{vote}, "", tmtime.Now()}:
 			return nil
 		}
 	} else {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case cs.peerMsgQueue <- msgInfo{&VoteMessage{vote}, peerID}:
+		case cs.peerMsgQueue <- msgInfo{&VoteMessage{vote}, peerID, tmtime.Now()}:
 			return nil
 		}
 	}
@@ -538,14 +570,14 @@ func (cs *State) SetProposal(ctx context.Context, proposal *types.Proposal, peer
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case cs.internalMsgQueue <- msgInfo{&ProposalMessage{proposal}, ""}:
+		case cs.internalMsgQueue <- msgInfo{&ProposalMessage{proposal}, "", tmtime.Now()}:
 			return nil
 		}
 	} else {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case cs.peerMsgQueue <- msgInfo{&ProposalMessage{proposal}, peerID}:
+		case cs.peerMsgQueue <- msgInfo{&ProposalMessage{proposal}, peerID, tmtime.Now()}:
 			return nil
 		}
 	}
@@ -559,14 +591,14 @@ func (cs *State) AddProposalBlockPart(ctx context.Context, height int64, round i
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case cs.internalMsgQueue <- msgInfo{&BlockPartMessage{height, round, part}, ""}:
+		case cs.internalMsgQueue <- msgInfo{&BlockPartMessage{height, round, part}, "", tmtime.Now()}:
 			return nil
 		}
 	} else {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case cs.peerMsgQueue <- msgInfo{&BlockPartMessage{height, round, part}, peerID}:
+		case cs.peerMsgQueue <- msgInfo{&BlockPartMessage{height, round, part}, peerID, tmtime.Now()}:
 			return nil
 		}
 	}
@@ -608,6 +640,7 @@ func (cs *State) updateHeight(height int64) {
 func (cs *State) updateRoundStep(round int32, step cstypes.RoundStepType) {
 	cs.Round = round
 	cs.Step = step
+	cs.recordTimeline(cs.Height, TimelineEvent{Type: TimelineEventStep, Round: round, Step: step.String()})
 }
 
 // enterNewRound(height, 0) at cs.StartTime.
@@ -742,19 +775,9 @@ func (cs *State) updateToState(ctx context.Context, state sm.State) {
 	cs.updateHeight(height)
 	cs.updateRoundStep(0, cstypes.RoundStepNewHeight)
 
-	if cs.CommitTime.IsZero() {
-		// "Now" makes it easier to sync up dev nodes.
-		// We add timeoutCommit to allow transactions
-		// to be gathered for the first block.
-		// And alternative solution that relies on clocks:
-		// cs.StartTime = state.LastBlockTime.Add(timeoutCommit)
-		cs.StartTime = cs.config.Commit(tmtime.Now())
-	} else {
-		cs.StartTime = cs.config.Commit(cs.CommitTime)
-	}
-
 	cs.Validators = validators
 	cs.Proposal = nil
+	cs.ProposalReceiveTime = time.Time{}
 	cs.ProposalBlock = nil
 	cs.ProposalBlockParts = nil
 	cs.LockedRound = -1
@@ -770,6 +793,17 @@ func (cs *State) updateToState(ctx context.Context, state sm.State) {
 
 	cs.state = state
 
+	if cs.CommitTime.IsZero() {
+		// "Now" makes it easier to sync up dev nodes.
+		// We add timeoutCommit to allow transactions
+		// to be gathered for the first block.
+		// And alternative solution that relies on clocks:
+		// cs.StartTime = state.LastBlockTime.Add(timeoutCommit)
+		cs.StartTime = cs.timeoutParams().CommitTime(tmtime.Now())
+	} else {
+		cs.StartTime = cs.timeoutParams().CommitTime(cs.CommitTime)
+	}
+
 	// Finally, broadcast RoundState
 	cs.newStep(ctx)
 }
@@ -900,6 +934,11 @@ func (cs *State) handleMsg(ctx context.Context, mi msgInfo) {
 	cs.mtx.Lock()
 	defer cs.mtx.Unlock()
 
+	// once halted we neither propose nor vote
+	if cs.isHalted() {
+		return
+	}
+
 	var (
 		added bool
 		err   error
@@ -911,7 +950,7 @@ func (cs *State) handleMsg(ctx context.Context, mi msgInfo) {
 	case *ProposalMessage:
 		// will not cause transition.
 		// once proposal is set, we can receive block parts
-		err = cs.setProposal(msg.Proposal)
+		err = cs.setProposal(msg.Proposal, mi.ReceiveTime)
 
 	case *BlockPartMessage:
 		// if the proposal is complete, we'll enterPrevote or tryFinalizeCommit
@@ -961,6 +1000,11 @@ func (cs *State) handleMsg(ctx context.Context, mi msgInfo) {
 		// the peer is sending us CatchupCommit precommits.
 		// We could make note of this and help filter in broadcastHasVoteMessage().
 
+	case *CommitMessage:
+		// the precommits of a commit, which a peer sends us to catch up,
+		// make us commit its block
+		_, err = cs.addCommit(ctx, msg.Commit, peerID)
+
 	default:
 		cs.logger.Error("unknown msg type", "type", fmt.Sprintf("%T", msg))
 		return
@@ -995,6 +1039,17 @@ func (cs *State) handleTimeout(
 	cs.mtx.Lock()
 	defer cs.mtx.Unlock()
 
+	if cs.isHalted() {
+		return
+	}
+
+	cs.recordTimeline(ti.Height, TimelineEvent{
+		Type:     TimelineEventTimeout,
+		Round:    ti.Round,
+		Step:     ti.Step.String(),
+		Duration: ti.Duration,
+	})
+
 	switch ti.Step {
 	case cstypes.RoundStepNewHeight:
 		// NewRound event fired from enterNewRound.
@@ -1002,7 +1057,7 @@ func (cs *State) handleTimeout(
 		cs.enterNewRound(ctx, ti.Height, 0)
 
 	case cstypes.RoundStepNewRound:
-		cs.enterPropose(ctx, ti.Height, 0)
+		cs.enterPropose(ctx, ti.Height, ti.Round)
 
 	case cstypes.RoundStepPropose:
 		if err := cs.eventBus.PublishEventTimeoutPropose(ctx, cs.RoundStateEvent()); err != nil {
@@ -1062,7 +1117,7 @@ func (cs *State) handleTxsAvailable(ctx context.Context) {
 // Used internally by handleTimeout and handleMsg to make state transitions
 
 // Enter: `timeoutNewHeight` by startTime (commitTime+timeoutCommit),
-//	or, if SkipTimeoutCommit==true, after receiving all precommits from (height,round-1)
+//	or, if BypassCommitTimeout==true, after receiving all precommits from (height,round-1)
 // Enter: `timeoutPrecommits` after any +2/3 precommits from (height,round-1)
 // Enter: +2/3 precommits for nil at (height,round-1)
 // Enter: +2/3 prevotes any or +2/3 precommits for block or any from (height, round)
@@ -1078,6 +1133,11 @@ func (cs *State) enterNewRound(ctx context.Context, height int64, round int32) {
 		return
 	}
 
+	if cs.isHalted() {
+		logger.Debug("entering new round after halt; ignoring")
+		return
+	}
+
 	if now := tmtime.Now(); cs.StartTime.After(now) {
 		logger.Debug("need to set a buffer and log message here for sanity", "start_time", cs.StartTime, "now", now)
 	}
@@ -1103,6 +1163,7 @@ func (cs *State) enterNewRound(ctx context.Context, height int64, round int32) {
 	} else {
 		logger.Debug("resetting proposal info")
 		cs.Proposal = nil
+		cs.ProposalReceiveTime = time.Time{}
 		cs.ProposalBlock = nil
 		cs.ProposalBlockParts = nil
 	}
@@ -1160,6 +1221,17 @@ func (cs *State) enterPropose(ctx context.Context, height int64, round int32) {
 		return
 	}
 
+	// If we are the proposer of this round and the previous block time is
+	// later than our local clock, wait until our clock has passed it before
+	// proposing, so that block times keep increasing.
+	if cs.privValidatorPubKey != nil && cs.isProposer(cs.privValidatorPubKey.Address()) {
+		if wait := proposerWaitTime(tmtime.Now(), cs.state.LastBlockTime); wait > 0 {
+			logger.Debug("propose step; waiting for the last block time to pass", "wait", wait)
+			cs.scheduleTimeout(wait, height, round, cstypes.RoundStepNewRound)
+			return
+		}
+	}
+
 	logger.Debug("entering propose step", "current", fmt.Sprintf("%v/%v/%v", cs.Height, cs.Round, cs.Step))
 
 	defer func() {
@@ -1176,7 +1248,7 @@ func (cs *State) enterPropose(ctx context.Context, height int64, round int32) {
 	}()
 
 	// If we don't get the proposal and all block parts quick enough, enterPrevote
-	cs.scheduleTimeout(cs.config.Propose(round), height, round, cstypes.RoundStepPropose)
+	cs.scheduleTimeout(cs.timeoutParams().ProposeTimeout(round), height, round, cstypes.RoundStepPropose)
 
 	// Nothing more to do if we're not a validator
 	if cs.privValidator == nil {
@@ -1216,10 +1288,50 @@ func (cs *State) enterPropose(ctx context.Context, height int64, round int32) {
 	}
 }
 
+// timeoutParams returns the timeouts of the consensus parameters, overridden
+// by the timeouts set in the local config, if any.
+func (cs *State) timeoutParams() types.TimeoutParams {
+	tp := cs.state.ConsensusParams.Timeout
+	if cs.config.TimeoutPropose > 0 {
+		tp.Propose = cs.config.TimeoutPropose
+	}
+	if cs.config.TimeoutProposeDelta > 0 {
+		tp.ProposeDelta = cs.config.TimeoutProposeDelta
+	}
+	if cs.config.TimeoutPrevote > 0 {
+		tp.Prevote = cs.config.TimeoutPrevote
+	}
+	if cs.config.TimeoutPrevoteDelta > 0 {
+		tp.PrevoteDelta = cs.config.TimeoutPrevoteDelta
+	}
+	if cs.config.TimeoutPrecommit > 0 {
+		tp.Precommit = cs.config.TimeoutPrecommit
+	}
+	if cs.config.TimeoutPrecommitDelta > 0 {
+		tp.PrecommitDelta = cs.config.TimeoutPrecommitDelta
+	}
+	if cs.config.TimeoutCommit > 0 {
+		tp.Commit = cs.config.TimeoutCommit
+	}
+	if cs.config.SkipTimeoutCommit {
+		tp.BypassCommitTimeout = true
+	}
+	return tp
+}
+
 func (cs *State) isProposer(address []byte) bool {
 	return bytes.Equal(cs.Validators.GetProposer().Address, address)
 }
 
+// proposerWaitTime returns how long the proposer must wait before its local
+// clock, currently at now, is past the time of the last block.
+func proposerWaitTime(now, lastBlockTime time.Time) time.Duration {
+	if now.After(lastBlockTime) {
+		return 0
+	}
+	return lastBlockTime.Sub(now) + time.Millisecond
+}
+
 func (cs *State) defaultDecideProposal(ctx context.Context, height int64, round int32) {
 	var block *types.Block
 	var blockParts *types.PartSet
@@ -1231,8 +1343,12 @@ func (cs *State) defaultDecideProposal(ctx context.Context, height int64, round
 	} else {
 		// Create a new proposal block from state/txs from the mempool.
 		var err error
-		block, blockParts, err = cs.createProposalBlock()
-		if block == nil || err != nil {
+		block, blockParts, err = cs.createProposalBlock(ctx)
+		if err != nil {
+			cs.logger.Error("unable to create proposal block", "err", err)
+			return
+		}
+		if block == nil {
 			return
 		}
 	}
@@ -1245,21 +1361,21 @@ func (cs *State) defaultDecideProposal(ctx context.Context, height int64, round
 
 	// Make proposal
 	propBlockID := types.BlockID{Hash: block.Hash(), PartSetHeader: blockParts.Header()}
-	proposal := types.NewProposal(height, round, cs.ValidRound, propBlockID)
+	proposal := types.NewProposal(height, round, cs.ValidRound, propBlockID, block.Header.Time)
 	p := proposal.ToProto()
 
 	// wait the max amount we would wait for a proposal
-	ctxto, cancel := context.WithTimeout(ctx, cs.config.TimeoutPropose)
+	ctxto, cancel := context.WithTimeout(ctx, cs.timeoutParams().Propose)
 	defer cancel()
 	if err := cs.privValidator.SignProposal(ctxto, cs.state.ChainID, p); err == nil {
 		proposal.Signature = p.Signature
 
 		// send proposal and block parts on internal msg queue
-		cs.sendInternalMessage(ctx, msgInfo{&ProposalMessage{proposal}, ""})
+		cs.sendInternalMessage(ctx, msgInfo{&ProposalMessage{proposal}, "", tmtime.Now()})
 
 		for i := 0; i < int(blockParts.Total()); i++ {
 			part := blockParts.GetPart(i)
-			cs.sendInternalMessage(ctx, msgInfo{&BlockPartMessage{cs.Height, cs.Round, part}, ""})
+			cs.sendInternalMessage(ctx, msgInfo{&BlockPartMessage{cs.Height, cs.Round, part}, "", tmtime.Now()})
 		}
 
 		cs.logger.Debug("signed proposal", "height", height, "round", round, "proposal", proposal)
@@ -1291,12 +1407,13 @@ func (cs *State) isProposalComplete() bool {
 //
 // NOTE: keep it side-effect free for clarity.
 // CONTRACT: cs.privValidator is not nil.
-func (cs *State) createProposalBlock() (block *types.Block, blockParts *types.PartSet, err error) {
+func (cs *State) createProposalBlock(ctx context.Context) (block *types.Block, blockParts *types.PartSet, err error) {
 	if cs.privValidator == nil {
 		return nil, nil, errors.New("entered createProposalBlock with privValidator being nil")
 	}
 
 	var commit *types.Commit
+	var votes []*types.Vote
 	switch {
 	case cs.Height == cs.state.InitialHeight:
 		// We're creating a proposal for the first block.
@@ -1304,8 +1421,10 @@ func (cs *State) createProposalBlock() (block *types.Block, blockParts *types.Pa
 		commit = types.NewCommit(0, 0, types.BlockID{}, nil)
 
 	case cs.LastCommit.HasTwoThirdsMajority():
-		// Make the commit from LastCommit
+		// Make the commit from LastCommit. The precommits themselves carry the
+		// vote extensions, which are passed on to the application.
 		commit = cs.LastCommit.MakeCommit()
+		votes = cs.LastCommit.GetVotes()
 
 	default: // This shouldn't happen.
 		cs.logger.Error("propose step; cannot propose anything without commit for the previous block")
@@ -1321,7 +1440,20 @@ func (cs *State) createProposalBlock() (block *types.Block, blockParts *types.Pa
 
 	proposerAddr := cs.privValidatorPubKey.Address()
 
-	return cs.blockExec.CreateProposalBlock(cs.Height, cs.state, commit, proposerAddr)
+	block, blockParts, err = cs.blockExec.CreateProposalBlock(ctx, cs.Height, cs.state, commit, proposerAddr, votes)
+	if err != nil || block == nil || cs.config.BlockPartParity == 0 {
+		return block, blockParts, err
+	}
+
+	// Add the configured share of parity parts, rounded up, so that peers can
+	// rebuild the block without waiting for every part.
+	dataParts := types.ErasureCodedDataParts(block.Size(), types.BlockPartSizeBytes)
+	parity := (dataParts*uint32(cs.config.BlockPartParity) + 99) / 100
+	blockParts, err = block.MakeErasureCodedPartSet(types.BlockPartSizeBytes, parity)
+	if err != nil {
+		return nil, nil, err
+	}
+	return block, blockParts, nil
 }
 
 // Enter: `timeoutPropose` after entering Propose.
@@ -1371,6 +1503,26 @@ func (cs *State) defaultDoPrevote(ctx context.Context, height int64, round int32
 		return
 	}
 
+	// The proposal must carry the time of the proposed block.
+	if !cs.Proposal.Timestamp.Equal(cs.ProposalBlock.Header.Time) {
+		logger.Debug("prevote step: proposal timestamp not equal to block time; prevoting nil")
+		cs.signAddVote(ctx, tmproto.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+
+	// A block that has not received a polka yet must be timely: its time must
+	// be close to the time we received the proposal.
+	sp := cs.state.ConsensusParams.Synchrony
+	if cs.Proposal.POLRound == -1 && !cs.Proposal.IsTimely(cs.ProposalReceiveTime, sp, cs.Round) {
+		logger.Debug("prevote step: proposal is not timely; prevoting nil",
+			"proposed", cs.Proposal.Timestamp,
+			"received", cs.ProposalReceiveTime,
+			"msg_delay", sp.MessageDelay,
+			"precision", sp.Precision)
+		cs.signAddVote(ctx, tmproto.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+
 	// Validate proposal block
 	err := cs.blockExec.ValidateBlock(cs.state, cs.ProposalBlock)
 	if err != nil {
@@ -1380,6 +1532,20 @@ func (cs *State) defaultDoPrevote(ctx context.Context, height int64, round int32
 		return
 	}
 
+	// Ask the application whether it accepts the proposal block.
+	isAppValid, err := cs.blockExec.ProcessProposal(ctx, cs.ProposalBlock, cs.state)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"state machine returned an error (%v) when calling ProcessProposal", err,
+		))
+	}
+	if !isAppValid {
+		// The application rejected the proposal block, prevote nil.
+		logger.Error("prevote step: state machine rejected the proposed block; prevoting nil")
+		cs.signAddVote(ctx, tmproto.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+
 	// Prevote cs.ProposalBlock
 	// NOTE: the proposal signature is validated when it is received,
 	// and the proposal block parts are validated as they are received (against the merkle hash in the proposal)
@@ -1415,7 +1581,7 @@ func (cs *State) enterPrevoteWait(ctx context.Context, height int64, round int32
 	}()
 
 	// Wait for some more prevotes; enterPrecommit
-	cs.scheduleTimeout(cs.config.Prevote(round), height, round, cstypes.RoundStepPrevoteWait)
+	cs.scheduleTimeout(cs.timeoutParams().PrevoteTimeout(round), height, round, cstypes.RoundStepPrevoteWait)
 }
 
 // Enter: `timeoutPrevote` after any +2/3 prevotes.
@@ -1574,7 +1740,7 @@ func (cs *State) enterPrecommitWait(ctx context.Context, height int64, round int
 	}()
 
 	// wait for some more precommits; enterNewRound
-	cs.scheduleTimeout(cs.config.Precommit(round), height, round, cstypes.RoundStepPrecommitWait)
+	cs.scheduleTimeout(cs.timeoutParams().PrecommitTimeout(round), height, round, cstypes.RoundStepPrecommitWait)
 }
 
 // Enter: +2/3 precommits for block
@@ -1777,6 +1943,11 @@ func (cs *State) finalizeCommit(ctx context.Context, height int64) {
 		logger.Error("failed to get private validator pubkey", "err", err)
 	}
 
+	if cs.shouldHalt() {
+		cs.halt()
+		return
+	}
+
 	// cs.StartTime is already set.
 	// Schedule Round0 to start soon.
 	cs.scheduleRound0(&cs.RoundState)
@@ -1845,18 +2016,26 @@ func (cs *State) RecordMetrics(height int64, block *types.Block) {
 	cs.metrics.MissingValidators.Set(float64(missingValidators))
 	cs.metrics.MissingValidatorsPower.Set(float64(missingValidatorsPower))
 
-	// NOTE: byzantine validators power and count is only for consensus evidence i.e. duplicate vote
+	// NOTE: byzantine validators power and count is only for consensus evidence i.e. duplicate
+	// vote and duplicate proposal
 	var (
 		byzantineValidatorsPower int64
 		byzantineValidatorsCount int64
 	)
 
 	for _, ev := range block.Evidence.Evidence {
-		if dve, ok := ev.(*types.DuplicateVoteEvidence); ok {
-			if _, val := cs.Validators.GetByAddress(dve.VoteA.ValidatorAddress); val != nil {
-				byzantineValidatorsCount++
-				byzantineValidatorsPower += val.VotingPower
-			}
+		var addr types.Address
+		switch ev := ev.(type) {
+		case *types.DuplicateVoteEvidence:
+			addr = ev.VoteA.ValidatorAddress
+		case *types.DuplicateProposalEvidence:
+			addr = ev.ValidatorAddress
+		default:
+			continue
+		}
+		if _, val := cs.Validators.GetByAddress(addr); val != nil {
+			byzantineValidatorsCount++
+			byzantineValidatorsPower += val.VotingPower
 		}
 	}
 	cs.metrics.ByzantineValidators.Set(float64(byzantineValidatorsCount))
@@ -1879,10 +2058,10 @@ func (cs *State) RecordMetrics(height int64, block *types.Block) {
 
 //-----------------------------------------------------------------------------
 
-func (cs *State) defaultSetProposal(proposal *types.Proposal) error {
+func (cs *State) defaultSetProposal(proposal *types.Proposal, recvTime time.Time) error {
 	// Already have one
-	// TODO: possibly catch double proposals
 	if cs.Proposal != nil {
+		cs.checkConflictingProposal(proposal)
 		return nil
 	}
 
@@ -1907,6 +2086,8 @@ func (cs *State) defaultSetProposal(proposal *types.Proposal) error {
 
 	proposal.Signature = p.Signature
 	cs.Proposal = proposal
+	cs.ProposalReceiveTime = recvTime
+	cs.recordTimeline(proposal.Height, TimelineEvent{Time: recvTime, Type: TimelineEventProposal, Round: proposal.Round})
 	// We don't update cs.ProposalBlockParts if it is already set.
 	// This happens if we're already in cstypes.RoundStepCommit or if there is a valid block in the current round.
 	// TODO: We can check if Proposal is for a different block as this is a sign of misbehavior!
@@ -1918,6 +2099,28 @@ func (cs *State) defaultSetProposal(proposal *types.Proposal) error {
 	return nil
 }
 
+// checkConflictingProposal reports proposal to the evidence pool if it was
+// signed by the proposer of the current round for a different block than the
+// proposal we already have.
+func (cs *State) checkConflictingProposal(proposal *types.Proposal) {
+	if proposal.Height != cs.Proposal.Height || proposal.Round != cs.Proposal.Round ||
+		proposal.BlockID.Equals(cs.Proposal.BlockID) {
+		return
+	}
+
+	p := proposal.ToProto()
+	if !cs.Validators.GetProposer().PubKey.VerifySignature(
+		types.ProposalSignBytes(cs.state.ChainID, p), proposal.Signature,
+	) {
+		return
+	}
+
+	cs.logger.Info("found conflicting proposal from proposer",
+		"proposal_a", cs.Proposal,
+		"proposal_b", proposal)
+	cs.evpool.ReportConflictingProposals(cs.Proposal, proposal)
+}
+
 // NOTE: block is not necessarily valid.
 // Asynchronously triggers either enterPrevote (before we timeout of propose) or tryFinalizeCommit,
 // once we have the full block.
@@ -1952,6 +2155,10 @@ func (cs *State) addProposalBlockPart(
 	if err != nil {
 		return added, err
 	}
+	if added {
+		idx := part.Index
+		cs.recordTimeline(height, TimelineEvent{Type: TimelineEventBlockPart, Round: round, PartIndex: &idx})
+	}
 	if cs.ProposalBlockParts.ByteSize() > cs.state.ConsensusParams.Block.MaxBytes {
 		return added, fmt.Errorf("total size of proposal block parts exceeds maximum block bytes (%d > %d)",
 			cs.ProposalBlockParts.ByteSize(), cs.state.ConsensusParams.Block.MaxBytes,
@@ -2097,6 +2304,7 @@ func (cs *State) addVote(
 		if !added {
 			return
 		}
+		cs.recordVoteTimeline(vote)
 
 		cs.logger.Debug("added vote to last precommits", "last_commit", cs.LastCommit.StringShort())
 		if err := cs.eventBus.PublishEventVote(ctx, types.EventDataVote{Vote: vote}); err != nil {
@@ -2106,7 +2314,7 @@ func (cs *State) addVote(
 		cs.evsw.FireEvent(ctx, types.EventVoteValue, vote)
 
 		// if we can skip timeoutCommit and have all the votes now,
-		if cs.config.SkipTimeoutCommit && cs.LastCommit.HasAll() {
+		if cs.timeoutParams().BypassCommitTimeout && cs.LastCommit.HasAll() {
 			// go straight to new round (skip timeout commit)
 			// cs.scheduleTimeout(time.Duration(0), cs.Height, 0, cstypes.RoundStepNewHeight)
 			cs.enterNewRound(ctx, cs.Height, 0)
@@ -2122,12 +2330,25 @@ func (cs *State) addVote(
 		return
 	}
 
+	// Before adding a precommit for a block from another validator, have the
+	// application verify its vote extension. Our own extension was produced by
+	// the application, and duplicates were verified when first received.
+	if vote.Type == tmproto.PrecommitType && !vote.BlockID.IsZero() && !cs.isOwnVote(vote) {
+		existing := cs.Votes.Precommits(vote.Round).GetByIndex(vote.ValidatorIndex)
+		if existing == nil || !bytes.Equal(existing.Signature, vote.Signature) {
+			if err := cs.verifyVoteExtension(ctx, vote); err != nil {
+				return false, err
+			}
+		}
+	}
+
 	height := cs.Height
 	added, err = cs.Votes.AddVote(vote, peerID)
 	if !added {
 		// Either duplicate, or error upon cs.Votes.AddByIndex()
 		return
 	}
+	cs.recordVoteTimeline(vote)
 
 	if err := cs.eventBus.PublishEventVote(ctx, types.EventDataVote{Vote: vote}); err != nil {
 		return added, err
@@ -2231,7 +2452,7 @@ func (cs *State) addVote(
 
 			if len(blockID.Hash) != 0 {
 				cs.enterCommit(ctx, height, vote.Round)
-				if cs.config.SkipTimeoutCommit && precommits.HasAll() {
+				if cs.timeoutParams().BypassCommitTimeout && precommits.HasAll() {
 					cs.enterNewRound(ctx, cs.Height, 0)
 				}
 			} else {
@@ -2249,6 +2470,51 @@ func (cs *State) addVote(
 	return added, err
 }
 
+// addCommit adds the precommits of a commit for the current height, which
+// can't be sent as individual votes, and commits its block.
+func (cs *State) addCommit(
+	ctx context.Context,
+	commit *types.Commit,
+	peerID types.NodeID,
+) (added bool, err error) {
+	cs.logger.Debug(
+		"adding commit",
+		"commit_height", commit.Height,
+		"commit_round", commit.Round,
+		"cs_height", cs.Height,
+	)
+
+	// Height mismatch is ignored.
+	// By the time we get to the next height, we have a commit for this one.
+	if commit.Height != cs.Height {
+		cs.logger.Debug("commit ignored and not added", "commit_height", commit.Height, "cs_height", cs.Height, "peer", peerID)
+		return
+	}
+
+	height := cs.Height
+	added, err = cs.Votes.AddCommit(commit, peerID)
+	if !added {
+		// Either duplicate, or error upon cs.Votes.AddCommit()
+		return
+	}
+
+	precommits := cs.Votes.Precommits(commit.Round)
+	cs.logger.Debug("added commit to precommits",
+		"height", commit.Height,
+		"round", commit.Round,
+		"data", precommits.LogString())
+
+	// Executed as the commit could be from a higher round
+	cs.enterNewRound(ctx, height, commit.Round)
+	cs.enterPrecommit(ctx, height, commit.Round)
+	cs.enterCommit(ctx, height, commit.Round)
+	if cs.timeoutParams().BypassCommitTimeout && precommits.HasAll() {
+		cs.enterNewRound(ctx, cs.Height, 0)
+	}
+
+	return added, nil
+}
+
 // CONTRACT: cs.privValidator is not nil.
 func (cs *State) signVote(
 	ctx context.Context,
@@ -2279,6 +2545,16 @@ func (cs *State) signVote(
 		BlockID:          types.BlockID{Hash: hash, PartSetHeader: header},
 	}
 
+	// If the vote is a precommit for a block, ask the application for the
+	// vote extension to attach to it.
+	if msgType == tmproto.PrecommitType && !vote.BlockID.IsZero() {
+		ext, err := cs.blockExec.ExtendVote(ctx, vote)
+		if err != nil {
+			return nil, err
+		}
+		vote.Extension = ext
+	}
+
 	v := vote.ToProto()
 
 	// If the signedMessageType is for precommit,
@@ -2287,9 +2563,9 @@ func (cs *State) signVote(
 
 	switch msgType {
 	case tmproto.PrecommitType:
-		timeout = cs.config.TimeoutPrecommit
+		timeout = cs.timeoutParams().Precommit
 	case tmproto.PrevoteType:
-		timeout = cs.config.TimeoutPrevote
+		timeout = cs.timeoutParams().Prevote
 	default:
 		timeout = time.Second
 	}
@@ -2299,6 +2575,7 @@ func (cs *State) signVote(
 
 	err := cs.privValidator.SignVote(ctxto, cs.state.ChainID, v)
 	vote.Signature = v.Signature
+	vote.ExtensionSignature = v.ExtensionSignature
 	vote.Timestamp = v.Timestamp
 
 	return vote, err
@@ -2328,12 +2605,37 @@ func (cs *State) voteTime() time.Time {
 	return minVoteTime
 }
 
+// isOwnVote returns true if the vote was signed by this node's validator.
+func (cs *State) isOwnVote(vote *types.Vote) bool {
+	return cs.privValidatorPubKey != nil &&
+		bytes.Equal(vote.ValidatorAddress, cs.privValidatorPubKey.Address())
+}
+
+// verifyVoteExtension checks the vote extension signature of the given
+// precommit and then asks the application to verify the extension itself, so
+// that the application only sees extensions actually signed by the validator.
+func (cs *State) verifyVoteExtension(ctx context.Context, vote *types.Vote) error {
+	_, val := cs.Validators.GetByIndex(vote.ValidatorIndex)
+	if val == nil {
+		return fmt.Errorf("cannot find validator %d in valSet of size %d: %w",
+			vote.ValidatorIndex, cs.Validators.Size(), types.ErrVoteInvalidValidatorIndex)
+	}
+	if err := vote.VerifyExtension(cs.state.ChainID, val.PubKey); err != nil {
+		return err
+	}
+	return cs.blockExec.VerifyVoteExtension(ctx, vote)
+}
+
 // sign the vote and publish on internalMsgQueue
 func (cs *State) signAddVote(ctx context.Context, msgType tmproto.SignedMsgType, hash []byte, header types.PartSetHeader) *types.Vote {
 	if cs.privValidator == nil { // the node does not have a key
 		return nil
 	}
 
+	if cs.isHalted() { // never vote past the halt height
+		return nil
+	}
+
 	if cs.privValidatorPubKey == nil {
 		// Vote won't be signed, but it's not critical.
 		cs.logger.Error("signAddVote", "err", errPubKeyIsNotSet)
@@ -2348,7 +2650,7 @@ func (cs *State) signAddVote(ctx context.Context, msgType tmproto.SignedMsgType,
 	// TODO: pass pubKey to signVote
 	vote, err := cs.signVote(ctx, msgType, hash, header)
 	if err == nil {
-		cs.sendInternalMessage(ctx, msgInfo{&VoteMessage{vote}, ""})
+		cs.sendInternalMessage(ctx, msgInfo{&VoteMessage{vote}, "", tmtime.Now()})
 		cs.logger.Debug("signed and pushed vote", "height", cs.Height, "round", cs.Round, "vote", vote)
 		return vote
 	}
@@ -2366,10 +2668,10 @@ func (cs *State) updatePrivValidatorPubKey(rctx context.Context) error {
 	}
 
 	var timeout time.Duration
-	if cs.config.TimeoutPrecommit > cs.config.TimeoutPrevote {
-		timeout = cs.config.TimeoutPrecommit
+	if tp := cs.timeoutParams(); tp.Precommit > tp.Prevote {
+		timeout = tp.Precommit
 	} else {
-		timeout = cs.config.TimeoutPrevote
+		timeout = tp.Prevote
 	}
 
 	// no GetPubKey retry beyond the proposal/voting in RetrySignerClient
@@ -15,8 +15,10 @@ import (
 	cstypes "github.com/tendermint/tendermint/internal/consensus/types"
 	"github.com/tendermint/tendermint/internal/eventbus"
 	tmpubsub "github.com/tendermint/tendermint/internal/pubsub"
+	sm "github.com/tendermint/tendermint/internal/state"
 	"github.com/tendermint/tendermint/libs/log"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmtime "github.com/tendermint/tendermint/libs/time"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	"github.com/tendermint/tendermint/types"
 )
@@ -169,7 +171,7 @@ func TestStateEnterProposeNoPrivValidator(t *testing.T) {
 	startTestRound(ctx, cs, height, round)
 
 	// if we're not a validator, EnterPropose should timeout
-	ensureNewTimeout(timeoutCh, height, round, cs.config.TimeoutPropose.Nanoseconds())
+	ensureNewTimeout(timeoutCh, height, round, cs.timeoutParams().Propose.Nanoseconds())
 
 	if cs.GetRoundState().Proposal != nil {
 		t.Error("Expected to make no proposal, since no privValidator")
@@ -209,7 +211,7 @@ func TestStateEnterProposeYesPrivValidator(t *testing.T) {
 	}
 
 	// if we're a validator, enterPropose should not timeout
-	ensureNoNewTimeout(timeoutCh, cs.config.TimeoutPropose.Nanoseconds())
+	ensureNoNewTimeout(timeoutCh, cs.timeoutParams().Propose.Nanoseconds())
 }
 
 func TestStateBadProposal(t *testing.T) {
@@ -227,7 +229,7 @@ func TestStateBadProposal(t *testing.T) {
 	proposalCh := subscribe(ctx, t, cs1.eventBus, types.EventQueryCompleteProposal)
 	voteCh := subscribe(ctx, t, cs1.eventBus, types.EventQueryVote)
 
-	propBlock, _, err := cs1.createProposalBlock() // changeProposer(t, cs1, vs2)
+	propBlock, _, err := cs1.createProposalBlock(ctx) // changeProposer(t, cs1, vs2)
 	require.NoError(t, err)
 
 	// make the second validator the proposer by incrementing round
@@ -244,7 +246,7 @@ func TestStateBadProposal(t *testing.T) {
 	propBlockParts, err := propBlock.MakePartSet(partSize)
 	require.NoError(t, err)
 	blockID := types.BlockID{Hash: propBlock.Hash(), PartSetHeader: propBlockParts.Header()}
-	proposal := types.NewProposal(vs2.Height, round, -1, blockID)
+	proposal := types.NewProposal(vs2.Height, round, -1, blockID, propBlock.Header.Time)
 	p := proposal.ToProto()
 	if err := vs2.SignProposal(ctx, config.ChainID(), p); err != nil {
 		t.Fatal("failed to sign bad proposal", err)
@@ -283,6 +285,230 @@ func TestStateBadProposal(t *testing.T) {
 	signAddVotes(ctx, config, cs1, tmproto.PrecommitType, propBlock.Hash(), bps2.Header(), vs2)
 }
 
+type conflictingProposalsRecorder struct {
+	sm.EmptyEvidencePool
+	proposals [][2]*types.Proposal
+}
+
+func (r *conflictingProposalsRecorder) ReportConflictingProposals(proposalA, proposalB *types.Proposal) {
+	r.proposals = append(r.proposals, [2]*types.Proposal{proposalA, proposalB})
+}
+
+func TestStateConflictingProposal(t *testing.T) {
+	config := configSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cs1, vss, err := randState(ctx, t, config, log.TestingLogger(), 1)
+	require.NoError(t, err)
+	height, round := cs1.Height, cs1.Round
+
+	evpool := &conflictingProposalsRecorder{}
+	cs1.evpool = evpool
+
+	signProposal := func(blockHash []byte) *types.Proposal {
+		blockID := types.BlockID{
+			Hash:          blockHash,
+			PartSetHeader: types.PartSetHeader{Total: 1, Hash: tmrand.Bytes(32)},
+		}
+		proposal := types.NewProposal(height, round, -1, blockID, tmtime.Now())
+		p := proposal.ToProto()
+		require.NoError(t, vss[0].SignProposal(ctx, config.ChainID(), p))
+		proposal.Signature = p.Signature
+		return proposal
+	}
+
+	proposalA := signProposal(tmrand.Bytes(32))
+	require.NoError(t, cs1.defaultSetProposal(proposalA, tmtime.Now()))
+	require.Equal(t, proposalA, cs1.Proposal)
+
+	// the same proposal again is not misbehavior
+	require.NoError(t, cs1.defaultSetProposal(proposalA, tmtime.Now()))
+	require.Empty(t, evpool.proposals)
+
+	// a proposal for a different block with an invalid signature is ignored
+	badProposal := signProposal(tmrand.Bytes(32))
+	badProposal.Signature = tmrand.Bytes(64)
+	require.NoError(t, cs1.defaultSetProposal(badProposal, tmtime.Now()))
+	require.Empty(t, evpool.proposals)
+
+	// a second signed proposal for a different block is reported
+	proposalB := signProposal(tmrand.Bytes(32))
+	require.NoError(t, cs1.defaultSetProposal(proposalB, tmtime.Now()))
+	require.Equal(t, proposalA, cs1.Proposal)
+	require.Len(t, evpool.proposals, 1)
+	assert.Equal(t, proposalA, evpool.proposals[0][0])
+	assert.Equal(t, proposalB, evpool.proposals[0][1])
+}
+
+func TestStateTimeoutParams(t *testing.T) {
+	config := configSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cs1, _, err := randState(ctx, t, config, log.TestingLogger(), 1)
+	require.NoError(t, err)
+
+	// without local overrides the consensus params are used
+	cs1.config.TimeoutPropose = 0
+	cs1.config.TimeoutProposeDelta = 0
+	cs1.config.TimeoutPrevote = 0
+	cs1.config.TimeoutPrevoteDelta = 0
+	cs1.config.TimeoutPrecommit = 0
+	cs1.config.TimeoutPrecommitDelta = 0
+	cs1.config.TimeoutCommit = 0
+	cs1.config.SkipTimeoutCommit = false
+	assert.Equal(t, cs1.state.ConsensusParams.Timeout, cs1.timeoutParams())
+
+	// the local config overrides the consensus params it sets
+	cs1.config.TimeoutPropose = 7 * time.Millisecond
+	cs1.config.SkipTimeoutCommit = true
+	tp := cs1.timeoutParams()
+	assert.Equal(t, 7*time.Millisecond, tp.Propose)
+	assert.True(t, tp.BypassCommitTimeout)
+	assert.Equal(t, cs1.state.ConsensusParams.Timeout.Prevote, tp.Prevote)
+	assert.Equal(t, cs1.state.ConsensusParams.Timeout.Commit, tp.Commit)
+}
+
+func TestStateHaltHeight(t *testing.T) {
+	config := configSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cs1, _, err := randState(ctx, t, config, log.TestingLogger(), 1)
+	require.NoError(t, err)
+	height, round := cs1.Height, cs1.Round
+	cs1.config.HaltHeight = height
+
+	newBlockCh := subscribe(ctx, t, cs1.eventBus, types.EventQueryNewBlock)
+	newRoundCh := subscribe(ctx, t, cs1.eventBus, types.EventQueryNewRound)
+
+	startTestRound(ctx, cs1, height, round)
+	ensureNewRound(newRoundCh, height, round)
+	ensureNewBlock(newBlockCh, height)
+
+	select {
+	case <-cs1.Halted():
+	case <-time.After(ensureTimeout):
+		t.Fatal("expected consensus to halt")
+	}
+
+	var haltErr HaltError
+	require.ErrorAs(t, cs1.HaltError(), &haltErr)
+	assert.Equal(t, height, haltErr.Height)
+	assert.Equal(t, HaltExitCode, haltErr.ExitCode())
+
+	// no round is started for the next height
+	ensureNoNewEventOnChannel(newRoundCh)
+	assert.Equal(t, height+1, cs1.GetRoundState().Height)
+}
+
+func TestStateHaltHeightParamRestart(t *testing.T) {
+	cfg := configSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logger := log.TestingLogger()
+	state, privVals := randGenesisState(ctx, t, cfg, 1, false, 10)
+	state.ConsensusParams.Block.HaltHeight = 1
+
+	// start, commit the halt height and halt
+	cs1 := newStateWithConfig(ctx, logger, cfg, state, privVals[0], kvstore.NewApplication())
+	newBlockCh := subscribe(ctx, t, cs1.eventBus, types.EventQueryNewBlock)
+	require.NoError(t, cs1.Start(ctx))
+	ensureNewBlock(newBlockCh, 1)
+	ensureHalted(t, cs1)
+	require.NoError(t, cs1.Stop())
+
+	state = cs1.state
+	restart := func(appVersion uint64) *State {
+		cs := NewState(ctx, logger, cfg.Consensus, state, cs1.blockExec, cs1.blockStore,
+			cs1.txNotifier, cs1.evpool, StateAppVersion(appVersion))
+		cs.SetPrivValidator(ctx, privVals[0])
+
+		eventBus := eventbus.NewDefault(logger)
+		require.NoError(t, eventBus.Start(ctx))
+		cs.SetEventBus(eventBus)
+		return cs
+	}
+
+	// restarted with the same app, the node halts again
+	cs2 := restart(state.Version.Consensus.App)
+	require.NoError(t, cs2.Start(ctx))
+	ensureHalted(t, cs2)
+	require.NoError(t, cs2.Stop())
+
+	// restarted with an upgraded app, consensus resumes
+	cs3 := restart(state.Version.Consensus.App + 1)
+	newBlockCh = subscribe(ctx, t, cs3.eventBus, types.EventQueryNewBlock)
+	require.NoError(t, cs3.Start(ctx))
+	ensureNewBlock(newBlockCh, 2)
+	ensureNewBlock(newBlockCh, 3)
+	require.NoError(t, cs3.HaltError())
+	require.NoError(t, cs3.Stop())
+}
+
+func ensureHalted(t *testing.T, cs *State) {
+	t.Helper()
+	select {
+	case <-cs.Halted():
+	case <-time.After(ensureTimeout):
+		t.Fatal("expected consensus to halt")
+	}
+}
+
+func TestStateUntimelyProposal(t *testing.T) {
+	config := configSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cs1, vss, err := randState(ctx, t, config, log.TestingLogger(), 2)
+	require.NoError(t, err)
+	height, round := cs1.Height, cs1.Round
+	vs2 := vss[1]
+
+	partSize := types.BlockPartSizeBytes
+
+	proposalCh := subscribe(ctx, t, cs1.eventBus, types.EventQueryCompleteProposal)
+	voteCh := subscribe(ctx, t, cs1.eventBus, types.EventQueryVote)
+
+	propBlock, _, err := cs1.createProposalBlock(ctx)
+	require.NoError(t, err)
+
+	// make the second validator the proposer by incrementing round
+	round++
+	incrementRound(vss[1:]...)
+
+	// move the block time well past what the synchrony params allow
+	sp := cs1.state.ConsensusParams.Synchrony.InRound(round)
+	propBlock.Time = propBlock.Time.Add(sp.MessageDelay + sp.Precision + time.Minute)
+	propBlockParts, err := propBlock.MakePartSet(partSize)
+	require.NoError(t, err)
+	blockID := types.BlockID{Hash: propBlock.Hash(), PartSetHeader: propBlockParts.Header()}
+	proposal := types.NewProposal(vs2.Height, round, -1, blockID, propBlock.Header.Time)
+	p := proposal.ToProto()
+	if err := vs2.SignProposal(ctx, config.ChainID(), p); err != nil {
+		t.Fatal("failed to sign untimely proposal", err)
+	}
+
+	proposal.Signature = p.Signature
+
+	// set the proposal block
+	if err := cs1.SetProposalAndBlock(ctx, proposal, propBlock, propBlockParts, "some peer"); err != nil {
+		t.Fatal(err)
+	}
+
+	// start the machine
+	startTestRound(ctx, cs1, height, round)
+
+	// wait for proposal
+	ensureProposal(proposalCh, height, round, blockID)
+
+	// the block is valid but not timely, so we prevote nil
+	ensurePrevote(voteCh, height, round)
+	validatePrevote(ctx, t, cs1, round, vss[0], nil)
+}
+
 func TestStateOversizedBlock(t *testing.T) {
 	config := configSetup(t)
 	ctx, cancel := context.WithCancel(context.Background())
@@ -299,7 +525,7 @@ func TestStateOversizedBlock(t *testing.T) {
 	timeoutProposeCh := subscribe(ctx, t, cs1.eventBus, types.EventQueryTimeoutPropose)
 	voteCh := subscribe(ctx, t, cs1.eventBus, types.EventQueryVote)
 
-	propBlock, _, err := cs1.createProposalBlock()
+	propBlock, _, err := cs1.createProposalBlock(ctx)
 	require.NoError(t, err)
 	propBlock.Data.Txs = []types.Tx{tmrand.Bytes(2001)}
 	propBlock.Header.DataHash = propBlock.Data.Hash()
@@ -311,7 +537,7 @@ func TestStateOversizedBlock(t *testing.T) {
 	propBlockParts, err := propBlock.MakePartSet(partSize)
 	require.NoError(t, err)
 	blockID := types.BlockID{Hash: propBlock.Hash(), PartSetHeader: propBlockParts.Header()}
-	proposal := types.NewProposal(height, round, -1, blockID)
+	proposal := types.NewProposal(height, round, -1, blockID, propBlock.Header.Time)
 	p := proposal.ToProto()
 	if err := vs2.SignProposal(ctx, config.ChainID(), p); err != nil {
 		t.Fatal("failed to sign bad proposal", err)
@@ -335,7 +561,7 @@ func TestStateOversizedBlock(t *testing.T) {
 
 	// c1 should log an error with the block part message as it exceeds the consensus params. The
 	// block is not added to cs.ProposalBlock so the node timeouts.
-	ensureNewTimeout(timeoutProposeCh, height, round, cs1.config.Propose(round).Nanoseconds())
+	ensureNewTimeout(timeoutProposeCh, height, round, cs1.timeoutParams().ProposeTimeout(round).Nanoseconds())
 
 	// and then should send nil prevote and precommit regardless of whether other validators prevote and
 	// precommit on it
@@ -404,6 +630,34 @@ func TestStateFullRound1(t *testing.T) {
 	validateLastPrecommit(ctx, t, cs, vss[0], propBlockHash)
 }
 
+// the proposal block is erasure coded and committed
+func TestStateFullRoundErasureCoded(t *testing.T) {
+	config := configSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cs, _, err := randState(ctx, t, config, log.TestingLogger(), 1)
+	require.NoError(t, err)
+	cs.config.BlockPartParity = 50
+	height, round := cs.Height, cs.Round
+
+	newRoundCh := subscribe(ctx, t, cs.eventBus, types.EventQueryNewRound)
+
+	startTestRound(ctx, cs, height, round)
+	ensureNewRound(newRoundCh, height, round)
+	ensureNewRound(newRoundCh, height+1, 0)
+
+	// the block is stored with its parity part and loaded back
+	meta := cs.blockStore.LoadBlockMeta(height)
+	require.NotNil(t, meta)
+	header := meta.BlockID.PartSetHeader
+	assert.EqualValues(t, 1, header.Parity)
+	assert.EqualValues(t, 2, header.Total)
+	block := cs.blockStore.LoadBlock(height)
+	require.NotNil(t, block)
+	assert.Equal(t, meta.BlockID.Hash, block.Hash())
+}
+
 // nil is proposed, so prevote and precommit nil
 func TestStateFullRoundNil(t *testing.T) {
 	config := configSetup(t)
@@ -527,7 +781,7 @@ func TestStateLockNoPOL(t *testing.T) {
 
 	// (note we're entering precommit for a second time this round)
 	// but with invalid args. then we enterPrecommitWait, and the timeout to new round
-	ensureNewTimeout(timeoutWaitCh, height, round, cs1.config.Precommit(round).Nanoseconds())
+	ensureNewTimeout(timeoutWaitCh, height, round, cs1.timeoutParams().PrecommitTimeout(round).Nanoseconds())
 
 	///
 
@@ -541,7 +795,7 @@ func TestStateLockNoPOL(t *testing.T) {
 	incrementRound(vs2)
 
 	// now we're on a new round and not the proposer, so wait for timeout
-	ensureNewTimeout(timeoutProposeCh, height, round, cs1.config.Propose(round).Nanoseconds())
+	ensureNewTimeout(timeoutProposeCh, height, round, cs1.timeoutParams().ProposeTimeout(round).Nanoseconds())
 
 	rs := cs1.GetRoundState()
 
@@ -561,7 +815,7 @@ func TestStateLockNoPOL(t *testing.T) {
 
 	// now we're going to enter prevote again, but with invalid args
 	// and then prevote wait, which should timeout. then wait for precommit
-	ensureNewTimeout(timeoutWaitCh, height, round, cs1.config.Prevote(round).Nanoseconds())
+	ensureNewTimeout(timeoutWaitCh, height, round, cs1.timeoutParams().PrevoteTimeout(round).Nanoseconds())
 
 	ensurePrecommit(voteCh, height, round) // precommit
 	// the proposed block should still be locked and our precommit added
@@ -576,7 +830,7 @@ func TestStateLockNoPOL(t *testing.T) {
 
 	// (note we're entering precommit for a second time this round, but with invalid args
 	// then we enterPrecommitWait and timeout into NewRound
-	ensureNewTimeout(timeoutWaitCh, height, round, cs1.config.Precommit(round).Nanoseconds())
+	ensureNewTimeout(timeoutWaitCh, height, round, cs1.timeoutParams().PrecommitTimeout(round).Nanoseconds())
 
 	round++ // entering new round
 	ensureNewRound(newRoundCh, height, round)
@@ -606,7 +860,7 @@ func TestStateLockNoPOL(t *testing.T) {
 	signAddVotes(ctx, config, cs1, tmproto.PrevoteType, hash, bps0.Header(), vs2)
 	ensurePrevote(voteCh, height, round)
 
-	ensureNewTimeout(timeoutWaitCh, height, round, cs1.config.Prevote(round).Nanoseconds())
+	ensureNewTimeout(timeoutWaitCh, height, round, cs1.timeoutParams().PrevoteTimeout(round).Nanoseconds())
 	ensurePrecommit(voteCh, height, round) // precommit
 
 	validatePrecommit(ctx, t, cs1, round, 0, vss[0], nil, theBlockHash) // precommit nil but be locked on proposal
@@ -623,7 +877,7 @@ func TestStateLockNoPOL(t *testing.T) {
 		vs2) // NOTE: conflicting precommits at same height
 	ensurePrecommit(voteCh, height, round)
 
-	ensureNewTimeout(timeoutWaitCh, height, round, cs1.config.Precommit(round).Nanoseconds())
+	ensureNewTimeout(timeoutWaitCh, height, round, cs1.timeoutParams().PrecommitTimeout(round).Nanoseconds())
 
 	// needed so generated block is different than locked block
 	cs2, _, err := randState(ctx, t, config, log.TestingLogger(), 2)
@@ -663,7 +917,7 @@ func TestStateLockNoPOL(t *testing.T) {
 	signAddVotes(ctx, config, cs1, tmproto.PrevoteType, propBlock.Hash(), bps4.Header(), vs2)
 	ensurePrevote(voteCh, height, round)
 
-	ensureNewTimeout(timeoutWaitCh, height, round, cs1.config.Prevote(round).Nanoseconds())
+	ensureNewTimeout(timeoutWaitCh, height, round, cs1.timeoutParams().PrevoteTimeout(round).Nanoseconds())
 	ensurePrecommit(voteCh, height, round)
 	validatePrecommit(ctx, t, cs1, round, 0, vss[0], nil, theBlockHash) // precommit nil but locked on proposal
 
@@ -752,7 +1006,7 @@ func TestStateLockPOLRelock(t *testing.T) {
 	incrementRound(vs2, vs3, vs4)
 
 	// timeout to new round
-	ensureNewTimeout(timeoutWaitCh, height, round, cs1.config.Precommit(round).Nanoseconds())
+	ensureNewTimeout(timeoutWaitCh, height, round, cs1.timeoutParams().PrecommitTimeout(round).Nanoseconds())
 
 	round++ // moving to the next round
 	//XXX: this isnt guaranteed to get there before the timeoutPropose ...
@@ -848,7 +1102,7 @@ func TestStateLockPOLUnlock(t *testing.T) {
 	require.NoError(t, err)
 
 	// timeout to new round
-	ensureNewTimeout(timeoutWaitCh, height, round, cs1.config.Precommit(round).Nanoseconds())
+	ensureNewTimeout(timeoutWaitCh, height, round, cs1.timeoutParams().PrecommitTimeout(round).Nanoseconds())
 	rs = cs1.GetRoundState()
 	lockedBlockHash := rs.LockedBlock.Hash()
 
@@ -952,7 +1206,7 @@ func TestStateLockPOLUnlockOnUnknownBlock(t *testing.T) {
 	incrementRound(vs2, vs3, vs4)
 
 	// timeout to new round
-	ensureNewTimeout(timeoutWaitCh, height, round, cs1.config.Precommit(round).Nanoseconds())
+	ensureNewTimeout(timeoutWaitCh, height, round, cs1.timeoutParams().PrecommitTimeout(round).Nanoseconds())
 
 	round++ // moving to the next round
 
@@ -998,7 +1252,7 @@ func TestStateLockPOLUnlockOnUnknownBlock(t *testing.T) {
 	incrementRound(vs2, vs3, vs4)
 
 	// timeout to new round
-	ensureNewTimeout(timeoutWaitCh, height, round, cs1.config.Precommit(round).Nanoseconds())
+	ensureNewTimeout(timeoutWaitCh, height, round, cs1.timeoutParams().PrecommitTimeout(round).Nanoseconds())
 
 	round++ // moving to the next round
 	ensureNewRound(newRoundCh, height, round)
@@ -1074,7 +1328,7 @@ func TestStateLockPOLSafety1(t *testing.T) {
 
 	// cs1 precommit nil
 	ensurePrecommit(voteCh, height, round)
-	ensureNewTimeout(timeoutWaitCh, height, round, cs1.config.Precommit(round).Nanoseconds())
+	ensureNewTimeout(timeoutWaitCh, height, round, cs1.timeoutParams().PrecommitTimeout(round).Nanoseconds())
 
 	t.Log("### ONTO ROUND 1")
 
@@ -1119,7 +1373,7 @@ func TestStateLockPOLSafety1(t *testing.T) {
 
 	signAddVotes(ctx, config, cs1, tmproto.PrecommitType, nil, types.PartSetHeader{}, vs2, vs3, vs4)
 
-	ensureNewTimeout(timeoutWaitCh, height, round, cs1.config.Precommit(round).Nanoseconds())
+	ensureNewTimeout(timeoutWaitCh, height, round, cs1.timeoutParams().PrecommitTimeout(round).Nanoseconds())
 
 	incrementRound(vs2, vs3, vs4)
 	round++ // moving to the next round
@@ -1132,7 +1386,7 @@ func TestStateLockPOLSafety1(t *testing.T) {
 	*/
 
 	// timeout of propose
-	ensureNewTimeout(timeoutProposeCh, height, round, cs1.config.Propose(round).Nanoseconds())
+	ensureNewTimeout(timeoutProposeCh, height, round, cs1.timeoutParams().ProposeTimeout(round).Nanoseconds())
 
 	// finish prevote
 	ensurePrevote(voteCh, height, round)
@@ -1224,11 +1478,11 @@ func TestStateLockPOLSafety2(t *testing.T) {
 	incrementRound(vs2, vs3, vs4)
 
 	// timeout of precommit wait to new round
-	ensureNewTimeout(timeoutWaitCh, height, round, cs1.config.Precommit(round).Nanoseconds())
+	ensureNewTimeout(timeoutWaitCh, height, round, cs1.timeoutParams().PrecommitTimeout(round).Nanoseconds())
 
 	round++ // moving to the next round
 	// in round 2 we see the polkad block from round 0
-	newProp := types.NewProposal(height, round, 0, propBlockID0)
+	newProp := types.NewProposal(height, round, 0, propBlockID0, propBlock0.Header.Time)
 	p := newProp.ToProto()
 	if err := vs3.SignProposal(ctx, config.ChainID(), p); err != nil {
 		t.Fatal(err)
@@ -1308,7 +1562,7 @@ func TestProposeValidBlock(t *testing.T) {
 
 	signAddVotes(ctx, config, cs1, tmproto.PrecommitType, nil, types.PartSetHeader{}, vs2, vs3, vs4)
 
-	ensureNewTimeout(timeoutWaitCh, height, round, cs1.config.Precommit(round).Nanoseconds())
+	ensureNewTimeout(timeoutWaitCh, height, round, cs1.timeoutParams().PrecommitTimeout(round).Nanoseconds())
 
 	incrementRound(vs2, vs3, vs4)
 	round++ // moving to the next round
@@ -1318,7 +1572,7 @@ func TestProposeValidBlock(t *testing.T) {
 	t.Log("### ONTO ROUND 2")
 
 	// timeout of propose
-	ensureNewTimeout(timeoutProposeCh, height, round, cs1.config.Propose(round).Nanoseconds())
+	ensureNewTimeout(timeoutProposeCh, height, round, cs1.timeoutParams().ProposeTimeout(round).Nanoseconds())
 
 	ensurePrevote(voteCh, height, round)
 	validatePrevote(ctx, t, cs1, round, vss[0], propBlockHash)
@@ -1341,7 +1595,7 @@ func TestProposeValidBlock(t *testing.T) {
 	ensureNewRound(newRoundCh, height, round)
 	t.Log("### ONTO ROUND 3")
 
-	ensureNewTimeout(timeoutWaitCh, height, round, cs1.config.Precommit(round).Nanoseconds())
+	ensureNewTimeout(timeoutWaitCh, height, round, cs1.timeoutParams().PrecommitTimeout(round).Nanoseconds())
 
 	round++ // moving to the next round
 
@@ -1401,7 +1655,7 @@ func TestSetValidBlockOnDelayedPrevote(t *testing.T) {
 	// vs3 send prevote nil
 	signAddVotes(ctx, config, cs1, tmproto.PrevoteType, nil, types.PartSetHeader{}, vs3)
 
-	ensureNewTimeout(timeoutWaitCh, height, round, cs1.config.Prevote(round).Nanoseconds())
+	ensureNewTimeout(timeoutWaitCh, height, round, cs1.timeoutParams().PrevoteTimeout(round).Nanoseconds())
 
 	ensurePrecommit(voteCh, height, round)
 	// we should have precommitted
@@ -1456,7 +1710,7 @@ func TestSetValidBlockOnDelayedProposal(t *testing.T) {
 	startTestRound(ctx, cs1, cs1.Height, round)
 	ensureNewRound(newRoundCh, height, round)
 
-	ensureNewTimeout(timeoutProposeCh, height, round, cs1.config.Propose(round).Nanoseconds())
+	ensureNewTimeout(timeoutProposeCh, height, round, cs1.timeoutParams().ProposeTimeout(round).Nanoseconds())
 
 	ensurePrevote(voteCh, height, round)
 	validatePrevote(ctx, t, cs1, round, vss[0], nil)
@@ -1470,7 +1724,7 @@ func TestSetValidBlockOnDelayedProposal(t *testing.T) {
 	signAddVotes(ctx, config, cs1, tmproto.PrevoteType, propBlockHash, propBlockParts.Header(), vs2, vs3, vs4)
 	ensureNewValidBlock(validBlockCh, height, round)
 
-	ensureNewTimeout(timeoutWaitCh, height, round, cs1.config.Prevote(round).Nanoseconds())
+	ensureNewTimeout(timeoutWaitCh, height, round, cs1.timeoutParams().PrevoteTimeout(round).Nanoseconds())
 
 	ensurePrecommit(voteCh, height, round)
 	validatePrecommit(ctx, t, cs1, round, -1, vss[0], nil, nil)
@@ -1510,7 +1764,7 @@ func TestWaitingTimeoutOnNilPolka(t *testing.T) {
 
 	signAddVotes(ctx, config, cs1, tmproto.PrecommitType, nil, types.PartSetHeader{}, vs2, vs3, vs4)
 
-	ensureNewTimeout(timeoutWaitCh, height, round, cs1.config.Precommit(round).Nanoseconds())
+	ensureNewTimeout(timeoutWaitCh, height, round, cs1.timeoutParams().PrecommitTimeout(round).Nanoseconds())
 	ensureNewRound(newRoundCh, height, round+1)
 }
 
@@ -1549,7 +1803,7 @@ func TestWaitingTimeoutProposeOnNewRound(t *testing.T) {
 	rs := cs1.GetRoundState()
 	assert.True(t, rs.Step == cstypes.RoundStepPropose) // P0 does not prevote before timeoutPropose expires
 
-	ensureNewTimeout(timeoutWaitCh, height, round, cs1.config.Propose(round).Nanoseconds())
+	ensureNewTimeout(timeoutWaitCh, height, round, cs1.timeoutParams().ProposeTimeout(round).Nanoseconds())
 
 	ensurePrevote(voteCh, height, round)
 	validatePrevote(ctx, t, cs1, round, vss[0], nil)
@@ -1590,7 +1844,7 @@ func TestRoundSkipOnNilPolkaFromHigherRound(t *testing.T) {
 	ensurePrecommit(voteCh, height, round)
 	validatePrecommit(ctx, t, cs1, round, -1, vss[0], nil, nil)
 
-	ensureNewTimeout(timeoutWaitCh, height, round, cs1.config.Precommit(round).Nanoseconds())
+	ensureNewTimeout(timeoutWaitCh, height, round, cs1.timeoutParams().PrecommitTimeout(round).Nanoseconds())
 
 	round++ // moving to the next round
 	ensureNewRound(newRoundCh, height, round)
@@ -1623,7 +1877,7 @@ func TestWaitTimeoutProposeOnNilPolkaForTheCurrentRound(t *testing.T) {
 	incrementRound(vss[1:]...)
 	signAddVotes(ctx, config, cs1, tmproto.PrevoteType, nil, types.PartSetHeader{}, vs2, vs3, vs4)
 
-	ensureNewTimeout(timeoutProposeCh, height, round, cs1.config.Propose(round).Nanoseconds())
+	ensureNewTimeout(timeoutProposeCh, height, round, cs1.timeoutParams().ProposeTimeout(round).Nanoseconds())
 
 	ensurePrevote(voteCh, height, round)
 	validatePrevote(ctx, t, cs1, round, vss[0], nil)
@@ -1788,7 +2042,7 @@ func TestStartNextHeightCorrectlyAfterTimeout(t *testing.T) {
 
 	cs1.txNotifier.(*fakeTxNotifier).Notify()
 
-	ensureNewTimeout(timeoutProposeCh, height+1, round, cs1.config.Propose(round).Nanoseconds())
+	ensureNewTimeout(timeoutProposeCh, height+1, round, cs1.timeoutParams().ProposeTimeout(round).Nanoseconds())
 	rs = cs1.GetRoundState()
 	assert.False(
 		t,
@@ -1991,7 +2245,7 @@ func TestStateHalt1(t *testing.T) {
 	incrementRound(vs2, vs3, vs4)
 
 	// timeout to new round
-	ensureNewTimeout(timeoutWaitCh, height, round, cs1.config.Precommit(round).Nanoseconds())
+	ensureNewTimeout(timeoutWaitCh, height, round, cs1.timeoutParams().PrecommitTimeout(round).Nanoseconds())
 
 	round++ // moving to the next round
 
@@ -2037,26 +2291,26 @@ func TestStateOutputsBlockPartsStats(t *testing.T) {
 	}
 
 	cs.ProposalBlockParts = types.NewPartSetFromHeader(parts.Header())
-	cs.handleMsg(ctx, msgInfo{msg, peerID})
+	cs.handleMsg(ctx, msgInfo{msg, peerID, tmtime.Now()})
 
 	statsMessage := <-cs.statsMsgQueue
 	require.Equal(t, msg, statsMessage.Msg, "")
 	require.Equal(t, peerID, statsMessage.PeerID, "")
 
 	// sending the same part from different peer
-	cs.handleMsg(ctx, msgInfo{msg, "peer2"})
+	cs.handleMsg(ctx, msgInfo{msg, "peer2", tmtime.Now()})
 
 	// sending the part with the same height, but different round
 	msg.Round = 1
-	cs.handleMsg(ctx, msgInfo{msg, peerID})
+	cs.handleMsg(ctx, msgInfo{msg, peerID, tmtime.Now()})
 
 	// sending the part from the smaller height
 	msg.Height = 0
-	cs.handleMsg(ctx, msgInfo{msg, peerID})
+	cs.handleMsg(ctx, msgInfo{msg, peerID, tmtime.Now()})
 
 	// sending the part from the bigger height
 	msg.Height = 3
-	cs.handleMsg(ctx, msgInfo{msg, peerID})
+	cs.handleMsg(ctx, msgInfo{msg, peerID, tmtime.Now()})
 
 	select {
 	case <-cs.statsMsgQueue:
@@ -2082,20 +2336,20 @@ func TestStateOutputVoteStats(t *testing.T) {
 	vote := signVote(ctx, vss[1], config, tmproto.PrecommitType, randBytes, types.PartSetHeader{})
 
 	voteMessage := &VoteMessage{vote}
-	cs.handleMsg(ctx, msgInfo{voteMessage, peerID})
+	cs.handleMsg(ctx, msgInfo{voteMessage, peerID, tmtime.Now()})
 
 	statsMessage := <-cs.statsMsgQueue
 	require.Equal(t, voteMessage, statsMessage.Msg, "")
 	require.Equal(t, peerID, statsMessage.PeerID, "")
 
 	// sending the same part from different peer
-	cs.handleMsg(ctx, msgInfo{&VoteMessage{vote}, "peer2"})
+	cs.handleMsg(ctx, msgInfo{&VoteMessage{vote}, "peer2", tmtime.Now()})
 
 	// sending the vote for the bigger height
 	incrementHeight(vss[1])
 	vote = signVote(ctx, vss[1], config, tmproto.PrecommitType, randBytes, types.PartSetHeader{})
 
-	cs.handleMsg(ctx, msgInfo{&VoteMessage{vote}, peerID})
+	cs.handleMsg(ctx, msgInfo{&VoteMessage{vote}, peerID, tmtime.Now()})
 
 	select {
 	case <-cs.statsMsgQueue:
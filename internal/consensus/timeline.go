@@ -0,0 +1,168 @@
+package consensus
+
+import (
+	"time"
+
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	tmtime "github.com/tendermint/tendermint/libs/time"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	// timelineHeights is the number of heights, including the current one,
+	// whose timeline is kept in memory.
+	timelineHeights = 100
+
+	// timelineMaxEvents bounds the number of events recorded for a single
+	// height. Events past the bound are counted but not kept.
+	timelineMaxEvents = 5000
+)
+
+// TimelineEventType is the kind of a TimelineEvent.
+type TimelineEventType string
+
+const (
+	TimelineEventStep      TimelineEventType = "step"
+	TimelineEventProposal  TimelineEventType = "proposal"
+	TimelineEventBlockPart TimelineEventType = "block_part"
+	TimelineEventVote      TimelineEventType = "vote"
+	TimelineEventTimeout   TimelineEventType = "timeout"
+)
+
+// TimelineEvent is something that happened to the state machine at a height.
+// Only the fields relevant to Type are set.
+type TimelineEvent struct {
+	Time  time.Time         `json:"time"`
+	Type  TimelineEventType `json:"type"`
+	Round int32             `json:"round"`
+
+	// Step is the step entered for a step event, and the step that timed
+	// out for a timeout event.
+	Step     string        `json:"step,omitempty"`
+	Duration time.Duration `json:"duration,omitempty"`
+
+	// the part index of a block part event
+	PartIndex *uint32 `json:"part_index,omitempty"`
+
+	// the validator and type of a vote event
+	VoteType         string        `json:"vote_type,omitempty"`
+	ValidatorAddress types.Address `json:"validator_address,omitempty"`
+	ValidatorIndex   *int32        `json:"validator_index,omitempty"`
+}
+
+// HeightTimeline is the timeline of a height, oldest event first.
+type HeightTimeline struct {
+	Height int64           `json:"height"`
+	Events []TimelineEvent `json:"events"`
+	// the number of events that exceeded the bound and were dropped
+	Dropped int `json:"dropped"`
+}
+
+// timeline keeps the timelines of the last timelineHeights heights.
+// It is not safe for concurrent use; State guards it with its mutex.
+type timeline struct {
+	heights   []*HeightTimeline // oldest first
+	maxEvents int
+}
+
+func newTimeline() *timeline {
+	return &timeline{maxEvents: timelineMaxEvents}
+}
+
+// record appends ev to the timeline of height. Events for a height that is
+// no longer kept are dropped.
+func (tl *timeline) record(height int64, ev TimelineEvent) {
+	var ht *HeightTimeline
+	if n := len(tl.heights); n > 0 {
+		last := tl.heights[n-1]
+		switch {
+		case height == last.Height:
+			ht = last
+		case height < last.Height:
+			// votes for the previous height keep arriving during the
+			// commit timeout
+			if ht = tl.get(height); ht == nil {
+				return
+			}
+		}
+	}
+
+	if ht == nil {
+		ht = &HeightTimeline{Height: height}
+		if len(tl.heights) == timelineHeights {
+			tl.heights[0] = nil
+			tl.heights = tl.heights[1:]
+		}
+		tl.heights = append(tl.heights, ht)
+	}
+
+	if len(ht.Events) >= tl.maxEvents {
+		ht.Dropped++
+		return
+	}
+	ht.Events = append(ht.Events, ev)
+}
+
+// get returns the timeline of height, or nil if it is not kept.
+func (tl *timeline) get(height int64) *HeightTimeline {
+	for i := len(tl.heights) - 1; i >= 0; i-- {
+		if tl.heights[i].Height == height {
+			return tl.heights[i]
+		}
+	}
+	return nil
+}
+
+// recordTimeline records ev for height, unless the state machine is
+// replaying the WAL, whose messages did not arrive now.
+func (cs *State) recordTimeline(height int64, ev TimelineEvent) {
+	if cs.replayMode {
+		return
+	}
+	if ev.Time.IsZero() {
+		ev.Time = tmtime.Now()
+	}
+	cs.timeline.record(height, ev)
+}
+
+func (cs *State) recordVoteTimeline(vote *types.Vote) {
+	idx := vote.ValidatorIndex
+	cs.recordTimeline(vote.Height, TimelineEvent{
+		Type:             TimelineEventVote,
+		Round:            vote.Round,
+		VoteType:         voteTypeString(vote.Type),
+		ValidatorAddress: vote.ValidatorAddress,
+		ValidatorIndex:   &idx,
+	})
+}
+
+func voteTypeString(t tmproto.SignedMsgType) string {
+	switch t {
+	case tmproto.PrevoteType:
+		return "prevote"
+	case tmproto.PrecommitType:
+		return "precommit"
+	default:
+		return t.String()
+	}
+}
+
+// GetTimelineJSON returns a json of the timeline of the given height, which
+// holds the step transitions, the arrival of the proposal, its block parts and
+// the votes, and the timeouts that fired. A height of 0 selects the current
+// height. It returns nil if the timeline of the height is no longer or not
+// yet kept.
+func (cs *State) GetTimelineJSON(height int64) ([]byte, error) {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+
+	if height == 0 {
+		height = cs.Height
+	}
+	ht := cs.timeline.get(height)
+	if ht == nil {
+		return nil, nil
+	}
+	return tmjson.Marshal(ht)
+}
@@ -0,0 +1,113 @@
+package consensus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cstypes "github.com/tendermint/tendermint/internal/consensus/types"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestTimelineBounds(t *testing.T) {
+	tl := newTimeline()
+	tl.maxEvents = 3
+
+	for h := int64(1); h <= timelineHeights+5; h++ {
+		for i := 0; i < 4; i++ {
+			tl.record(h, TimelineEvent{Type: TimelineEventStep})
+		}
+	}
+
+	// the oldest heights are pruned
+	require.Len(t, tl.heights, timelineHeights)
+	assert.Nil(t, tl.get(5))
+	ht := tl.get(6)
+	require.NotNil(t, ht)
+	assert.Len(t, ht.Events, 3)
+	assert.Equal(t, 1, ht.Dropped)
+
+	// late events of a kept height are recorded, of a pruned one dropped
+	tl.maxEvents = 10
+	tl.record(6, TimelineEvent{Type: TimelineEventVote})
+	assert.Len(t, tl.get(6).Events, 4)
+	tl.record(2, TimelineEvent{Type: TimelineEventVote})
+	assert.Nil(t, tl.get(2))
+	assert.Len(t, tl.heights, timelineHeights)
+}
+
+func TestStateTimeline(t *testing.T) {
+	config := configSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cs1, vss, err := randState(ctx, t, config, log.TestingLogger(), 1)
+	require.NoError(t, err)
+	height, round := cs1.Height, cs1.Round
+
+	newRoundCh := subscribe(ctx, t, cs1.eventBus, types.EventQueryNewRound)
+
+	startTestRound(ctx, cs1, height, round)
+	ensureNewRound(newRoundCh, height, round)
+	ensureNewRound(newRoundCh, height+1, 0)
+
+	bz, err := cs1.GetTimelineJSON(height)
+	require.NoError(t, err)
+	var ht HeightTimeline
+	require.NoError(t, tmjson.Unmarshal(bz, &ht))
+	assert.Equal(t, height, ht.Height)
+
+	pubKey, err := vss[0].GetPubKey(ctx)
+	require.NoError(t, err)
+
+	var (
+		steps     []string
+		proposals int
+		parts     int
+		votes     = map[string]int{}
+	)
+	for _, ev := range ht.Events {
+		assert.False(t, ev.Time.IsZero())
+		switch ev.Type {
+		case TimelineEventStep:
+			steps = append(steps, ev.Step)
+		case TimelineEventProposal:
+			proposals++
+		case TimelineEventBlockPart:
+			require.NotNil(t, ev.PartIndex)
+			parts++
+		case TimelineEventVote:
+			assert.Equal(t, pubKey.Address(), ev.ValidatorAddress)
+			require.NotNil(t, ev.ValidatorIndex)
+			assert.EqualValues(t, 0, *ev.ValidatorIndex)
+			votes[ev.VoteType]++
+		}
+	}
+
+	assert.Subset(t, steps, []string{
+		cstypes.RoundStepNewRound.String(),
+		cstypes.RoundStepPropose.String(),
+		cstypes.RoundStepPrevote.String(),
+		cstypes.RoundStepPrecommit.String(),
+		cstypes.RoundStepCommit.String(),
+	})
+	assert.Equal(t, 1, proposals)
+	assert.NotZero(t, parts)
+	assert.Equal(t, map[string]int{"prevote": 1, "precommit": 1}, votes)
+
+	// the current height is selected by default
+	bz, err = cs1.GetTimelineJSON(0)
+	require.NoError(t, err)
+	var current HeightTimeline
+	require.NoError(t, tmjson.Unmarshal(bz, &current))
+	assert.Greater(t, current.Height, height)
+
+	// a height that is not kept has no timeline
+	bz, err = cs1.GetTimelineJSON(height + timelineHeights*10)
+	require.NoError(t, err)
+	assert.Nil(t, bz)
+}
@@ -104,7 +104,7 @@ func (hvs *HeightVoteSet) addRound(round int32) {
 	}
 	// log.Debug("addRound(round)", "round", round)
 	prevotes := types.NewVoteSet(hvs.chainID, hvs.height, round, tmproto.PrevoteType, hvs.valSet)
-	precommits := types.NewVoteSet(hvs.chainID, hvs.height, round, tmproto.PrecommitType, hvs.valSet)
+	precommits := types.NewExtendedVoteSet(hvs.chainID, hvs.height, round, tmproto.PrecommitType, hvs.valSet)
 	hvs.roundVoteSets[round] = RoundVoteSet{
 		Prevotes:   prevotes,
 		Precommits: precommits,
@@ -135,6 +135,27 @@ func (hvs *HeightVoteSet) AddVote(vote *types.Vote, peerID types.NodeID) (added
 	return
 }
 
+// AddCommit adds the precommits of a commit to the precommits of its round,
+// which is added like that of a vote if needed.
+func (hvs *HeightVoteSet) AddCommit(commit *types.Commit, peerID types.NodeID) (added bool, err error) {
+	hvs.mtx.Lock()
+	defer hvs.mtx.Unlock()
+	voteSet := hvs.getVoteSet(commit.Round, tmproto.PrecommitType)
+	if voteSet == nil {
+		if rndz := hvs.peerCatchupRounds[peerID]; len(rndz) < 2 {
+			hvs.addRound(commit.Round)
+			voteSet = hvs.getVoteSet(commit.Round, tmproto.PrecommitType)
+			hvs.peerCatchupRounds[peerID] = append(rndz, commit.Round)
+		} else {
+			// punish peer
+			err = ErrGotVoteFromUnwantedRound
+			return
+		}
+	}
+	added, err = voteSet.AddCommit(commit)
+	return
+}
+
 func (hvs *HeightVoteSet) Prevotes(round int32) *types.VoteSet {
 	hvs.mtx.Lock()
 	defer hvs.mtx.Unlock()
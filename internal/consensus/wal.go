@@ -1,13 +1,16 @@
 package consensus
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"hash/crc32"
 	"io"
+	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -27,6 +30,18 @@ const (
 
 	// how often the WAL should be sync'd during period sync'ing
 	walDefaultFlushInterval = 2 * time.Second
+
+	// walVersion is the version of the WAL format, written in every end
+	// height marker.
+	walVersion = 2
+
+	// walMarkerMagic starts the data of the records that mark the end of a
+	// height. An encoded TimedWALMessage can't start with it, as 'T' is an
+	// end group tag.
+	walMarkerMagic = "TMWAL"
+
+	// magic + version + height + time (seconds and nanoseconds)
+	walMarkerSize = len(walMarkerMagic) + 1 + 8 + 8 + 4
 )
 
 //--------------------------------------------------------
@@ -69,11 +84,25 @@ type WAL interface {
 	Wait()
 }
 
-// Write ahead logger writes msgs to disk before they are processed.
-// Can be used for crash-recovery and deterministic replay.
-// TODO: currently the wal is overwritten during replay catchup, give it a mode
-// so it's either reading or appending - must read to end to start appending
-// again.
+/*
+Write ahead logger writes msgs to disk before they are processed.
+Can be used for crash-recovery and deterministic replay.
+
+The WAL is stored in the files of an autofile.Group, its segments. Every
+EndHeightMessage starts a new segment, so that the segment starting with the
+#ENDHEIGHT marker of a height holds the messages of the next height. The
+segments are indexed by the height of their marker, which lets
+SearchForEndHeight open the segment of a height without scanning the WAL.
+Once the marker of a height is written, the segments holding only older
+heights are removed.
+
+A record that was only partially written when the node crashed is truncated
+from the head when the WAL is opened.
+
+TODO: currently the wal is overwritten during replay catchup, give it a mode
+so it's either reading or appending - must read to end to start appending
+again.
+*/
 type BaseWAL struct {
 	service.BaseService
 	logger log.Logger
@@ -84,6 +113,9 @@ type BaseWAL struct {
 
 	flushTicker   *time.Ticker
 	flushInterval time.Duration
+
+	mtx      sync.Mutex
+	segments map[int64]int // end height -> index of the segment it starts
 }
 
 var _ WAL = &BaseWAL{}
@@ -96,6 +128,10 @@ func NewWAL(logger log.Logger, walFile string, groupOptions ...func(*auto.Group)
 		return nil, fmt.Errorf("failed to ensure WAL directory is in place: %w", err)
 	}
 
+	if err := repairWALTail(logger, walFile); err != nil {
+		return nil, fmt.Errorf("failed to repair the end of the WAL: %w", err)
+	}
+
 	group, err := auto.OpenGroup(logger, walFile, groupOptions...)
 	if err != nil {
 		return nil, err
@@ -105,11 +141,41 @@ func NewWAL(logger log.Logger, walFile string, groupOptions ...func(*auto.Group)
 		group:         group,
 		enc:           NewWALEncoder(group),
 		flushInterval: walDefaultFlushInterval,
+		segments:      make(map[int64]int),
 	}
 	wal.BaseService = *service.NewBaseService(logger, "baseWAL", wal)
+
+	if err := wal.indexSegments(); err != nil {
+		return nil, err
+	}
 	return wal, nil
 }
 
+// indexSegments reads the marker each segment of the group starts with.
+// Segments that were rotated for their size, as well as the segments of a
+// WAL of the previous version, don't start with one.
+func (wal *BaseWAL) indexSegments() error {
+	min, max := wal.group.MinIndex(), wal.group.MaxIndex()
+	for index := min; index <= max; index++ {
+		f, err := os.Open(wal.group.FilePath(index))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		msg, err := NewWALDecoder(f).Decode()
+		f.Close()
+		if err != nil {
+			continue
+		}
+		if m, ok := msg.Msg.(EndHeightMessage); ok {
+			wal.segments[m.Height] = index
+		}
+	}
+	return nil
+}
+
 // SetFlushInterval allows us to override the periodic flush interval for the WAL.
 func (wal *BaseWAL) SetFlushInterval(i time.Duration) {
 	wal.flushInterval = i
@@ -123,7 +189,7 @@ func (wal *BaseWAL) OnStart(ctx context.Context) error {
 	size, err := wal.group.Head.Size()
 	if err != nil {
 		return err
-	} else if size == 0 {
+	} else if size == 0 && wal.group.MinIndex() == wal.group.MaxIndex() {
 		if err := wal.WriteSync(EndHeightMessage{0}); err != nil {
 			return err
 		}
@@ -193,6 +259,10 @@ func (wal *BaseWAL) Write(msg WALMessage) error {
 		return nil
 	}
 
+	if m, ok := msg.(EndHeightMessage); ok {
+		return wal.writeEndHeight(m)
+	}
+
 	if err := wal.enc.Encode(&TimedWALMessage{tmtime.Now(), msg}); err != nil {
 		wal.logger.Error("error writing msg to consensus wal. WARNING: recover may not be possible for the current height",
 			"err", err, "msg", msg)
@@ -202,6 +272,45 @@ func (wal *BaseWAL) Write(msg WALMessage) error {
 	return nil
 }
 
+// writeEndHeight starts a new segment with the marker of the given height, and
+// removes the segments that hold only the heights before it.
+func (wal *BaseWAL) writeEndHeight(msg EndHeightMessage) error {
+	size, err := wal.group.Head.Size()
+	if err != nil {
+		return err
+	}
+	if size > 0 || wal.group.Buffered() > 0 {
+		wal.group.RotateFile()
+	}
+
+	if err := wal.enc.Encode(&TimedWALMessage{tmtime.Now(), msg}); err != nil {
+		wal.logger.Error("error writing end height to consensus wal", "err", err, "height", msg.Height)
+		return err
+	}
+
+	wal.mtx.Lock()
+	defer wal.mtx.Unlock()
+
+	wal.segments[msg.Height] = wal.group.MaxIndex()
+
+	// the segment starting with the previous marker holds the messages of
+	// this height, which are kept
+	prev, ok := wal.segments[msg.Height-1]
+	if !ok || prev <= wal.group.MinIndex() {
+		return nil
+	}
+	if err := wal.group.RemoveFilesBefore(prev); err != nil {
+		wal.logger.Error("failed to remove old WAL segments", "err", err)
+		return nil
+	}
+	for height, index := range wal.segments {
+		if index < prev {
+			delete(wal.segments, height)
+		}
+	}
+	return nil
+}
+
 // WriteSync is called when we receive a msg from ourselves
 // so that we write to disk before sending signed messages.
 // NOTE: calls fsync()
@@ -242,6 +351,23 @@ func (wal *BaseWAL) SearchForEndHeight(
 		msg *TimedWALMessage
 		gr  *auto.GroupReader
 	)
+
+	wal.mtx.Lock()
+	index, ok := wal.segments[height]
+	wal.mtx.Unlock()
+	if ok {
+		gr, err = wal.group.NewReader(index)
+		if err != nil {
+			return nil, false, err
+		}
+		// skip the marker
+		if _, err := NewWALDecoder(gr).Decode(); err != nil {
+			gr.Close()
+			return nil, false, err
+		}
+		return gr, true, nil
+	}
+
 	lastHeightFound := int64(-1)
 
 	// NOTE: starting from the last file in the group because we're usually
@@ -292,6 +418,11 @@ func (wal *BaseWAL) SearchForEndHeight(
 // A WALEncoder writes custom-encoded WAL messages to an output stream.
 //
 // Format: 4 bytes CRC sum + 4 bytes length + arbitrary-length value
+//
+// The value is a protobuf encoded TimedWALMessage, except for an
+// EndHeightMessage, which is encoded as a marker:
+// "TMWAL" + 1 byte version + 8 bytes height + 8 bytes seconds + 4 bytes
+// nanoseconds of the time.
 type WALEncoder struct {
 	wr io.Writer
 }
@@ -305,18 +436,23 @@ func NewWALEncoder(wr io.Writer) *WALEncoder {
 // the encoded size of v is greater than 1MB. Any error encountered
 // during the write is also returned.
 func (enc *WALEncoder) Encode(v *TimedWALMessage) error {
-	pbMsg, err := WALToProto(v.Msg)
-	if err != nil {
-		return err
-	}
-	pv := tmcons.TimedWALMessage{
-		Time: v.Time,
-		Msg:  pbMsg,
-	}
+	var data []byte
+	if m, ok := v.Msg.(EndHeightMessage); ok {
+		data = encodeWALMarker(m.Height, v.Time)
+	} else {
+		pbMsg, err := WALToProto(v.Msg)
+		if err != nil {
+			return err
+		}
+		pv := tmcons.TimedWALMessage{
+			Time: v.Time,
+			Msg:  pbMsg,
+		}
 
-	data, err := proto.Marshal(&pv)
-	if err != nil {
-		panic(fmt.Errorf("encode timed wall message failure: %w", err))
+		data, err = proto.Marshal(&pv)
+		if err != nil {
+			panic(fmt.Errorf("encode timed wall message failure: %w", err))
+		}
 	}
 
 	crc := crc32.Checksum(data, crc32c)
@@ -331,10 +467,42 @@ func (enc *WALEncoder) Encode(v *TimedWALMessage) error {
 	binary.BigEndian.PutUint32(msg[4:8], length)
 	copy(msg[8:], data)
 
-	_, err = enc.wr.Write(msg)
+	_, err := enc.wr.Write(msg)
 	return err
 }
 
+func encodeWALMarker(height int64, t time.Time) []byte {
+	data := make([]byte, walMarkerSize)
+	n := copy(data, walMarkerMagic)
+	data[n] = walVersion
+	binary.BigEndian.PutUint64(data[n+1:], uint64(height))
+	binary.BigEndian.PutUint64(data[n+9:], uint64(t.Unix()))
+	binary.BigEndian.PutUint32(data[n+17:], uint32(t.Nanosecond()))
+	return data
+}
+
+// decodeWALMarker decodes data if it is a marker. It returns false otherwise.
+func decodeWALMarker(data []byte) (*TimedWALMessage, bool, error) {
+	if !bytes.HasPrefix(data, []byte(walMarkerMagic)) {
+		return nil, false, nil
+	}
+	n := len(walMarkerMagic)
+	if len(data) != walMarkerSize {
+		return nil, true, fmt.Errorf("marker has %d bytes, expected %d", len(data), walMarkerSize)
+	}
+	if data[n] != walVersion {
+		return nil, true, fmt.Errorf("unsupported WAL version %d", data[n])
+	}
+
+	height := int64(binary.BigEndian.Uint64(data[n+1:]))
+	sec := int64(binary.BigEndian.Uint64(data[n+9:]))
+	nsec := int64(binary.BigEndian.Uint32(data[n+17:]))
+	return &TimedWALMessage{
+		Time: time.Unix(sec, nsec).UTC(),
+		Msg:  EndHeightMessage{height},
+	}, true, nil
+}
+
 // IsDataCorruptionError returns true if data has been corrupted inside WAL.
 func IsDataCorruptionError(err error) bool {
 	_, ok := err.(DataCorruptionError)
@@ -407,6 +575,13 @@ func (dec *WALDecoder) Decode() (*TimedWALMessage, error) {
 		return nil, DataCorruptionError{fmt.Errorf("checksums do not match: read: %v, actual: %v", crc, actualCRC)}
 	}
 
+	if marker, ok, err := decodeWALMarker(data); ok {
+		if err != nil {
+			return nil, DataCorruptionError{err}
+		}
+		return marker, nil
+	}
+
 	var res = new(tmcons.TimedWALMessage)
 	err = proto.Unmarshal(data, res)
 	if err != nil {
@@ -425,6 +600,47 @@ func (dec *WALDecoder) Decode() (*TimedWALMessage, error) {
 	return tMsgWal, err
 }
 
+// repairWALTail truncates the file at walFile after its last complete record,
+// which drops a record that was only partially written when the node
+// crashed. The last record is partial if it extends past the end of the file
+// or its checksum does not match. Corrupted records before the last one are
+// left for the decoder to report.
+func repairWALTail(logger log.Logger, walFile string) error {
+	data, err := os.ReadFile(walFile)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var offset int
+	for offset < len(data) {
+		if len(data)-offset < 8 {
+			break
+		}
+		crc := binary.BigEndian.Uint32(data[offset : offset+4])
+		length := int(binary.BigEndian.Uint32(data[offset+4 : offset+8]))
+		end := offset + 8 + length
+		if end > len(data) {
+			break
+		}
+		if crc32.Checksum(data[offset+8:end], crc32c) != crc {
+			if end < len(data) {
+				return nil
+			}
+			break
+		}
+		offset = end
+	}
+	if offset == len(data) {
+		return nil
+	}
+
+	logger.Error("truncating partially written record at the end of the WAL",
+		"file", walFile, "offset", offset, "dropped_bytes", len(data)-offset)
+	return os.Truncate(walFile, int64(offset))
+}
+
 type nilWAL struct{}
 
 var _ WAL = nilWAL{}
@@ -3,6 +3,8 @@ package consensus
 import (
 	"bytes"
 	"context"
+	"io"
+	"os"
 	"path/filepath"
 
 	"testing"
@@ -201,3 +203,121 @@ func TestWALPeriodicSync(t *testing.T) {
 		gr.Close()
 	}
 }
+
+func TestWALSegments(t *testing.T) {
+	walDir := t.TempDir()
+	walFile := filepath.Join(walDir, "wal")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	wal, err := NewWAL(log.TestingLogger(), walFile)
+	require.NoError(t, err)
+	require.NoError(t, wal.Start(ctx))
+
+	writeHeight := func(height int64) {
+		for round := int32(0); round < 3; round++ {
+			require.NoError(t, wal.Write(timeoutInfo{Duration: time.Second, Height: height, Round: round}))
+		}
+		require.NoError(t, wal.WriteSync(EndHeightMessage{height}))
+	}
+
+	// every height starts a new segment and only the segments holding the
+	// last committed height are kept
+	for h := int64(1); h <= 4; h++ {
+		writeHeight(h)
+	}
+	assert.Equal(t, 4, wal.Group().MaxIndex())
+	assert.Equal(t, 3, wal.Group().MinIndex())
+
+	assertFound := func(wal *BaseWAL, height int64) {
+		t.Helper()
+		gr, found, err := wal.SearchForEndHeight(height, &WALSearchOptions{})
+		require.NoError(t, err)
+		require.True(t, found, "expected to find end height for %d", height)
+		defer gr.Close()
+
+		msg, err := NewWALDecoder(gr).Decode()
+		require.NoError(t, err)
+		assert.Equal(t, timeoutInfo{Duration: time.Second, Height: height + 1}, msg.Msg)
+	}
+
+	require.NoError(t, wal.Write(timeoutInfo{Duration: time.Second, Height: 5}))
+	require.NoError(t, wal.FlushAndSync())
+	assertFound(wal, 4)
+	assertFound(wal, 3)
+
+	_, found, err := wal.SearchForEndHeight(2, &WALSearchOptions{})
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	require.NoError(t, wal.Stop())
+	wal.Wait()
+
+	// the index is rebuilt when the WAL is opened again
+	wal, err = NewWAL(log.TestingLogger(), walFile)
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]int{3: 3, 4: 4}, wal.segments)
+	assertFound(wal, 4)
+}
+
+func TestWALRepairTail(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	walBody, err := WALWithNBlocks(ctx, t, 3)
+	require.NoError(t, err)
+
+	record := new(bytes.Buffer)
+	err = NewWALEncoder(record).Encode(&TimedWALMessage{
+		Time: tmtime.Now(),
+		Msg:  timeoutInfo{Duration: time.Second, Height: 4, Round: 1},
+	})
+	require.NoError(t, err)
+
+	corruptChecksum := append([]byte{}, record.Bytes()...)
+	corruptChecksum[0]++
+
+	testCases := []struct {
+		name     string
+		tail     []byte
+		repaired bool
+	}{
+		{"partial length", record.Bytes()[:6], true},
+		{"partial data", record.Bytes()[:record.Len()-1], true},
+		{"checksum mismatch", corruptChecksum, true},
+		{"checksum mismatch before the last record", append(corruptChecksum, record.Bytes()...), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			walFile := tempWALWithData(append(append([]byte{}, walBody...), tc.tail...))
+
+			wal, err := NewWAL(log.TestingLogger(), walFile)
+			require.NoError(t, err)
+
+			gr, found, err := wal.SearchForEndHeight(2, &WALSearchOptions{})
+			require.NoError(t, err)
+			require.True(t, found)
+			defer gr.Close()
+
+			dec := NewWALDecoder(gr)
+			for {
+				_, err = dec.Decode()
+				if err != nil {
+					break
+				}
+			}
+			if tc.repaired {
+				assert.Equal(t, io.EOF, err)
+
+				info, err := os.Stat(walFile)
+				require.NoError(t, err)
+				assert.EqualValues(t, len(walBody), info.Size())
+			} else {
+				assert.True(t, IsDataCorruptionError(err), "expected data corruption error, got %v", err)
+			}
+		})
+	}
+}
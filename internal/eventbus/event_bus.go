@@ -88,7 +88,7 @@ func (b *EventBus) Publish(ctx context.Context, eventValue string, eventData typ
 }
 
 func (b *EventBus) PublishEventNewBlock(ctx context.Context, data types.EventDataNewBlock) error {
-	events := append(data.ResultBeginBlock.Events, data.ResultEndBlock.Events...)
+	events := data.ResultFinalizeBlock.Events
 
 	// add Tendermint-reserved new block event
 	events = append(events, types.EventNewBlock)
@@ -99,7 +99,7 @@ func (b *EventBus) PublishEventNewBlock(ctx context.Context, data types.EventDat
 func (b *EventBus) PublishEventNewBlockHeader(ctx context.Context, data types.EventDataNewBlockHeader) error {
 	// no explicit deadline for publishing events
 
-	events := append(data.ResultBeginBlock.Events, data.ResultEndBlock.Events...)
+	events := data.ResultFinalizeBlock.Events
 
 	// add Tendermint-reserved new block header event
 	events = append(events, types.EventNewBlockHeader)
@@ -111,6 +111,84 @@ func (b *EventBus) PublishEventNewEvidence(ctx context.Context, evidence types.E
 	return b.Publish(ctx, types.EventNewEvidenceValue, evidence)
 }
 
+// PublishEventAdmittedTx publishes an admitted tx event. Note it will add
+// predefined keys (EventTypeKey, TxHashKey and, if the transaction has a
+// sender, TxSenderKey).
+func (b *EventBus) PublishEventAdmittedTx(ctx context.Context, data types.EventDataAdmittedTx) error {
+	return b.publishMempoolEvent(ctx, types.EventAdmittedTxValue, data, data.Tx, data.Sender)
+}
+
+// PublishEventRejectedTx publishes a rejected tx event. Note it will add
+// predefined keys (EventTypeKey, TxHashKey).
+func (b *EventBus) PublishEventRejectedTx(ctx context.Context, data types.EventDataRejectedTx) error {
+	return b.publishMempoolEvent(ctx, types.EventRejectedTxValue, data, data.Tx, "")
+}
+
+// PublishEventEvictedTx publishes an evicted tx event. Note it will add
+// predefined keys (EventTypeKey, TxHashKey and, if the transaction has a
+// sender, TxSenderKey).
+func (b *EventBus) PublishEventEvictedTx(ctx context.Context, data types.EventDataEvictedTx) error {
+	return b.publishMempoolEvent(ctx, types.EventEvictedTxValue, data, data.Tx, data.Sender)
+}
+
+func (b *EventBus) PublishEventReplacedTx(ctx context.Context, data types.EventDataReplacedTx) error {
+	return b.Publish(ctx, types.EventReplacedTxValue, data)
+}
+
+// PublishEventCommittedTx publishes a committed tx event. Note it will add
+// predefined keys (EventTypeKey, TxHashKey and, if the transaction has a
+// sender, TxSenderKey).
+func (b *EventBus) PublishEventCommittedTx(ctx context.Context, data types.EventDataCommittedTx) error {
+	return b.publishMempoolEvent(ctx, types.EventCommittedTxValue, data, data.Tx, data.Sender)
+}
+
+// publishMempoolEvent publishes a mempool event about tx, so that it can be
+// queried by the hash and sender of the transaction.
+func (b *EventBus) publishMempoolEvent(
+	ctx context.Context,
+	eventValue string,
+	eventData types.TMEventData,
+	tx types.Tx,
+	sender string,
+) error {
+	tokens := strings.Split(types.EventTypeKey, ".")
+	events := []abci.Event{{
+		Type: tokens[0],
+		Attributes: []abci.EventAttribute{
+			{
+				Key:   tokens[1],
+				Value: eventValue,
+			},
+		},
+	}}
+
+	tokens = strings.Split(types.TxHashKey, ".")
+	events = append(events, abci.Event{
+		Type: tokens[0],
+		Attributes: []abci.EventAttribute{
+			{
+				Key:   tokens[1],
+				Value: fmt.Sprintf("%X", tx.Hash()),
+			},
+		},
+	})
+
+	if sender != "" {
+		tokens = strings.Split(types.TxSenderKey, ".")
+		events = append(events, abci.Event{
+			Type: tokens[0],
+			Attributes: []abci.EventAttribute{
+				{
+					Key:   tokens[1],
+					Value: sender,
+				},
+			},
+		})
+	}
+
+	return b.pubsub.PublishWithEvents(ctx, eventData, events)
+}
+
 func (b *EventBus) PublishEventVote(ctx context.Context, data types.EventDataVote) error {
 	return b.Publish(ctx, types.EventVoteValue, data)
 }
@@ -203,7 +281,8 @@ func (b *EventBus) PublishEventValidatorSetUpdates(ctx context.Context, data typ
 
 //-----------------------------------------------------------------------------
 
-// NopEventBus implements a types.BlockEventPublisher that discards all events.
+// NopEventBus implements a types.BlockEventPublisher and a
+// types.MempoolEventPublisher that discards all events.
 type NopEventBus struct{}
 
 func (NopEventBus) PublishEventNewBlock(context.Context, types.EventDataNewBlock) error {
@@ -225,3 +304,23 @@ func (NopEventBus) PublishEventTx(context.Context, types.EventDataTx) error {
 func (NopEventBus) PublishEventValidatorSetUpdates(context.Context, types.EventDataValidatorSetUpdates) error {
 	return nil
 }
+
+func (NopEventBus) PublishEventAdmittedTx(context.Context, types.EventDataAdmittedTx) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventRejectedTx(context.Context, types.EventDataRejectedTx) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventEvictedTx(context.Context, types.EventDataEvictedTx) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventReplacedTx(context.Context, types.EventDataReplacedTx) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventCommittedTx(context.Context, types.EventDataCommittedTx) error {
+	return nil
+}
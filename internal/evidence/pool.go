@@ -22,10 +22,19 @@ import (
 
 const (
 	// prefixes are unique across all tm db's
-	prefixCommitted = int64(9)
-	prefixPending   = int64(10)
+	prefixCommitted       = int64(9)
+	prefixPending         = int64(10)
+	prefixCommittedHeight = int64(11)
+	prefixCommittedHash   = int64(12)
 )
 
+// CommittedEvidence is evidence along with the height of the block it was
+// committed in.
+type CommittedEvidence struct {
+	Evidence types.Evidence
+	Height   int64
+}
+
 // Pool maintains a pool of valid evidence to be broadcasted and committed
 type Pool struct {
 	logger log.Logger
@@ -46,6 +55,8 @@ type Pool struct {
 	// before being flushed to the pool. This prevents broadcasting and proposing of
 	// evidence before the height with which the evidence happened is finished.
 	consensusBuffer []duplicateVoteSet
+	// conflicting proposals from consensus are buffered likewise
+	proposalBuffer []duplicateProposalSet
 
 	pruningHeight int64
 	pruningTime   time.Time
@@ -67,6 +78,7 @@ func NewPool(logger log.Logger, evidenceDB dbm.DB, stateDB sm.Store, blockStore
 		evidenceStore:   evidenceDB,
 		evidenceList:    clist.New(),
 		consensusBuffer: make([]duplicateVoteSet, 0),
+		proposalBuffer:  make([]duplicateProposalSet, 0),
 	}
 
 	// If pending evidence already in db, in event of prior failure, then check
@@ -101,10 +113,86 @@ func (evpool *Pool) PendingEvidence(maxBytes int64) ([]types.Evidence, int64) {
 	return evidence, size
 }
 
+// CommittedEvidence returns the evidence with the given hash and the height of
+// the block it was committed in. It returns nil if no such evidence was
+// committed since the node started keeping the committed evidence index.
+func (evpool *Pool) CommittedEvidence(hash []byte) (*CommittedEvidence, error) {
+	bz, err := evpool.evidenceStore.Get(keyCommittedHash(hash))
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	if bz == nil {
+		return nil, nil
+	}
+
+	var h gogotypes.Int64Value
+	if err := proto.Unmarshal(bz, &h); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal committed evidence height: %w", err)
+	}
+
+	evBytes, err := evpool.evidenceStore.Get(keyCommittedHeight(h.Value, hash))
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	if evBytes == nil {
+		return nil, fmt.Errorf("committed evidence %X at height %d is missing", hash, h.Value)
+	}
+
+	ev, err := bytesToEv(evBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CommittedEvidence{Evidence: ev, Height: h.Value}, nil
+}
+
+// ListCommittedEvidence returns the evidence committed in blocks from
+// minHeight to maxHeight inclusive, ordered by height.
+func (evpool *Pool) ListCommittedEvidence(minHeight, maxHeight int64) ([]*CommittedEvidence, error) {
+	start, err := orderedcode.Append(nil, prefixCommittedHeight, minHeight)
+	if err != nil {
+		return nil, err
+	}
+	end, err := orderedcode.Append(nil, prefixCommittedHeight, maxHeight+1)
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := evpool.evidenceStore.Iterator(start, end)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	defer iter.Close()
+
+	var evidence []*CommittedEvidence
+	for ; iter.Valid(); iter.Next() {
+		var (
+			prefix, height int64
+			hash           string
+		)
+		if _, err := orderedcode.Parse(string(iter.Key()), &prefix, &height, &hash); err != nil {
+			return nil, fmt.Errorf("failed to parse committed evidence key: %w", err)
+		}
+
+		ev, err := bytesToEv(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+
+		evidence = append(evidence, &CommittedEvidence{Evidence: ev, Height: height})
+	}
+
+	if err := iter.Error(); err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+
+	return evidence, nil
+}
+
 // Update takes both the new state and the evidence committed at that height and performs
 // the following operations:
-// 1. Take any conflicting votes from consensus and use the state's LastBlockTime to form
-//    DuplicateVoteEvidence and add it to the pool.
+// 1. Take any conflicting votes and proposals from consensus and use the state's LastBlockTime
+//    to form DuplicateVoteEvidence and DuplicateProposalEvidence and add it to the pool.
 // 2. Update the pool's state which contains evidence params relating to expiry.
 // 3. Moves pending evidence that has now been committed into the committed pool.
 // 4. Removes any expired evidence based on both height and time.
@@ -124,8 +212,8 @@ func (evpool *Pool) Update(state sm.State, ev types.EvidenceList) {
 		"last_block_time", state.LastBlockTime,
 	)
 
-	// flush conflicting vote pairs from the buffer, producing DuplicateVoteEvidence and
-	// adding it to the pool
+	// flush conflicting vote and proposal pairs from the buffers, producing
+	// DuplicateVoteEvidence and DuplicateProposalEvidence and adding it to the pool
 	evpool.processConsensusBuffer(state)
 	// update state
 	evpool.updateState(state)
@@ -194,6 +282,20 @@ func (evpool *Pool) ReportConflictingVotes(voteA, voteB *types.Vote) {
 	})
 }
 
+// ReportConflictingProposals takes two conflicting proposals and forms
+// duplicate proposal evidence from them once consensus at their height has
+// been reached, the same way ReportConflictingVotes does for votes.
+//
+// Proposals are not verified.
+func (evpool *Pool) ReportConflictingProposals(proposalA, proposalB *types.Proposal) {
+	evpool.mtx.Lock()
+	defer evpool.mtx.Unlock()
+	evpool.proposalBuffer = append(evpool.proposalBuffer, duplicateProposalSet{
+		ProposalA: proposalA,
+		ProposalB: proposalB,
+	})
+}
+
 // CheckEvidence takes an array of evidence from a block and verifies all the evidence there.
 // If it has already verified the evidence then it jumps to the next one. It ensures that no
 // evidence has already been committed or is being proposed twice. It also adds any
@@ -349,6 +451,12 @@ func (evpool *Pool) markEvidenceAsCommitted(evidence types.EvidenceList, height
 			evpool.logger.Error("failed to save committed evidence", "key(height/hash)", key, "err", err)
 		}
 
+		// Index the evidence by the height it was committed at and by its hash
+		// so that it can be queried without scanning the block store.
+		if err := evpool.indexCommittedEvidence(ev, height); err != nil {
+			evpool.logger.Error("failed to index committed evidence", "evidence", ev, "err", err)
+		}
+
 		evpool.logger.Debug("marked evidence as committed", "evidence", ev)
 	}
 
@@ -370,6 +478,35 @@ func (evpool *Pool) markEvidenceAsCommitted(evidence types.EvidenceList, height
 	atomic.AddUint32(&evpool.evidenceSize, ^uint32(len(blockEvidenceMap)-1))
 }
 
+func (evpool *Pool) indexCommittedEvidence(ev types.Evidence, height int64) error {
+	evpb, err := types.EvidenceToProto(ev)
+	if err != nil {
+		return fmt.Errorf("failed to convert to proto: %w", err)
+	}
+
+	evBytes, err := evpb.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal evidence: %w", err)
+	}
+
+	heightBytes, err := proto.Marshal(&gogotypes.Int64Value{Value: height})
+	if err != nil {
+		return fmt.Errorf("failed to marshal height: %w", err)
+	}
+
+	batch := evpool.evidenceStore.NewBatch()
+	defer batch.Close()
+
+	if err := batch.Set(keyCommittedHeight(height, ev.Hash()), evBytes); err != nil {
+		return err
+	}
+	if err := batch.Set(keyCommittedHash(ev.Hash()), heightBytes); err != nil {
+		return err
+	}
+
+	return batch.Write()
+}
+
 // listEvidence retrieves lists evidence from oldest to newest within maxBytes.
 // If maxBytes is -1, there's no cap on the size of returned evidence.
 func (evpool *Pool) listEvidence(prefixKey int64, maxBytes int64) ([]types.Evidence, int64, error) {
@@ -509,86 +646,96 @@ func (evpool *Pool) updateState(state sm.State) {
 	evpool.state = state
 }
 
-// processConsensusBuffer converts all the duplicate votes witnessed from consensus
-// into DuplicateVoteEvidence. It sets the evidence timestamp to the block height
-// from the most recently committed block.
+// processConsensusBuffer converts all the duplicate votes and proposals witnessed
+// from consensus into DuplicateVoteEvidence and DuplicateProposalEvidence. It sets
+// the evidence timestamp to the time of the block at the height of the infraction.
 // Evidence is then added to the pool so as to be ready to be broadcasted and proposed.
 func (evpool *Pool) processConsensusBuffer(state sm.State) {
 	evpool.mtx.Lock()
 	defer evpool.mtx.Unlock()
 	for _, voteSet := range evpool.consensusBuffer {
-
-		// Check the height of the conflicting votes and fetch the corresponding time and validator set
-		// to produce the valid evidence
-		var (
-			dve *types.DuplicateVoteEvidence
-			err error
-		)
-		switch {
-		case voteSet.VoteA.Height == state.LastBlockHeight:
-			dve, err = types.NewDuplicateVoteEvidence(
-				voteSet.VoteA,
-				voteSet.VoteB,
-				state.LastBlockTime,
-				state.LastValidators,
-			)
-
-		case voteSet.VoteA.Height < state.LastBlockHeight:
-			valSet, dbErr := evpool.stateDB.LoadValidators(voteSet.VoteA.Height)
-			if dbErr != nil {
-				evpool.logger.Error("failed to load validator set for conflicting votes",
-					"height", voteSet.VoteA.Height, "err", err)
-				continue
-			}
-			blockMeta := evpool.blockStore.LoadBlockMeta(voteSet.VoteA.Height)
-			if blockMeta == nil {
-				evpool.logger.Error("failed to load block time for conflicting votes", "height", voteSet.VoteA.Height)
-				continue
-			}
-			dve, err = types.NewDuplicateVoteEvidence(
-				voteSet.VoteA,
-				voteSet.VoteB,
-				blockMeta.Header.Time,
-				valSet,
-			)
-
-		default:
-			// evidence pool shouldn't expect to get votes from consensus of a height that is above the current
-			// state. If this error is seen then perhaps consider keeping the votes in the buffer and retry
-			// in following heights
-			evpool.logger.Error("inbound duplicate votes from consensus are of a greater height than current state",
-				"duplicate vote height", voteSet.VoteA.Height,
-				"state.LastBlockHeight", state.LastBlockHeight)
+		blockTime, valSet, err := evpool.consensusEvidenceContext(state, voteSet.VoteA.Height)
+		if err != nil {
+			evpool.logger.Error("failed to process conflicting votes", "height", voteSet.VoteA.Height, "err", err)
 			continue
 		}
+		dve, err := types.NewDuplicateVoteEvidence(voteSet.VoteA, voteSet.VoteB, blockTime, valSet)
 		if err != nil {
 			evpool.logger.Error("error in generating evidence from votes", "err", err)
 			continue
 		}
-
-		// check if we already have this evidence
-		if evpool.isPending(dve) {
-			evpool.logger.Debug("evidence already pending; ignoring", "evidence", dve)
+		evpool.addConsensusEvidence(dve)
+	}
+	for _, proposalSet := range evpool.proposalBuffer {
+		blockTime, valSet, err := evpool.consensusEvidenceContext(state, proposalSet.ProposalA.Height)
+		if err != nil {
+			evpool.logger.Error("failed to process conflicting proposals", "height", proposalSet.ProposalA.Height, "err", err)
 			continue
 		}
-
-		// check that the evidence is not already committed on chain
-		if evpool.isCommitted(dve) {
-			evpool.logger.Debug("evidence already committed; ignoring", "evidence", dve)
+		dpe, err := types.NewDuplicateProposalEvidence(proposalSet.ProposalA, proposalSet.ProposalB, blockTime, valSet)
+		if err != nil {
+			evpool.logger.Error("error in generating evidence from proposals", "err", err)
 			continue
 		}
+		evpool.addConsensusEvidence(dpe)
+	}
+	// reset consensus buffers
+	evpool.consensusBuffer = make([]duplicateVoteSet, 0)
+	evpool.proposalBuffer = make([]duplicateProposalSet, 0)
+}
 
-		if err := evpool.addPendingEvidence(dve); err != nil {
-			evpool.logger.Error("failed to flush evidence from consensus buffer to pending list: %w", err)
-			continue
+// consensusEvidenceContext returns the block time and validator set at the
+// height of conflicting messages witnessed by consensus, needed to produce
+// valid evidence from them.
+func (evpool *Pool) consensusEvidenceContext(state sm.State, height int64) (time.Time, *types.ValidatorSet, error) {
+	switch {
+	case height == state.LastBlockHeight:
+		return state.LastBlockTime, state.LastValidators, nil
+
+	case height < state.LastBlockHeight:
+		valSet, err := evpool.stateDB.LoadValidators(height)
+		if err != nil {
+			return time.Time{}, nil, fmt.Errorf("failed to load validator set: %w", err)
 		}
+		blockMeta := evpool.blockStore.LoadBlockMeta(height)
+		if blockMeta == nil {
+			return time.Time{}, nil, errors.New("failed to load block time")
+		}
+		return blockMeta.Header.Time, valSet, nil
 
-		evpool.evidenceList.PushBack(dve)
+	default:
+		// evidence pool shouldn't expect to get messages from consensus of a height that is above the current
+		// state. If this error is seen then perhaps consider keeping the messages in the buffer and retry
+		// in following heights
+		return time.Time{}, nil, fmt.Errorf(
+			"inbound conflicting messages from consensus are of a greater height than current state (%d > %d)",
+			height, state.LastBlockHeight)
+	}
+}
 
-		evpool.logger.Info("verified new evidence of byzantine behavior", "evidence", dve)
+// addConsensusEvidence adds evidence formed from consensus to the pending
+// pool unless it is already pending or committed.
+func (evpool *Pool) addConsensusEvidence(ev types.Evidence) {
+	// check if we already have this evidence
+	if evpool.isPending(ev) {
+		evpool.logger.Debug("evidence already pending; ignoring", "evidence", ev)
+		return
 	}
-	// reset consensus buffer
-	evpool.consensusBuffer = make([]duplicateVoteSet, 0)
+
+	// check that the evidence is not already committed on chain
+	if evpool.isCommitted(ev) {
+		evpool.logger.Debug("evidence already committed; ignoring", "evidence", ev)
+		return
+	}
+
+	if err := evpool.addPendingEvidence(ev); err != nil {
+		evpool.logger.Error("failed to flush evidence from consensus buffer to pending list", "err", err)
+		return
+	}
+
+	evpool.evidenceList.PushBack(ev)
+
+	evpool.logger.Info("verified new evidence of byzantine behavior", "evidence", ev)
 }
 
 type duplicateVoteSet struct {
@@ -596,6 +743,11 @@ type duplicateVoteSet struct {
 	VoteB *types.Vote
 }
 
+type duplicateProposalSet struct {
+	ProposalA *types.Proposal
+	ProposalB *types.Proposal
+}
+
 func bytesToEv(evBytes []byte) (types.Evidence, error) {
 	var evpb tmproto.Evidence
 	err := evpb.Unmarshal(evBytes)
@@ -627,6 +779,22 @@ func keyCommitted(evidence types.Evidence) []byte {
 	return key
 }
 
+func keyCommittedHeight(height int64, hash []byte) []byte {
+	key, err := orderedcode.Append(nil, prefixCommittedHeight, height, string(hash))
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+func keyCommittedHash(hash []byte) []byte {
+	key, err := orderedcode.Append(nil, prefixCommittedHash, string(hash))
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
 func keyPending(evidence types.Evidence) []byte {
 	height := evidence.Height()
 	key, err := orderedcode.Append(nil, prefixPending, height, string(evidence.Hash()))
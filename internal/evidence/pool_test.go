@@ -187,6 +187,40 @@ func TestReportConflictingVotes(t *testing.T) {
 	require.NotNil(t, next)
 }
 
+func TestReportConflictingProposals(t *testing.T) {
+	var height int64 = 10
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pool, pv := defaultTestPool(ctx, t, height)
+	val := types.NewValidator(pv.PrivKey.PubKey(), 10)
+
+	ev, err := types.NewMockDuplicateProposalEvidenceWithValidator(ctx, height+1, defaultEvidenceTime, pv, evidenceChainID)
+	require.NoError(t, err)
+
+	pool.ReportConflictingProposals(ev.ProposalA, ev.ProposalB)
+
+	// shouldn't be able to submit the same evidence twice
+	pool.ReportConflictingProposals(ev.ProposalA, ev.ProposalB)
+
+	// evidence from consensus should not be added immediately but reside in the consensus buffer
+	evList, evSize := pool.PendingEvidence(defaultEvidenceMaxBytes)
+	require.Empty(t, evList)
+	require.Zero(t, evSize)
+
+	// move to next height and update state and evidence pool
+	state := pool.State()
+	state.LastBlockHeight++
+	state.LastBlockTime = ev.Time()
+	state.LastValidators = types.NewValidatorSet([]*types.Validator{val})
+	pool.Update(state, []types.Evidence{})
+
+	// should be able to retrieve evidence from pool
+	evList, _ = pool.PendingEvidence(defaultEvidenceMaxBytes)
+	require.Equal(t, []types.Evidence{ev}, evList)
+}
+
 func TestEvidencePoolUpdate(t *testing.T) {
 	height := int64(21)
 	ctx, cancel := context.WithCancel(context.Background())
@@ -253,6 +287,26 @@ func TestEvidencePoolUpdate(t *testing.T) {
 	if assert.Error(t, err) {
 		assert.Equal(t, "evidence was already committed", err.(*types.ErrInvalidEvidence).Reason.Error())
 	}
+
+	// c) Committed evidence can be looked up by hash and by the height it was committed at
+	committed, err := pool.CommittedEvidence(ev.Hash())
+	require.NoError(t, err)
+	require.NotNil(t, committed)
+	assert.Equal(t, ev, committed.Evidence)
+	assert.Equal(t, height+1, committed.Height)
+
+	committed, err = pool.CommittedEvidence(notPrunedEv.Hash())
+	require.NoError(t, err)
+	assert.Nil(t, committed)
+
+	committedList, err := pool.ListCommittedEvidence(height+1, height+1)
+	require.NoError(t, err)
+	require.Len(t, committedList, 1)
+	assert.Equal(t, ev, committedList[0].Evidence)
+
+	committedList, err = pool.ListCommittedEvidence(1, height)
+	require.NoError(t, err)
+	assert.Empty(t, committedList)
 }
 
 func TestVerifyPendingEvidencePasses(t *testing.T) {
@@ -82,6 +82,28 @@ func (evpool *Pool) verify(evidence types.Evidence) error {
 
 		return nil
 
+	case *types.DuplicateProposalEvidence:
+		valSet, err := evpool.stateDB.LoadValidators(evidence.Height())
+		if err != nil {
+			return err
+		}
+
+		if err := VerifyDuplicateProposal(ev, state.ChainID, valSet); err != nil {
+			return types.NewErrInvalidEvidence(evidence, err)
+		}
+
+		_, val := valSet.GetByAddress(ev.ValidatorAddress)
+
+		if err := ev.ValidateABCI(val, valSet, evTime); err != nil {
+			ev.GenerateABCI(val, valSet, evTime)
+			if addErr := evpool.addPendingEvidence(ev); addErr != nil {
+				evpool.logger.Error("adding pending duplicate proposal evidence failed", "err", addErr)
+			}
+			return err
+		}
+
+		return nil
+
 	case *types.LightClientAttackEvidence:
 		commonHeader, err := getSignedHeader(evpool.blockStore, evidence.Height())
 		if err != nil {
@@ -161,7 +183,8 @@ func VerifyLightClientAttack(e *types.LightClientAttackEvidence, commonHeader, t
 	// In the case of lunatic attack there will be a different commonHeader height. Therefore the node perform a single
 	// verification jump between the common header and the conflicting one
 	if commonHeader.Height != e.ConflictingBlock.Height {
-		err := commonVals.VerifyCommitLightTrusting(trustedHeader.ChainID, e.ConflictingBlock.Commit, light.DefaultTrustLevel)
+		err := commonVals.VerifyAggregatedCommitLightTrusting(trustedHeader.ChainID, e.ConflictingBlock.ValidatorSet,
+			e.ConflictingBlock.Commit, light.DefaultTrustLevel)
 		if err != nil {
 			return fmt.Errorf("skipping verification of conflicting block failed: %w", err)
 		}
@@ -251,6 +274,52 @@ func VerifyDuplicateVote(e *types.DuplicateVoteEvidence, chainID string, valSet
 	return nil
 }
 
+// VerifyDuplicateProposal verifies DuplicateProposalEvidence against the state of full node. This involves the
+// following checks:
+//      - the validator is the proposer of the round of the proposals, given the validator set at their height
+//      - the height and round of the proposals must be the same
+//      - the block ID's must be different
+//      - The signatures must both be valid
+func VerifyDuplicateProposal(e *types.DuplicateProposalEvidence, chainID string, valSet *types.ValidatorSet) error {
+	// H/R must be the same
+	if e.ProposalA.Height != e.ProposalB.Height ||
+		e.ProposalA.Round != e.ProposalB.Round {
+		return fmt.Errorf("h/r does not match: %d/%d vs %d/%d",
+			e.ProposalA.Height, e.ProposalA.Round,
+			e.ProposalB.Height, e.ProposalB.Round)
+	}
+
+	proposer := valSet.GetProposerForRound(e.ProposalA.Round)
+	if proposer == nil {
+		return fmt.Errorf("no validators at height %d", e.Height())
+	}
+	if !bytes.Equal(proposer.Address, e.ValidatorAddress) {
+		return fmt.Errorf("address %X was not the proposer at height %d and round %d (expected %X)",
+			e.ValidatorAddress, e.Height(), e.ProposalA.Round, proposer.Address)
+	}
+	pubKey := proposer.PubKey
+
+	// BlockIDs must be different
+	if e.ProposalA.BlockID.Equals(e.ProposalB.BlockID) {
+		return fmt.Errorf(
+			"block IDs are the same (%v) - not a real duplicate proposal",
+			e.ProposalA.BlockID,
+		)
+	}
+
+	pa := e.ProposalA.ToProto()
+	pb := e.ProposalB.ToProto()
+	// Signatures must be valid
+	if !pubKey.VerifySignature(types.ProposalSignBytes(chainID, pa), e.ProposalA.Signature) {
+		return errors.New("verifying ProposalA: invalid signature")
+	}
+	if !pubKey.VerifySignature(types.ProposalSignBytes(chainID, pb), e.ProposalB.Signature) {
+		return errors.New("verifying ProposalB: invalid signature")
+	}
+
+	return nil
+}
+
 func getSignedHeader(blockStore BlockStore, height int64) (*types.SignedHeader, error) {
 	blockMeta := blockStore.LoadBlockMeta(height)
 	if blockMeta == nil {
@@ -489,6 +489,89 @@ func TestVerifyDuplicateVoteEvidence(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestVerifyDuplicateProposalEvidence(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	val := types.NewMockPV()
+	val2 := types.NewMockPV()
+	valSet := types.NewValidatorSet([]*types.Validator{val.ExtractIntoValidator(ctx, 1)})
+	pubKey, err := val.GetPubKey(ctx)
+	require.NoError(t, err)
+
+	blockID := makeBlockID([]byte("blockhash"), 1000, []byte("partshash"))
+	blockID2 := makeBlockID([]byte("blockhash2"), 1000, []byte("partshash"))
+
+	const chainID = "mychain"
+
+	proposal1 := makeProposal(ctx, t, val, chainID, 10, 2, blockID, defaultEvidenceTime)
+
+	cases := []struct {
+		name      string
+		proposal2 *types.Proposal
+		valid     bool
+	}{
+		{"different block ids", makeProposal(ctx, t, val, chainID, 10, 2, blockID2, defaultEvidenceTime), true},
+		{"same block id", makeProposal(ctx, t, val, chainID, 10, 2, blockID, defaultEvidenceTime), false},
+		{"wrong chain id", makeProposal(ctx, t, val, "mychain2", 10, 2, blockID2, defaultEvidenceTime), false},
+		{"wrong height", makeProposal(ctx, t, val, chainID, 11, 2, blockID2, defaultEvidenceTime), false},
+		{"wrong round", makeProposal(ctx, t, val, chainID, 10, 3, blockID2, defaultEvidenceTime), false},
+		{"wrong validator", makeProposal(ctx, t, val2, chainID, 10, 2, blockID2, defaultEvidenceTime), false},
+	}
+	for _, c := range cases {
+		ev := &types.DuplicateProposalEvidence{
+			ProposalA:        proposal1,
+			ProposalB:        c.proposal2,
+			ValidatorAddress: pubKey.Address(),
+			ValidatorPower:   1,
+			TotalVotingPower: 1,
+			Timestamp:        defaultEvidenceTime,
+		}
+		if c.valid {
+			assert.NoError(t, evidence.VerifyDuplicateProposal(ev, chainID, valSet), c.name)
+		} else {
+			assert.Error(t, evidence.VerifyDuplicateProposal(ev, chainID, valSet), c.name)
+		}
+	}
+
+	// the evidence must name the proposer of the round
+	pubKey2, err := val2.GetPubKey(ctx)
+	require.NoError(t, err)
+	ev := &types.DuplicateProposalEvidence{
+		ProposalA:        proposal1,
+		ProposalB:        makeProposal(ctx, t, val, chainID, 10, 2, blockID2, defaultEvidenceTime),
+		ValidatorAddress: pubKey2.Address(),
+	}
+	assert.Error(t, evidence.VerifyDuplicateProposal(ev, chainID, valSet))
+
+	// create good evidence and correct validator power
+	goodEv, err := types.NewMockDuplicateProposalEvidenceWithValidator(ctx, 10, defaultEvidenceTime, val, chainID)
+	require.NoError(t, err)
+	goodEv.ValidatorPower = 1
+	goodEv.TotalVotingPower = 1
+	badEv, err := types.NewMockDuplicateProposalEvidenceWithValidator(ctx, 10, defaultEvidenceTime, val, chainID)
+	require.NoError(t, err)
+	state := sm.State{
+		ChainID:         chainID,
+		LastBlockTime:   defaultEvidenceTime.Add(1 * time.Minute),
+		LastBlockHeight: 11,
+		ConsensusParams: *types.DefaultConsensusParams(),
+	}
+	stateStore := &smmocks.Store{}
+	stateStore.On("LoadValidators", int64(10)).Return(valSet, nil)
+	stateStore.On("Load").Return(state, nil)
+	blockStore := &mocks.BlockStore{}
+	blockStore.On("LoadBlockMeta", int64(10)).Return(&types.BlockMeta{Header: types.Header{Time: defaultEvidenceTime}})
+
+	pool, err := evidence.NewPool(log.TestingLogger(), dbm.NewMemDB(), stateStore, blockStore)
+	require.NoError(t, err)
+
+	assert.NoError(t, pool.CheckEvidence(types.EvidenceList{goodEv}))
+
+	// evidence with a different validator power should fail
+	assert.Error(t, pool.CheckEvidence(types.EvidenceList{badEv}))
+}
+
 func makeLunaticEvidence(
 	ctx context.Context,
 	t *testing.T,
@@ -599,6 +682,18 @@ func makeVote(
 	return v
 }
 
+func makeProposal(
+	ctx context.Context,
+	t *testing.T, val types.PrivValidator, chainID string, height int64,
+	round int32, blockID types.BlockID, time time.Time,
+) *types.Proposal {
+	p := types.NewProposal(height, round, -1, blockID, time)
+	ppb := p.ToProto()
+	require.NoError(t, val.SignProposal(ctx, chainID, ppb))
+	p.Signature = ppb.Signature
+	return p
+}
+
 func makeBlockID(hash []byte, partSetSize uint32, partSetHash []byte) types.BlockID {
 	var (
 		h   = make([]byte, tmhash.Size)
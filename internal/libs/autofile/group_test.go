@@ -301,3 +301,33 @@ func TestMaxIndex(t *testing.T) {
 	// Cleanup
 	destroyTestGroup(t, g)
 }
+
+func TestRemoveFilesBefore(t *testing.T) {
+	logger := log.TestingLogger()
+	g := createTestGroupWithHeadSizeLimit(t, logger, 0)
+
+	for i := 0; i < 4; i++ {
+		err := g.WriteLine("Line")
+		require.NoError(t, err)
+		err = g.FlushAndSync()
+		require.NoError(t, err)
+		g.RotateFile()
+	}
+	require.Equal(t, 4, g.MaxIndex())
+
+	require.NoError(t, g.RemoveFilesBefore(2))
+	assert.Equal(t, 2, g.MinIndex())
+	assertGroupInfo(t, g.ReadGroupInfo(), 2, 4, 10, 0)
+	_, err := os.Stat(g.FilePath(1))
+	assert.True(t, os.IsNotExist(err))
+
+	// the head is kept
+	require.NoError(t, g.RemoveFilesBefore(10))
+	assert.Equal(t, 4, g.MinIndex())
+	_, err = os.Stat(g.FilePath(3))
+	assert.True(t, os.IsNotExist(err))
+	assert.Equal(t, g.Head.Path, g.FilePath(4))
+
+	// Cleanup
+	destroyTestGroup(t, g)
+}
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/types"
 )
 
 func TestCacheRemove(t *testing.T) {
@@ -33,3 +35,22 @@ func TestCacheRemove(t *testing.T) {
 		require.Equal(t, numTxs-(i+1), cache.list.Len())
 	}
 }
+
+func TestCacheHas(t *testing.T) {
+	cache := NewLRUTxCache(1)
+
+	tx1 := types.Tx("tx1")
+	tx2 := types.Tx("tx2")
+
+	require.False(t, cache.Has(tx1.Key()))
+	cache.Push(tx1)
+	require.True(t, cache.Has(tx1.Key()))
+
+	// pushing a new tx evicts the least recently used one
+	cache.Push(tx2)
+	require.False(t, cache.Has(tx1.Key()))
+	require.True(t, cache.Has(tx2.Key()))
+
+	cache.Remove(tx2)
+	require.False(t, cache.Has(tx2.Key()))
+}
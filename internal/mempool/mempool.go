@@ -5,13 +5,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	dbm "github.com/tendermint/tm-db"
+
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/internal/eventbus"
 	"github.com/tendermint/tendermint/internal/libs/clist"
 	"github.com/tendermint/tendermint/internal/proxy"
 	"github.com/tendermint/tendermint/libs/log"
@@ -28,12 +32,23 @@ type TxMempoolOption func(*TxMempool)
 // reactor. It keeps a thread-safe priority queue of transactions that is used
 // when a block proposer constructs a block and a thread-safe linked-list that
 // is used to gossip transactions to peers in a FIFO manner.
+//
+// Transactions that share a sender, as defined by the ABCI application, form a
+// lane that is reaped in ascending nonce order. Transactions that follow a
+// missing nonce are held back until the gap is filled. A pending transaction can
+// be replaced by a transaction with the same sender and nonce and a high enough
+// priority.
 type TxMempool struct {
 	logger       log.Logger
 	metrics      *Metrics
+	eventBus     types.MempoolEventPublisher
 	config       *config.MempoolConfig
 	proxyAppConn proxy.AppConnMempool
 
+	// db defines the database the mempool's transactions are persisted to, if
+	// any. See SaveTxs and LoadTxs.
+	db dbm.DB
+
 	// txsAvailable fires once for each height when the mempool is not empty
 	txsAvailable         chan struct{}
 	notifiedTxsAvailable bool
@@ -106,6 +121,7 @@ func NewTxMempool(
 		height:        height,
 		cache:         NopTxCache{},
 		metrics:       NopMetrics(),
+		eventBus:      eventbus.NopEventBus{},
 		txStore:       NewTxStore(),
 		gossipIndex:   clist.New(),
 		priorityIndex: NewTxPriorityQueue(),
@@ -149,6 +165,17 @@ func WithMetrics(metrics *Metrics) TxMempoolOption {
 	return func(txmp *TxMempool) { txmp.metrics = metrics }
 }
 
+// WithEventBus sets the event bus the mempool reports evicted and replaced
+// transactions to.
+func WithEventBus(eventBus types.MempoolEventPublisher) TxMempoolOption {
+	return func(txmp *TxMempool) { txmp.eventBus = eventBus }
+}
+
+// WithDB sets the database the mempool persists its transactions to.
+func WithDB(db dbm.DB) TxMempoolOption {
+	return func(txmp *TxMempool) { txmp.db = db }
+}
+
 // Lock obtains a write-lock on the mempool. A caller must be sure to explicitly
 // release the lock when finished.
 func (txmp *TxMempool) Lock() {
@@ -232,19 +259,36 @@ func (txmp *TxMempool) CheckTx(
 	tx types.Tx,
 	cb func(*abci.Response),
 	txInfo TxInfo,
+) error {
+	return txmp.checkTx(ctx, tx, cb, txInfo, 0, time.Time{})
+}
+
+// checkTx implements CheckTx. If timestamp is non-zero, the transaction is
+// restored with the given height and timestamp instead of the current ones, so
+// that it keeps its TTL.
+func (txmp *TxMempool) checkTx(
+	ctx context.Context,
+	tx types.Tx,
+	cb func(*abci.Response),
+	txInfo TxInfo,
+	height int64,
+	timestamp time.Time,
 ) error {
 	txmp.mtx.RLock()
 	defer txmp.mtx.RUnlock()
 
 	if txSize := len(tx); txSize > txmp.config.MaxTxBytes {
-		return types.ErrTxTooLarge{
+		err := types.ErrTxTooLarge{
 			Max:    txmp.config.MaxTxBytes,
 			Actual: txSize,
 		}
+		txmp.publishRejectedTx(tx, 0, err.Error())
+		return err
 	}
 
 	if txmp.preCheck != nil {
 		if err := txmp.preCheck(tx); err != nil {
+			txmp.publishRejectedTx(tx, 0, err.Error())
 			return types.ErrPreCheck{Reason: err}
 		}
 	}
@@ -287,6 +331,10 @@ func (txmp *TxMempool) CheckTx(
 			timestamp: time.Now().UTC(),
 			height:    txmp.height,
 		}
+		if !timestamp.IsZero() {
+			wtx.timestamp = timestamp
+			wtx.height = height
+		}
 		txmp.initTxCallback(wtx, res, txInfo)
 
 		if cb != nil {
@@ -310,15 +358,57 @@ func (txmp *TxMempool) RemoveTxByKey(txKey types.TxKey) error {
 	return errors.New("transaction not found")
 }
 
-// Flush empties the mempool. It acquires a read-lock, fetches all the
+// GetTxByKey returns the transaction with the given key, if it is in the
+// mempool. It is thread safe.
+func (txmp *TxMempool) GetTxByKey(txKey types.TxKey) (types.Tx, bool) {
+	if wtx := txmp.txStore.GetTxByHash(txKey); wtx != nil {
+		return wtx.tx, true
+	}
+
+	return nil, false
+}
+
+// ListTxs returns the transactions that match the filter, in the order in which
+// they would be reaped. Gapped transactions are listed last, as they cannot be
+// reaped. It is thread safe.
+func (txmp *TxMempool) ListTxs(filter TxFilter) []PendingTx {
+	txmp.mtx.RLock()
+	defer txmp.mtx.RUnlock()
+
+	now := time.Now()
+
+	var txs []PendingTx
+	for _, wtx := range txmp.priorityIndex.GetTxs() {
+		if !filter.matches(wtx, now) {
+			continue
+		}
+
+		txs = append(txs, PendingTx{
+			Tx:        wtx.tx,
+			Priority:  wtx.priority,
+			Sender:    wtx.sender,
+			Nonce:     wtx.nonce,
+			GasWanted: wtx.gasWanted,
+			Height:    wtx.height,
+			Timestamp: wtx.timestamp,
+			Gapped:    wtx.gapped,
+		})
+	}
+
+	return txs
+}
+
+// Flush empties the mempool. It acquires a write-lock, fetches all the
 // transactions currently in the transaction store and removes each transaction
-// from the store and all indexes and finally resets the cache.
+// from the store and all indexes and finally resets the cache. The write-lock
+// keeps the CheckTx callbacks, which may replace or evict transactions, from
+// removing a transaction concurrently.
 //
 // NOTE:
 // - Flushing the mempool may leave the mempool in an inconsistent state.
 func (txmp *TxMempool) Flush() {
-	txmp.mtx.RLock()
-	defer txmp.mtx.RUnlock()
+	txmp.mtx.Lock()
+	defer txmp.mtx.Unlock()
 
 	txmp.heightIndex.Reset()
 	txmp.timestampIndex.Reset()
@@ -332,7 +422,8 @@ func (txmp *TxMempool) Flush() {
 }
 
 // ReapMaxBytesMaxGas returns a list of transactions within the provided size
-// and gas constraints. Transaction are retrieved in priority order.
+// and gas constraints. Transaction are retrieved in priority order, and in
+// nonce order within a sender's lane.
 //
 // NOTE:
 // - Transactions returned are not removed from the mempool transaction
@@ -358,8 +449,13 @@ func (txmp *TxMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 	txs := make([]types.Tx, 0, txmp.priorityIndex.NumTxs())
 	for txmp.priorityIndex.NumTxs() > 0 {
 		wtx := txmp.priorityIndex.PopTx()
-		txs = append(txs, wtx.tx)
 		wTxs = append(wTxs, wtx)
+		if wtx.gapped {
+			// gapped transactions sort last, so there is nothing left to reap
+			break
+		}
+
+		txs = append(txs, wtx.tx)
 		size := types.ComputeProtoSizeForTxs([]types.Tx{wtx.tx})
 
 		// Ensure we have capacity for the transaction with respect to the
@@ -383,7 +479,8 @@ func (txmp *TxMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 }
 
 // ReapMaxTxs returns a list of transactions within the provided number of
-// transactions bound. Transaction are retrieved in priority order.
+// transactions bound. Transaction are retrieved in priority order, and in nonce
+// order within a sender's lane.
 //
 // NOTE:
 // - Transactions returned are not removed from the mempool transaction
@@ -405,8 +502,13 @@ func (txmp *TxMempool) ReapMaxTxs(max int) types.Txs {
 	txs := make([]types.Tx, 0, cap)
 	for txmp.priorityIndex.NumTxs() > 0 && len(txs) < max {
 		wtx := txmp.priorityIndex.PopTx()
-		txs = append(txs, wtx.tx)
 		wTxs = append(wTxs, wtx)
+		if wtx.gapped {
+			// gapped transactions sort last, so there is nothing left to reap
+			break
+		}
+
+		txs = append(txs, wtx.tx)
 	}
 	for _, wtx := range wTxs {
 		txmp.priorityIndex.PushTx(wtx)
@@ -453,7 +555,23 @@ func (txmp *TxMempool) Update(
 
 		// remove the committed transaction from the transaction store and indexes
 		if wtx := txmp.txStore.GetTxByHash(tx.Key()); wtx != nil {
+			if len(wtx.sender) > 0 {
+				txmp.commitSenderNonce(wtx.sender, wtx.nonce, wtx)
+			}
+
 			txmp.removeTx(wtx, false)
+
+			err := txmp.eventBus.PublishEventCommittedTx(context.Background(), types.EventDataCommittedTx{
+				Tx:       wtx.tx,
+				Height:   blockHeight,
+				Priority: wtx.priority,
+				Sender:   wtx.sender,
+			})
+			if err != nil {
+				txmp.logger.Error("failed to publish committed tx event", "err", err)
+			}
+		} else if len(deliverTxResponses[i].Sender) > 0 {
+			txmp.commitUnknownTx(deliverTxResponses[i])
 		}
 	}
 
@@ -490,6 +608,12 @@ func (txmp *TxMempool) Update(
 // reports an error, the transaction is rejected. Otherwise, we attempt to insert
 // the transaction into the mempool.
 //
+// If the sender of a transaction already has a transaction with the same nonce
+// in the mempool, the new transaction replaces the existing one if its priority
+// is higher by at least ReplacePriorityBump. Otherwise, it is rejected. A
+// replacement takes the place of the existing transaction, so it never evicts
+// other transactions.
+//
 // When inserting a transaction, we first check if there is sufficient capacity.
 // If there is, the transaction is added to the txStore and all indexes.
 // Otherwise, if the mempool is full, we attempt to find a lower priority transaction
@@ -525,30 +649,74 @@ func (txmp *TxMempool) initTxCallback(wtx *WrappedTx, res *abci.Response, txInfo
 		if !txmp.config.KeepInvalidTxsInCache {
 			txmp.cache.Remove(wtx.tx)
 		}
+		reason := checkTxRes.CheckTx.Log
 		if err != nil {
 			checkTxRes.CheckTx.MempoolError = err.Error()
+			reason = err.Error()
 		}
+		txmp.publishRejectedTx(wtx.tx, checkTxRes.CheckTx.Code, reason)
 		return
 	}
 
-	sender := checkTxRes.CheckTx.Sender
-	priority := checkTxRes.CheckTx.Priority
+	wtx.gasWanted = checkTxRes.CheckTx.GasWanted
+	wtx.priority = checkTxRes.CheckTx.Priority
+	wtx.sender = checkTxRes.CheckTx.Sender
+	wtx.nonce = checkTxRes.CheckTx.Nonce
+
+	// Determine where the transaction would sit in its sender's lane, as its
+	// lane priority is what it must beat to evict other transactions.
+	var (
+		prev     *WrappedTx
+		replaced *WrappedTx
+	)
+	if len(wtx.sender) > 0 {
+		if existing := txmp.txStore.GetTxBySenderNonce(wtx.sender, wtx.nonce); existing != nil {
+			if !canReplace(existing.priority, wtx.priority, txmp.config.ReplacePriorityBump) {
+				txmp.logger.Error(
+					"rejected incoming good transaction; tx already exists for sender and nonce",
+					"tx", fmt.Sprintf("%X", existing.tx.Hash()),
+					"sender", wtx.sender,
+					"nonce", wtx.nonce,
+					"priority", existing.priority,
+					"new_priority", wtx.priority,
+				)
+				txmp.metrics.RejectedTxs.Add(1)
+				txmp.publishRejectedTx(wtx.tx, 0, "tx already exists for sender and nonce")
+				return
+			}
 
-	if len(sender) > 0 {
-		if wtx := txmp.txStore.GetTxBySender(sender); wtx != nil {
+			replaced = existing
+		}
+
+		for _, laneTx := range txmp.txStore.GetTxsBySender(wtx.sender) {
+			if laneTx.nonce >= wtx.nonce {
+				break
+			}
+			prev = laneTx
+		}
+	}
+
+	headNonce := wtx.nonce
+	if prev != nil || replaced != nil {
+		headNonce = txmp.txStore.GetSenderNonce(wtx.sender)
+	}
+	setLanePosition(wtx, prev, headNonce)
+
+	if replaced != nil {
+		if err := txmp.canReplaceTx(wtx, replaced); err != nil {
+			txmp.cache.Remove(wtx.tx)
 			txmp.logger.Error(
-				"rejected incoming good transaction; tx already exists for sender",
+				"rejected incoming good transaction; mempool full",
 				"tx", fmt.Sprintf("%X", wtx.tx.Hash()),
-				"sender", sender,
+				"err", err.Error(),
 			)
 			txmp.metrics.RejectedTxs.Add(1)
+			txmp.publishRejectedTx(wtx.tx, 0, err.Error())
 			return
 		}
-	}
-
-	if err := txmp.canAddTx(wtx); err != nil {
+	} else if err := txmp.canAddTx(wtx); err != nil {
 		evictTxs := txmp.priorityIndex.GetEvictableTxs(
-			priority,
+			wtx.lanePriority,
 			int64(wtx.Size()),
 			txmp.SizeBytes(),
 			txmp.config.MaxTxsBytes,
@@ -563,6 +731,7 @@ func (txmp *TxMempool) initTxCallback(wtx *WrappedTx, res *abci.Response, txInfo
 				"err", err.Error(),
 			)
 			txmp.metrics.RejectedTxs.Add(1)
+			txmp.publishRejectedTx(wtx.tx, 0, err.Error())
 			return
 		}
 
@@ -581,27 +750,53 @@ func (txmp *TxMempool) initTxCallback(wtx *WrappedTx, res *abci.Response, txInfo
 				"new_priority", wtx.priority,
 			)
 			txmp.metrics.EvictedTxs.Add(1)
+
+			err := txmp.eventBus.PublishEventEvictedTx(context.Background(), types.EventDataEvictedTx{
+				Tx:       toEvict.tx,
+				Priority: toEvict.priority,
+				Sender:   toEvict.sender,
+				Reason:   types.EvictReasonSize,
+				NewTx:    wtx.tx,
+			})
+			if err != nil {
+				txmp.logger.Error("failed to publish evicted tx event", "err", err)
+			}
 		}
 	}
 
-	wtx.gasWanted = checkTxRes.CheckTx.GasWanted
-	wtx.priority = priority
-	wtx.sender = sender
 	wtx.peers = map[uint16]struct{}{
 		txInfo.SenderID: {},
 	}
 
 	txmp.metrics.TxSizeBytes.Observe(float64(wtx.Size()))
-	txmp.metrics.Size.Set(float64(txmp.Size()))
 
-	txmp.insertTx(wtx)
+	if replaced != nil {
+		txmp.replaceTx(replaced, wtx)
+	} else {
+		txmp.insertTx(wtx)
+	}
+
+	txmp.metrics.Size.Set(float64(txmp.Size()))
 	txmp.logger.Debug(
 		"inserted good transaction",
 		"priority", wtx.priority,
 		"tx", fmt.Sprintf("%X", wtx.tx.Hash()),
+		"nonce", wtx.nonce,
+		"gapped", wtx.gapped,
 		"height", txmp.height,
 		"num_txs", txmp.Size(),
 	)
+
+	err = txmp.eventBus.PublishEventAdmittedTx(context.Background(), types.EventDataAdmittedTx{
+		Tx:       wtx.tx,
+		Priority: wtx.priority,
+		Sender:   wtx.sender,
+		Nonce:    wtx.nonce,
+	})
+	if err != nil {
+		txmp.logger.Error("failed to publish admitted tx event", "err", err)
+	}
+
 	txmp.notifyTxsAvailable()
 }
 
@@ -668,6 +863,7 @@ func (txmp *TxMempool) defaultTxCallback(req *abci.Request, res *abci.Response)
 
 		if checkTxRes.CheckTx.Code == abci.CodeTypeOK && err == nil {
 			wtx.priority = checkTxRes.CheckTx.Priority
+			txmp.updateLane(wtx)
 		} else {
 			txmp.logger.Debug(
 				"existing transaction no longer valid; failed re-CheckTx callback",
@@ -682,6 +878,12 @@ func (txmp *TxMempool) defaultTxCallback(req *abci.Request, res *abci.Response)
 			}
 
 			txmp.removeTx(wtx, !txmp.config.KeepInvalidTxsInCache)
+
+			reason := checkTxRes.CheckTx.Log
+			if err != nil {
+				reason = err.Error()
+			}
+			txmp.publishRejectedTx(wtx.tx, checkTxRes.CheckTx.Code, reason)
 		}
 	}
 
@@ -761,6 +963,28 @@ func (txmp *TxMempool) canAddTx(wtx *WrappedTx) error {
 	return nil
 }
 
+// canReplaceTx returns an error if the mempool does not have the capacity to
+// hold wtx in place of the replaced transaction, i.e. if the swap would leave
+// it over its transaction count or byte limit. A replacement never evicts
+// other transactions to make room.
+func (txmp *TxMempool) canReplaceTx(wtx, replaced *WrappedTx) error {
+	var (
+		numTxs    = txmp.Size()
+		sizeBytes = txmp.SizeBytes()
+	)
+
+	if numTxs > txmp.config.Size || int64(wtx.Size()-replaced.Size())+sizeBytes > txmp.config.MaxTxsBytes {
+		return types.ErrMempoolIsFull{
+			NumTxs:      numTxs,
+			MaxTxs:      txmp.config.Size,
+			TxsBytes:    sizeBytes,
+			MaxTxsBytes: txmp.config.MaxTxsBytes,
+		}
+	}
+
+	return nil
+}
+
 func (txmp *TxMempool) insertTx(wtx *WrappedTx) {
 	txmp.txStore.SetTx(wtx)
 	txmp.priorityIndex.PushTx(wtx)
@@ -774,6 +998,10 @@ func (txmp *TxMempool) insertTx(wtx *WrappedTx) {
 	wtx.gossipEl = gossipEl
 
 	atomic.AddInt64(&txmp.sizeBytes, int64(wtx.Size()))
+
+	// Inserting a transaction may fill a gap or lower the lane priority of the
+	// transactions following it.
+	txmp.updateLane(wtx)
 }
 
 func (txmp *TxMempool) removeTx(wtx *WrappedTx, removeFromCache bool) {
@@ -796,6 +1024,152 @@ func (txmp *TxMempool) removeTx(wtx *WrappedTx, removeFromCache bool) {
 	if removeFromCache {
 		txmp.cache.Remove(wtx.tx)
 	}
+
+	// Removing a transaction either promotes its successors, if it was at the
+	// head of its lane, or leaves them gapped.
+	if len(wtx.sender) > 0 {
+		txmp.updateLane(wtx)
+	}
+}
+
+// replaceTx replaces a pending transaction with wtx, a transaction with the
+// same sender and nonce. The new transaction is inserted before the replaced
+// one is removed, so the sender's lane is never left with a gap.
+//
+// The replaced transaction is kept in the cache, so that it is not admitted
+// again when it is gossiped back to us.
+func (txmp *TxMempool) replaceTx(replaced, wtx *WrappedTx) {
+	txmp.insertTx(wtx)
+	txmp.removeTx(replaced, false)
+
+	txmp.logger.Debug(
+		"replaced existing good transaction",
+		"old_tx", fmt.Sprintf("%X", replaced.tx.Hash()),
+		"old_priority", replaced.priority,
+		"new_tx", fmt.Sprintf("%X", wtx.tx.Hash()),
+		"new_priority", wtx.priority,
+		"sender", wtx.sender,
+		"nonce", wtx.nonce,
+	)
+	txmp.metrics.ReplacedTxs.Add(1)
+
+	err := txmp.eventBus.PublishEventReplacedTx(context.Background(), types.EventDataReplacedTx{
+		Sender:      wtx.sender,
+		Nonce:       wtx.nonce,
+		OldTx:       replaced.tx,
+		OldPriority: replaced.priority,
+		NewTx:       wtx.tx,
+		NewPriority: wtx.priority,
+	})
+	if err != nil {
+		txmp.logger.Error("failed to publish replaced tx event", "err", err)
+	}
+}
+
+// updateLane recomputes the lane priority and timestamp of every transaction
+// sharing a sender with wtx and fixes their position in the priority index. A
+// transaction without a sender is a lane of its own.
+func (txmp *TxMempool) updateLane(wtx *WrappedTx) {
+	if len(wtx.sender) == 0 {
+		txmp.priorityIndex.SetLanePosition(wtx, nil, wtx.nonce)
+		return
+	}
+
+	var (
+		prev      *WrappedTx
+		headNonce = txmp.txStore.GetSenderNonce(wtx.sender)
+	)
+	for _, laneTx := range txmp.txStore.GetTxsBySender(wtx.sender) {
+		txmp.priorityIndex.SetLanePosition(laneTx, prev, headNonce)
+		prev = laneTx
+	}
+
+	// a gap holds back every transaction after it, including the last one
+	txmp.txStore.SetSenderGapped(wtx.sender, prev != nil && prev.gapped)
+}
+
+// commitSenderNonce advances the lane of sender past nonce, the nonce of a
+// committed transaction, and removes the transactions of the lane that can no
+// longer be executed. committed is the committed transaction if it is in the
+// mempool, and is left for the caller to remove.
+//
+// NOTE: The caller must have a write-lock.
+func (txmp *TxMempool) commitSenderNonce(sender string, nonce uint64, committed *WrappedTx) {
+	txmp.txStore.SetSenderNonce(sender, nonce+1)
+
+	for _, laneTx := range txmp.txStore.GetTxsBySender(sender) {
+		if laneTx.nonce > nonce {
+			break
+		}
+
+		if laneTx != committed {
+			txmp.removeTx(laneTx, !txmp.config.KeepInvalidTxsInCache)
+			txmp.publishEvictedTx(laneTx, types.EvictReasonNonce)
+		}
+	}
+
+	// the new head of the lane may have been held back by a gap
+	if head := txmp.txStore.GetTxBySender(sender); head != nil {
+		txmp.updateLane(head)
+	}
+}
+
+// commitUnknownTx advances the lane of the sender of a committed transaction
+// that was not in the mempool past its nonce, both as reported in its
+// ResponseDeliverTx. Only lanes held back by a gap are advanced here; the
+// transactions of other lanes are left to be rechecked.
+//
+// NOTE: The caller must have a write-lock.
+func (txmp *TxMempool) commitUnknownTx(res *abci.ResponseDeliverTx) {
+	if !txmp.txStore.IsSenderGapped(res.Sender) ||
+		res.Nonce < txmp.txStore.GetSenderNonce(res.Sender) {
+		return
+	}
+
+	txmp.commitSenderNonce(res.Sender, res.Nonce, nil)
+}
+
+// setLanePosition sets the lane priority and timestamp of wtx given prev, the
+// transaction preceding it in its sender's lane, or nil if wtx is at the head
+// of its lane, in which case it must carry headNonce to be executable.
+func setLanePosition(wtx, prev *WrappedTx, headNonce uint64) {
+	if prev == nil {
+		wtx.gapped = wtx.nonce != headNonce
+		wtx.lanePriority = wtx.priority
+		if wtx.gapped {
+			wtx.lanePriority = math.MinInt64
+		}
+		wtx.laneTimestamp = wtx.timestamp
+		return
+	}
+
+	if prev.gapped || wtx.nonce != prev.nonce+1 {
+		// Sink held back transactions to the bottom of the priority index, which
+		// also makes them the first to be evicted.
+		wtx.gapped = true
+		wtx.lanePriority = math.MinInt64
+	} else {
+		wtx.gapped = false
+		wtx.lanePriority = tmmath.MinInt64(wtx.priority, prev.lanePriority)
+	}
+
+	wtx.laneTimestamp = wtx.timestamp
+	if prev.laneTimestamp.After(wtx.laneTimestamp) {
+		wtx.laneTimestamp = prev.laneTimestamp
+	}
+}
+
+// canReplace returns true if a transaction with priority newPriority may
+// replace a transaction with priority oldPriority, i.e. if it is higher by at
+// least bump.
+func canReplace(oldPriority, newPriority, bump int64) bool {
+	if newPriority <= oldPriority {
+		return false
+	}
+
+	// The difference of two int64s may not fit in an int64, but always fits in
+	// a uint64 when it is positive.
+	return uint64(newPriority)-uint64(oldPriority) >= uint64(bump)
 }
 
 // purgeExpiredTxs removes all transactions that have exceeded their respective
@@ -845,6 +1219,33 @@ func (txmp *TxMempool) purgeExpiredTxs(blockHeight int64) {
 
 	for _, wtx := range expiredTxs {
 		txmp.removeTx(wtx, false)
+		txmp.publishEvictedTx(wtx, types.EvictReasonTTL)
+	}
+}
+
+// publishRejectedTx publishes a RejectedTx event for tx, logging any error.
+func (txmp *TxMempool) publishRejectedTx(tx types.Tx, code uint32, reason string) {
+	err := txmp.eventBus.PublishEventRejectedTx(context.Background(), types.EventDataRejectedTx{
+		Tx:     tx,
+		Code:   code,
+		Reason: reason,
+	})
+	if err != nil {
+		txmp.logger.Error("failed to publish rejected tx event", "err", err)
+	}
+}
+
+// publishEvictedTx publishes an EvictedTx event for a pending transaction that
+// was removed for the given reason, logging any error.
+func (txmp *TxMempool) publishEvictedTx(wtx *WrappedTx, reason string) {
+	err := txmp.eventBus.PublishEventEvictedTx(context.Background(), types.EventDataEvictedTx{
+		Tx:       wtx.tx,
+		Priority: wtx.priority,
+		Sender:   wtx.sender,
+		Reason:   reason,
+	})
+	if err != nil {
+		txmp.logger.Error("failed to publish evicted tx event", "err", err)
 	}
 }
 
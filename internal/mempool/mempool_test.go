@@ -21,6 +21,9 @@ import (
 	"github.com/tendermint/tendermint/abci/example/kvstore"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/internal/eventbus"
+	tmpubsub "github.com/tendermint/tendermint/internal/pubsub"
+	tmquery "github.com/tendermint/tendermint/internal/pubsub/query"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/types"
 )
@@ -40,11 +43,13 @@ func (app *application) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 	var (
 		priority int64
 		sender   string
+		nonce    uint64
 	)
 
-	// infer the priority from the raw transaction value (sender=key=value)
+	// infer the priority from the raw transaction value (sender=key=value) and
+	// the nonce from an optional fourth part (sender=key=value=nonce)
 	parts := bytes.Split(req.Tx, []byte("="))
-	if len(parts) == 3 {
+	if len(parts) == 3 || len(parts) == 4 {
 		v, err := strconv.ParseInt(string(parts[2]), 10, 64)
 		if err != nil {
 			return abci.ResponseCheckTx{
@@ -54,6 +59,17 @@ func (app *application) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 			}
 		}
 
+		if len(parts) == 4 {
+			nonce, err = strconv.ParseUint(string(parts[3]), 10, 64)
+			if err != nil {
+				return abci.ResponseCheckTx{
+					Priority:  priority,
+					Code:      100,
+					GasWanted: 1,
+				}
+			}
+		}
+
 		priority = v
 		sender = string(parts[0])
 	} else {
@@ -67,6 +83,7 @@ func (app *application) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 	return abci.ResponseCheckTx{
 		Priority:  priority,
 		Sender:    sender,
+		Nonce:     nonce,
 		Code:      code.CodeTypeOK,
 		GasWanted: 1,
 	}
@@ -413,6 +430,337 @@ func TestTxMempool_CheckTxSameSender(t *testing.T) {
 	require.Equal(t, 1, txmp.Size())
 }
 
+func TestTxMempool_SenderLanes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	txmp := setup(ctx, t, 100)
+	peerID := uint16(1)
+
+	// sender-a's lane is held back by its lowest priority transaction, while
+	// sender-c's second transaction cannot overtake its first one.
+	txs := []types.Tx{
+		[]byte("sender-a=k2=50=2"),
+		[]byte("sender-a=k0=10=0"),
+		[]byte("sender-b=k0=20=0"),
+		[]byte("sender-a=k1=100=1"),
+		[]byte("sender-c=k1=5=1"),
+		[]byte("sender-c=k0=30=0"),
+	}
+	for _, tx := range txs {
+		require.NoError(t, txmp.CheckTx(ctx, tx, nil, TxInfo{SenderID: peerID}))
+	}
+	require.Equal(t, len(txs), txmp.Size())
+
+	expected := types.Txs{
+		[]byte("sender-c=k0=30=0"),
+		[]byte("sender-b=k0=20=0"),
+		[]byte("sender-a=k0=10=0"),
+		[]byte("sender-a=k1=100=1"),
+		[]byte("sender-a=k2=50=2"),
+		[]byte("sender-c=k1=5=1"),
+	}
+	require.Equal(t, expected, txmp.ReapMaxTxs(-1))
+	require.Equal(t, expected[:2], txmp.ReapMaxTxs(2))
+	require.Equal(t, expected, txmp.ReapMaxBytesMaxGas(-1, -1))
+	require.Equal(t, expected[:3], txmp.ReapMaxBytesMaxGas(-1, 3))
+
+	// a second transaction with the same sender and nonce is rejected unless it
+	// has a higher priority
+	require.NoError(t, txmp.CheckTx(ctx, []byte("sender-a=k3=100=1"), nil, TxInfo{SenderID: peerID}))
+	require.Equal(t, len(txs), txmp.Size())
+	require.Equal(t, expected, txmp.ReapMaxTxs(-1))
+}
+
+func TestTxMempool_SenderLaneGap(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	txmp := setup(ctx, t, 100)
+	peerID := uint16(1)
+
+	tx0 := types.Tx("sender-a=k0=10=0")
+	tx1 := types.Tx("sender-a=k1=10=1")
+	tx2 := types.Tx("sender-a=k2=10=2")
+
+	// nonce 2 is held back until nonce 1 fills the gap
+	require.NoError(t, txmp.CheckTx(ctx, tx0, nil, TxInfo{SenderID: peerID}))
+	require.NoError(t, txmp.CheckTx(ctx, tx2, nil, TxInfo{SenderID: peerID}))
+	require.Equal(t, 2, txmp.Size())
+	require.Equal(t, types.Txs{tx0}, txmp.ReapMaxTxs(-1))
+	require.Equal(t, types.Txs{tx0}, txmp.ReapMaxBytesMaxGas(-1, -1))
+
+	require.NoError(t, txmp.CheckTx(ctx, tx1, nil, TxInfo{SenderID: peerID}))
+	require.Equal(t, types.Txs{tx0, tx1, tx2}, txmp.ReapMaxTxs(-1))
+
+	// committing the head of the lane promotes the rest of it
+	txmp.Lock()
+	require.NoError(t, txmp.Update(ctx, 1, types.Txs{tx0}, []*abci.ResponseDeliverTx{{Code: abci.CodeTypeOK}}, nil, nil))
+	txmp.Unlock()
+	require.Equal(t, types.Txs{tx1, tx2}, txmp.ReapMaxTxs(-1))
+
+	// removing a transaction in the middle of the lane opens a gap again
+	require.NoError(t, txmp.RemoveTxByKey(tx1.Key()))
+	require.Equal(t, 1, txmp.Size())
+	require.Empty(t, txmp.ReapMaxTxs(-1))
+}
+
+func TestTxMempool_SenderLaneGapCommittedElsewhere(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	txmp := setup(ctx, t, 100)
+	peerID := uint16(1)
+
+	tx0 := types.Tx("sender-a=k0=10=0")
+	tx1 := types.Tx("sender-a=k1=10=1")
+	tx2 := types.Tx("sender-a=k2=10=2")
+	require.NoError(t, txmp.CheckTx(ctx, tx0, nil, TxInfo{SenderID: peerID}))
+	require.NoError(t, txmp.CheckTx(ctx, tx2, nil, TxInfo{SenderID: peerID}))
+	require.Equal(t, types.Txs{tx0}, txmp.ReapMaxTxs(-1))
+	require.True(t, txmp.txStore.IsSenderGapped("sender-a"))
+
+	// nonce 1 never reached the mempool, but is committed along with nonce 0
+	txmp.Lock()
+	require.NoError(t, txmp.Update(ctx, 1, types.Txs{tx0, tx1}, []*abci.ResponseDeliverTx{
+		{Code: abci.CodeTypeOK, Sender: "sender-a", Nonce: 0},
+		{Code: abci.CodeTypeOK, Sender: "sender-a", Nonce: 1},
+	}, nil, nil))
+	txmp.Unlock()
+	require.Equal(t, 1, txmp.Size())
+	require.Equal(t, types.Txs{tx2}, txmp.ReapMaxTxs(-1))
+	require.False(t, txmp.txStore.IsSenderGapped("sender-a"))
+}
+
+func TestTxMempool_ReplaceTx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	eventBus := eventbus.NewDefault(log.TestingLogger())
+	require.NoError(t, eventBus.Start(ctx))
+
+	sub, err := eventBus.SubscribeWithArgs(ctx, tmpubsub.SubscribeArgs{
+		ClientID: "test",
+		Query:    types.EventQueryReplacedTx,
+	})
+	require.NoError(t, err)
+
+	txmp := setup(ctx, t, 100, WithEventBus(eventBus))
+	txmp.config.ReplacePriorityBump = 10
+	peerID := uint16(1)
+
+	tx0 := types.Tx("sender-a=k0=10=0")
+	tx1 := types.Tx("sender-a=k1=10=1")
+	tx2 := types.Tx("sender-a=k2=10=2")
+	for _, tx := range []types.Tx{tx0, tx1, tx2} {
+		require.NoError(t, txmp.CheckTx(ctx, tx, nil, TxInfo{SenderID: peerID}))
+	}
+	require.Equal(t, types.Txs{tx0, tx1, tx2}, txmp.ReapMaxTxs(-1))
+
+	// the replacement must beat the priority of the pending transaction by the
+	// configured bump
+	require.NoError(t, txmp.CheckTx(ctx, types.Tx("sender-a=k3=19=1"), nil, TxInfo{SenderID: peerID}))
+	require.Equal(t, types.Txs{tx0, tx1, tx2}, txmp.ReapMaxTxs(-1))
+
+	replacement := types.Tx("sender-a=k3=20=1")
+	require.NoError(t, txmp.CheckTx(ctx, replacement, nil, TxInfo{SenderID: peerID}))
+	require.Equal(t, 3, txmp.Size())
+	require.Equal(t, types.Txs{tx0, replacement, tx2}, txmp.ReapMaxTxs(-1))
+	require.Nil(t, txmp.txStore.GetTxByHash(tx1.Key()))
+	require.Equal(t, int64(len(tx0)+len(replacement)+len(tx2)), txmp.SizeBytes())
+
+	msg, err := sub.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.EventDataReplacedTx{
+		Sender:      "sender-a",
+		Nonce:       1,
+		OldTx:       tx1,
+		OldPriority: 10,
+		NewTx:       replacement,
+		NewPriority: 20,
+	}, msg.Data())
+
+	// the replaced transaction stays in the cache, so it is not admitted again
+	require.NoError(t, txmp.CheckTx(ctx, tx1, nil, TxInfo{SenderID: peerID}))
+	require.Equal(t, types.Txs{tx0, replacement, tx2}, txmp.ReapMaxTxs(-1))
+
+	// replacing the head of the lane keeps the rest of the lane in order
+	head := types.Tx("sender-a=k4=100=0")
+	require.NoError(t, txmp.CheckTx(ctx, head, nil, TxInfo{SenderID: peerID}))
+	require.Equal(t, types.Txs{head, replacement, tx2}, txmp.ReapMaxTxs(-1))
+
+	// a replacement that would take the mempool past its byte limit is
+	// rejected, even if a smaller one fits
+	txmp.config.MaxTxsBytes = txmp.SizeBytes() + 5
+	larger := types.Tx("sender-a=k5-larger=200=2")
+	require.NoError(t, txmp.CheckTx(ctx, larger, nil, TxInfo{SenderID: peerID}))
+	require.Equal(t, types.Txs{head, replacement, tx2}, txmp.ReapMaxTxs(-1))
+
+	fits := types.Tx("sender-a=k5=200=2")
+	require.NoError(t, txmp.CheckTx(ctx, fits, nil, TxInfo{SenderID: peerID}))
+	require.Equal(t, types.Txs{head, replacement, fits}, txmp.ReapMaxTxs(-1))
+	require.LessOrEqual(t, txmp.SizeBytes(), txmp.config.MaxTxsBytes)
+}
+
+func TestTxMempool_EvictedTxEvent(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	eventBus := eventbus.NewDefault(log.TestingLogger())
+	require.NoError(t, eventBus.Start(ctx))
+
+	sub, err := eventBus.SubscribeWithArgs(ctx, tmpubsub.SubscribeArgs{
+		ClientID: "test",
+		Query:    types.EventQueryEvictedTx,
+	})
+	require.NoError(t, err)
+
+	txmp := setup(ctx, t, 100, WithEventBus(eventBus))
+	txmp.config.Size = 1
+	peerID := uint16(1)
+
+	tx0 := types.Tx("sender-a=k0=10")
+	tx1 := types.Tx("sender-b=k0=20")
+	require.NoError(t, txmp.CheckTx(ctx, tx0, nil, TxInfo{SenderID: peerID}))
+	require.NoError(t, txmp.CheckTx(ctx, tx1, nil, TxInfo{SenderID: peerID}))
+	require.Equal(t, types.Txs{tx1}, txmp.ReapMaxTxs(-1))
+
+	msg, err := sub.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.EventDataEvictedTx{
+		Tx:       tx0,
+		Priority: 10,
+		Sender:   "sender-a",
+		Reason:   types.EvictReasonSize,
+		NewTx:    tx1,
+	}, msg.Data())
+}
+
+func TestTxMempool_TxEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	eventBus := eventbus.NewDefault(log.TestingLogger())
+	require.NoError(t, eventBus.Start(ctx))
+
+	subscribe := func(query string) eventbus.Subscription {
+		sub, err := eventBus.SubscribeWithArgs(ctx, tmpubsub.SubscribeArgs{
+			ClientID: "test",
+			Query:    tmquery.MustCompile(query),
+		})
+		require.NoError(t, err)
+		return sub
+	}
+
+	tx0 := types.Tx("sender-a=k0=10=0")
+	tx1 := types.Tx("sender-b=k0=20")
+	bad := types.Tx("bad")
+
+	admitted := subscribe("tm.event='AdmittedTx' AND tx.sender='sender-a'")
+	rejected := subscribe(fmt.Sprintf("tm.event='RejectedTx' AND tx.hash='%X'", bad.Hash()))
+	committed := subscribe("tm.event='CommittedTx'")
+	evicted := subscribe("tm.event='EvictedTx'")
+
+	txmp := setup(ctx, t, 100, WithEventBus(eventBus))
+	txmp.config.TTLNumBlocks = 1
+	peerID := uint16(1)
+
+	require.NoError(t, txmp.CheckTx(ctx, tx0, nil, TxInfo{SenderID: peerID}))
+	require.NoError(t, txmp.CheckTx(ctx, tx1, nil, TxInfo{SenderID: peerID}))
+	require.NoError(t, txmp.CheckTx(ctx, bad, nil, TxInfo{SenderID: peerID}))
+
+	msg, err := admitted.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.EventDataAdmittedTx{
+		Tx:       tx0,
+		Priority: 10,
+		Sender:   "sender-a",
+		Nonce:    0,
+	}, msg.Data())
+
+	msg, err = rejected.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.EventDataRejectedTx{Tx: bad, Code: 101}, msg.Data())
+
+	// committing tx0 two blocks later expires tx1
+	txmp.Lock()
+	require.NoError(t, txmp.Update(ctx, 2, types.Txs{tx0}, []*abci.ResponseDeliverTx{{Code: abci.CodeTypeOK}}, nil, nil))
+	txmp.Unlock()
+	require.Zero(t, txmp.Size())
+
+	msg, err = committed.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.EventDataCommittedTx{
+		Tx:       tx0,
+		Height:   2,
+		Priority: 10,
+		Sender:   "sender-a",
+	}, msg.Data())
+
+	msg, err = evicted.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.EventDataEvictedTx{
+		Tx:       tx1,
+		Priority: 20,
+		Sender:   "sender-b",
+		Reason:   types.EvictReasonTTL,
+	}, msg.Data())
+}
+
+func TestTxMempool_ListTxs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	txmp := setup(ctx, t, 100)
+	txmp.height = 5
+	peerID := uint16(1)
+
+	tx0 := types.Tx("sender-a=k0=10=0")
+	tx1 := types.Tx("sender-a=k1=30=1")
+	tx2 := types.Tx("sender-b=k0=20=0")
+	tx3 := types.Tx("sender-b=k1=40=2")
+	for _, tx := range []types.Tx{tx0, tx1, tx2, tx3} {
+		require.NoError(t, txmp.CheckTx(ctx, tx, nil, TxInfo{SenderID: peerID}))
+	}
+
+	listed := func(filter TxFilter) types.Txs {
+		var txs types.Txs
+		for _, ptx := range txmp.ListTxs(filter) {
+			txs = append(txs, ptx.Tx)
+		}
+		return txs
+	}
+
+	// transactions are listed in reaping order, with gapped transactions last
+	ptxs := txmp.ListTxs(TxFilter{})
+	require.Len(t, ptxs, 4)
+	require.Equal(t, types.Txs{tx2, tx0, tx1, tx3}, listed(TxFilter{}))
+	require.Equal(t, PendingTx{
+		Tx:        tx2,
+		Priority:  20,
+		Sender:    "sender-b",
+		Nonce:     0,
+		GasWanted: 1,
+		Height:    5,
+		Timestamp: ptxs[0].Timestamp,
+	}, ptxs[0])
+	require.False(t, ptxs[0].Timestamp.IsZero())
+	require.True(t, ptxs[3].Gapped)
+
+	minPriority, maxPriority := int64(15), int64(35)
+	require.Equal(t, types.Txs{tx0, tx1}, listed(TxFilter{Sender: "sender-a"}))
+	require.Equal(t, types.Txs{tx2, tx1}, listed(TxFilter{MinPriority: &minPriority, MaxPriority: &maxPriority}))
+	require.Equal(t, types.Txs{tx1}, listed(TxFilter{Sender: "sender-a", MinPriority: &minPriority}))
+
+	minGas, maxGas := int64(2), int64(1)
+	require.Empty(t, listed(TxFilter{MinGasWanted: &minGas}))
+	require.Len(t, listed(TxFilter{MaxGasWanted: &maxGas}), 4)
+
+	minAge, maxAge := time.Hour, time.Hour
+	require.Empty(t, listed(TxFilter{MinAge: &minAge}))
+	require.Len(t, listed(TxFilter{MaxAge: &maxAge}), 4)
+}
+
 func TestTxMempool_ConcurrentTxs(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
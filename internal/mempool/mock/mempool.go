@@ -20,9 +20,11 @@ func (Mempool) Size() int { return 0 }
 func (Mempool) CheckTx(_ context.Context, _ types.Tx, _ func(*abci.Response), _ mempool.TxInfo) error {
 	return nil
 }
-func (Mempool) RemoveTxByKey(txKey types.TxKey) error   { return nil }
-func (Mempool) ReapMaxBytesMaxGas(_, _ int64) types.Txs { return types.Txs{} }
-func (Mempool) ReapMaxTxs(n int) types.Txs              { return types.Txs{} }
+func (Mempool) RemoveTxByKey(txKey types.TxKey) error        { return nil }
+func (Mempool) GetTxByKey(types.TxKey) (types.Tx, bool)      { return nil, false }
+func (Mempool) ListTxs(mempool.TxFilter) []mempool.PendingTx { return nil }
+func (Mempool) ReapMaxBytesMaxGas(_, _ int64) types.Txs      { return types.Txs{} }
+func (Mempool) ReapMaxTxs(n int) types.Txs                   { return types.Txs{} }
 func (Mempool) Update(
 	_ context.Context,
 	_ int64,
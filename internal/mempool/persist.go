@@ -0,0 +1,237 @@
+package mempool
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/orderedcode"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	// prefixes are unique across all tm db's
+	prefixPersistedTx     = int64(13)
+	prefixPersistedHeight = int64(14)
+)
+
+// BlockStore defines the interface used by the mempool to find the
+// transactions committed since its transactions were persisted.
+type BlockStore interface {
+	LoadBlock(height int64) *types.Block
+}
+
+// persistedTx defines a transaction as persisted in the mempool database,
+// along with the height and time at which it was first validated.
+type persistedTx struct {
+	tx        types.Tx
+	height    int64
+	timestamp time.Time
+}
+
+// SaveTxs writes all the transactions in the mempool to the mempool database,
+// replacing the transactions written by a previous call. It is a no-op if the
+// mempool has no database.
+func (txmp *TxMempool) SaveTxs() error {
+	if txmp.db == nil {
+		return nil
+	}
+
+	txmp.mtx.RLock()
+	defer txmp.mtx.RUnlock()
+
+	wTxs := txmp.txStore.GetAllTxs()
+	keys := make(map[string]struct{}, len(wTxs))
+
+	batch := txmp.db.NewBatch()
+	defer batch.Close()
+
+	for _, wtx := range wTxs {
+		key := keyPersistedTx(wtx.hash)
+		keys[string(key)] = struct{}{}
+
+		if err := batch.Set(key, marshalPersistedTx(wtx)); err != nil {
+			return err
+		}
+	}
+
+	// delete the transactions that left the mempool since the previous call
+	iter, err := dbm.IteratePrefix(txmp.db, prefixToBytes(prefixPersistedTx))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if _, ok := keys[string(iter.Key())]; ok {
+			continue
+		}
+
+		if err := batch.Delete(iter.Key()); err != nil {
+			return err
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	if err := batch.Set(prefixToBytes(prefixPersistedHeight), marshalHeight(txmp.height)); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
+// LoadTxs re-submits the transactions written by SaveTxs to the application
+// through CheckTx. The transactions keep the height and time at which they
+// were first validated, so they expire as if the mempool had never been
+// stopped. Transactions committed since they were written, according to
+// blockStore, are dropped. It is a no-op if the mempool has no database.
+func (txmp *TxMempool) LoadTxs(ctx context.Context, blockStore BlockStore) error {
+	if txmp.db == nil {
+		return nil
+	}
+
+	bz, err := txmp.db.Get(prefixToBytes(prefixPersistedHeight))
+	if err != nil {
+		return err
+	}
+	if len(bz) == 0 {
+		// nothing was persisted
+		return nil
+	}
+
+	persistedHeight, err := unmarshalHeight(bz)
+	if err != nil {
+		return err
+	}
+
+	committed := make(map[types.TxKey]struct{})
+	for height := persistedHeight + 1; height <= txmp.height; height++ {
+		block := blockStore.LoadBlock(height)
+		if block == nil {
+			continue
+		}
+
+		for _, tx := range block.Txs {
+			committed[tx.Key()] = struct{}{}
+		}
+	}
+
+	ptxs, err := txmp.listPersistedTxs()
+	if err != nil {
+		return err
+	}
+
+	// re-submit the transactions in the order they were first received
+	sort.SliceStable(ptxs, func(i, j int) bool {
+		return ptxs[i].timestamp.Before(ptxs[j].timestamp)
+	})
+
+	var numLoaded, numCommitted int
+	for _, ptx := range ptxs {
+		if _, ok := committed[ptx.tx.Key()]; ok {
+			numCommitted++
+			continue
+		}
+
+		err := txmp.checkTx(ctx, ptx.tx, nil, TxInfo{SenderID: UnknownPeerID}, ptx.height, ptx.timestamp)
+		if err != nil {
+			txmp.logger.Debug(
+				"failed to load persisted transaction",
+				"tx", fmt.Sprintf("%X", ptx.tx.Hash()),
+				"err", err,
+			)
+			continue
+		}
+
+		numLoaded++
+	}
+
+	txmp.logger.Info(
+		"loaded persisted transactions",
+		"num_txs", numLoaded,
+		"num_committed", numCommitted,
+		"persisted_height", persistedHeight,
+	)
+
+	return nil
+}
+
+func (txmp *TxMempool) listPersistedTxs() ([]persistedTx, error) {
+	iter, err := dbm.IteratePrefix(txmp.db, prefixToBytes(prefixPersistedTx))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var ptxs []persistedTx
+	for ; iter.Valid(); iter.Next() {
+		ptx, err := unmarshalPersistedTx(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+
+		ptxs = append(ptxs, ptx)
+	}
+
+	return ptxs, iter.Error()
+}
+
+// marshalPersistedTx encodes a transaction as its height and timestamp, in
+// nanoseconds since the Unix epoch, followed by its raw bytes.
+func marshalPersistedTx(wtx *WrappedTx) []byte {
+	bz := make([]byte, 16+len(wtx.tx))
+	binary.BigEndian.PutUint64(bz[0:8], uint64(wtx.height))
+	binary.BigEndian.PutUint64(bz[8:16], uint64(wtx.timestamp.UnixNano()))
+	copy(bz[16:], wtx.tx)
+
+	return bz
+}
+
+func unmarshalPersistedTx(bz []byte) (persistedTx, error) {
+	if len(bz) < 16 {
+		return persistedTx{}, errors.New("persisted transaction is too short")
+	}
+
+	return persistedTx{
+		tx:        types.Tx(append([]byte(nil), bz[16:]...)),
+		height:    int64(binary.BigEndian.Uint64(bz[0:8])),
+		timestamp: time.Unix(0, int64(binary.BigEndian.Uint64(bz[8:16]))).UTC(),
+	}, nil
+}
+
+func marshalHeight(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return bz
+}
+
+func unmarshalHeight(bz []byte) (int64, error) {
+	if len(bz) != 8 {
+		return 0, fmt.Errorf("invalid persisted height length: %d", len(bz))
+	}
+
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+func prefixToBytes(prefix int64) []byte {
+	key, err := orderedcode.Append(nil, prefix)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+func keyPersistedTx(hash types.TxKey) []byte {
+	key, err := orderedcode.Append(nil, prefixPersistedTx, string(hash[:]))
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
@@ -0,0 +1,104 @@
+package mempool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+type blockStore map[int64]*types.Block
+
+func (bs blockStore) LoadBlock(height int64) *types.Block {
+	return bs[height]
+}
+
+func TestTxMempool_SaveLoadTxs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db := dbm.NewMemDB()
+	peerID := uint16(1)
+
+	txmp := setup(ctx, t, 100, WithDB(db))
+	txs := checkTxs(ctx, t, txmp, 10, peerID)
+	require.Equal(t, len(txs), txmp.Size())
+
+	// a transaction removed before saving is not persisted
+	require.NoError(t, txmp.RemoveTxByKey(txs[0].tx.Key()))
+	require.NoError(t, txmp.SaveTxs())
+
+	// a transaction removed after saving is deleted by the next save
+	require.NoError(t, txmp.SaveTxs())
+	require.NoError(t, txmp.RemoveTxByKey(txs[1].tx.Key()))
+	require.NoError(t, txmp.SaveTxs())
+
+	ptxs, err := txmp.listPersistedTxs()
+	require.NoError(t, err)
+	require.Len(t, ptxs, len(txs)-2)
+
+	expected := make(map[types.TxKey]*WrappedTx)
+	for _, wtx := range txmp.txStore.GetAllTxs() {
+		expected[wtx.hash] = wtx
+	}
+
+	// restart at height 1, at which one of the persisted transactions was
+	// committed
+	restarted := setup(ctx, t, 100, WithDB(db))
+	restarted.height = 1
+	require.NoError(t, restarted.LoadTxs(ctx, blockStore{
+		1: {Data: types.Data{Txs: types.Txs{txs[2].tx}}},
+	}))
+	require.Equal(t, len(txs)-3, restarted.Size())
+
+	for _, wtx := range restarted.txStore.GetAllTxs() {
+		orig, ok := expected[wtx.hash]
+		require.True(t, ok)
+		require.Equal(t, orig.priority, wtx.priority)
+		require.Equal(t, orig.height, wtx.height)
+		require.True(t, orig.timestamp.Equal(wtx.timestamp))
+	}
+	require.Nil(t, restarted.txStore.GetTxByHash(txs[2].tx.Key()))
+}
+
+func TestTxMempool_LoadTxsExpired(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db := dbm.NewMemDB()
+
+	txmp := setup(ctx, t, 100, WithDB(db))
+	checkTxs(ctx, t, txmp, 10, 0)
+	require.NoError(t, txmp.SaveTxs())
+
+	time.Sleep(100 * time.Millisecond)
+
+	// persisted transactions keep their timestamps, so they expire as if the
+	// mempool had never been stopped
+	restarted := setup(ctx, t, 100, WithDB(db))
+	restarted.config.TTLDuration = 50 * time.Millisecond
+	require.NoError(t, restarted.LoadTxs(ctx, blockStore{}))
+	require.Equal(t, 10, restarted.Size())
+
+	restarted.Lock()
+	require.NoError(t, restarted.Update(ctx, 1, nil, nil, nil, nil))
+	restarted.Unlock()
+	require.Zero(t, restarted.Size())
+}
+
+func TestTxMempool_LoadTxsWithoutDB(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	txmp := setup(ctx, t, 100)
+	checkTxs(ctx, t, txmp, 10, 0)
+	require.NoError(t, txmp.SaveTxs())
+
+	restarted := setup(ctx, t, 100)
+	require.NoError(t, restarted.LoadTxs(ctx, blockStore{}))
+	require.Zero(t, restarted.Size())
+}
@@ -37,8 +37,10 @@ func (pq *TxPriorityQueue) GetEvictableTxs(priority, txSize, totalSize, cap int6
 	txs := make([]*WrappedTx, len(pq.txs))
 	copy(txs, pq.txs)
 
+	// Sort in reverse reaping order so that the tail of a sender's lane is
+	// always evicted before the transactions it depends on.
 	sort.Slice(txs, func(i, j int) bool {
-		return txs[i].priority < txs[j].priority
+		return txLess(txs[j], txs[i])
 	})
 
 	var (
@@ -52,7 +54,7 @@ func (pq *TxPriorityQueue) GetEvictableTxs(priority, txSize, totalSize, cap int6
 	// that are only of less priority than the provided argument. We continue
 	// evaluating transactions until there is sufficient capacity for the new
 	// transaction (size) as defined by txSize.
-	for i < len(txs) && txs[i].priority < priority {
+	for i < len(txs) && txs[i].lanePriority < priority {
 		toEvict = append(toEvict, txs[i])
 		currSize -= int64(txs[i].Size())
 
@@ -66,6 +68,22 @@ func (pq *TxPriorityQueue) GetEvictableTxs(priority, txSize, totalSize, cap int6
 	return nil
 }
 
+// GetTxs returns all the transactions of the priority queue in reaping order.
+// It is thread safe.
+func (pq *TxPriorityQueue) GetTxs() []*WrappedTx {
+	pq.mtx.RLock()
+	defer pq.mtx.RUnlock()
+
+	txs := make([]*WrappedTx, len(pq.txs))
+	copy(txs, pq.txs)
+
+	sort.Slice(txs, func(i, j int) bool {
+		return txLess(txs[i], txs[j])
+	})
+
+	return txs
+}
+
 // NumTxs returns the number of transactions in the priority queue. It is
 // thread safe.
 func (pq *TxPriorityQueue) NumTxs() int {
@@ -93,6 +111,21 @@ func (pq *TxPriorityQueue) PushTx(tx *WrappedTx) {
 	heap.Push(pq, tx)
 }
 
+// SetLanePosition sets the lane priority and timestamp of a transaction, see
+// setLanePosition, and re-establishes its position in the priority queue. The
+// lane fields are read when ordering the queue, so they are only updated under
+// its lock. It is thread safe.
+func (pq *TxPriorityQueue) SetLanePosition(tx, prev *WrappedTx, headNonce uint64) {
+	pq.mtx.Lock()
+	defer pq.mtx.Unlock()
+
+	setLanePosition(tx, prev, headNonce)
+
+	if tx.heapIndex >= 0 && tx.heapIndex < len(pq.txs) && pq.txs[tx.heapIndex] == tx {
+		heap.Fix(pq, tx.heapIndex)
+	}
+}
+
 // PopTx removes the top priority transaction from the queue. It is thread safe.
 func (pq *TxPriorityQueue) PopTx() *WrappedTx {
 	pq.mtx.Lock()
@@ -139,15 +172,7 @@ func (pq *TxPriorityQueue) Len() int {
 // Less implements the Heap interface. It returns true if the transaction at
 // position i in the queue is of less priority than the transaction at position j.
 func (pq *TxPriorityQueue) Less(i, j int) bool {
-	// If there exists two transactions with the same priority, consider the one
-	// that we saw the earliest as the higher priority transaction.
-	if pq.txs[i].priority == pq.txs[j].priority {
-		return pq.txs[i].timestamp.Before(pq.txs[j].timestamp)
-	}
-
-	// We want Pop to give us the highest, not lowest, priority so we use greater
-	// than here.
-	return pq.txs[i].priority > pq.txs[j].priority
+	return txLess(pq.txs[i], pq.txs[j])
 }
 
 // Swap implements the Heap interface. It swaps two transactions in the queue.
@@ -156,3 +181,28 @@ func (pq *TxPriorityQueue) Swap(i, j int) {
 	pq.txs[i].heapIndex = i
 	pq.txs[j].heapIndex = j
 }
+
+// txLess returns true if wtx1 must be reaped before wtx2. Transactions are
+// ordered by their lane priority and timestamp, which guarantees that the
+// transactions of a sender are reaped in nonce order.
+func txLess(wtx1, wtx2 *WrappedTx) bool {
+	// We want Pop to give us the highest, not lowest, priority so we use greater
+	// than here.
+	if wtx1.lanePriority != wtx2.lanePriority {
+		return wtx1.lanePriority > wtx2.lanePriority
+	}
+
+	// If there exists two transactions with the same priority, consider the one
+	// that we saw the earliest as the higher priority transaction.
+	if !wtx1.laneTimestamp.Equal(wtx2.laneTimestamp) {
+		return wtx1.laneTimestamp.Before(wtx2.laneTimestamp)
+	}
+
+	// Transactions of the same lane share their lane priority and timestamp
+	// with their predecessors, so fall back to the nonce.
+	if wtx1.sender != wtx2.sender {
+		return wtx1.sender < wtx2.sender
+	}
+
+	return wtx1.nonce < wtx2.nonce
+}
@@ -22,9 +22,12 @@ func TestTxPriorityQueue(t *testing.T) {
 		wg.Add(1)
 
 		go func(i int) {
+			now := time.Now()
 			pq.PushTx(&WrappedTx{
-				priority:  int64(i),
-				timestamp: time.Now(),
+				priority:      int64(i),
+				timestamp:     now,
+				lanePriority:  int64(i),
+				laneTimestamp: now,
 			})
 
 			wg.Done()
@@ -40,8 +43,10 @@ func TestTxPriorityQueue(t *testing.T) {
 	time.Sleep(time.Second)
 	now := time.Now()
 	pq.PushTx(&WrappedTx{
-		priority:  1000,
-		timestamp: now,
+		priority:      1000,
+		timestamp:     now,
+		lanePriority:  1000,
+		laneTimestamp: now,
 	})
 	require.Equal(t, 1001, pq.NumTxs())
 
@@ -71,8 +76,9 @@ func TestTxPriorityQueue_GetEvictableTxs(t *testing.T) {
 
 		x := rng.Intn(100000)
 		pq.PushTx(&WrappedTx{
-			tx:       tx,
-			priority: int64(x),
+			tx:           tx,
+			priority:     int64(x),
+			lanePriority: int64(x),
 		})
 
 		values[i] = x
@@ -151,7 +157,8 @@ func TestTxPriorityQueue_RemoveTx(t *testing.T) {
 	for i := 0; i < numTxs; i++ {
 		x := rng.Intn(100000)
 		pq.PushTx(&WrappedTx{
-			priority: int64(x),
+			priority:     int64(x),
+			lanePriority: int64(x),
 		})
 
 		values[i] = x
@@ -174,3 +181,35 @@ func TestTxPriorityQueue_RemoveTx(t *testing.T) {
 	})
 	require.Equal(t, numTxs-2, pq.NumTxs())
 }
+
+func TestTxPriorityQueue_SenderLane(t *testing.T) {
+	pq := NewTxPriorityQueue()
+	now := time.Now()
+
+	// A lane shares the lane priority and timestamp of its head, so the nonce
+	// decides the order within the lane.
+	for _, nonce := range []uint64{2, 0, 1} {
+		pq.PushTx(&WrappedTx{
+			sender:        "foo",
+			nonce:         nonce,
+			priority:      int64(10 * (nonce + 1)),
+			lanePriority:  10,
+			laneTimestamp: now,
+		})
+	}
+	pq.PushTx(&WrappedTx{
+		sender:        "bar",
+		priority:      20,
+		lanePriority:  20,
+		laneTimestamp: now,
+	})
+
+	tx := pq.PopTx()
+	require.Equal(t, "bar", tx.sender)
+
+	for nonce := uint64(0); nonce < 3; nonce++ {
+		tx = pq.PopTx()
+		require.Equal(t, "foo", tx.sender)
+		require.Equal(t, nonce, tx.nonce)
+	}
+}
@@ -2,6 +2,7 @@ package mempool
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"runtime/debug"
@@ -18,6 +19,28 @@ import (
 	"github.com/tendermint/tendermint/types"
 )
 
+const (
+	// maxTxKeysPerMsg defines the maximum number of tx keys in a message sent
+	// on the MempoolAnnounceChannel.
+	maxTxKeysPerMsg = 1000
+
+	// maxPeerAnnounces defines the maximum number of envelopes received from a
+	// peer on the MempoolAnnounceChannel waiting to be handled.
+	maxPeerAnnounces = 10000
+
+	// maxTxRequests defines the maximum number of pending requests for txs
+	// announced by peers.
+	maxTxRequests = 100000
+
+	// txRequestTimeout defines how long we wait for a requested tx before
+	// requesting it from another peer that announced it.
+	txRequestTimeout = 5 * time.Second
+
+	// txRequestCheckInterval defines how often we check for expired tx
+	// requests.
+	txRequestCheckInterval = time.Second
+)
+
 var (
 	_ service.Service = (*Reactor)(nil)
 	_ p2p.Wrapper     = (*protomem.Message)(nil)
@@ -33,6 +56,10 @@ type PeerManager interface {
 // Reactor implements a service that contains mempool of txs that are broadcasted
 // amongst peers. It maintains a map from peer ID to counter, to prevent gossiping
 // txs to the peers you received it from.
+//
+// Depending on the configured gossip mode, txs are either pushed in full to
+// every peer, or their keys are announced to the peers that support it, which
+// request the txs they don't have.
 type Reactor struct {
 	service.BaseService
 	logger log.Logger
@@ -47,6 +74,7 @@ type Reactor struct {
 	peerMgr PeerManager
 
 	mempoolCh   *p2p.Channel
+	announceCh  *p2p.Channel
 	peerUpdates *p2p.PeerUpdates
 
 	// peerWG is used to coordinate graceful termination of all peer broadcasting
@@ -57,8 +85,21 @@ type Reactor struct {
 	// Reactor. observePanic is called with the recovered value.
 	observePanic func(interface{})
 
-	mtx          sync.Mutex
-	peerRoutines map[types.NodeID]*tmsync.Closer
+	// requests tracks the txs requested from peers that announced them.
+	requests *txRequests
+
+	mtx           sync.Mutex
+	peerRoutines  map[types.NodeID]*tmsync.Closer
+	peerAnnounces map[types.NodeID]*peerAnnounces
+}
+
+// peerAnnounces holds the envelopes received from a peer on the
+// MempoolAnnounceChannel, until they are handled by the peer's announce
+// goroutine. Handling them involves sending to the peer, which must not block
+// the receive loop or two peers sending to each other could deadlock.
+type peerAnnounces struct {
+	envelopes chan *p2p.Envelope
+	closer    *tmsync.Closer
 }
 
 // NewReactor returns a reference to a new reactor.
@@ -68,19 +109,23 @@ func NewReactor(
 	peerMgr PeerManager,
 	txmp *TxMempool,
 	mempoolCh *p2p.Channel,
+	announceCh *p2p.Channel,
 	peerUpdates *p2p.PeerUpdates,
 ) *Reactor {
 
 	r := &Reactor{
-		logger:       logger,
-		cfg:          cfg,
-		peerMgr:      peerMgr,
-		mempool:      txmp,
-		ids:          NewMempoolIDs(),
-		mempoolCh:    mempoolCh,
-		peerUpdates:  peerUpdates,
-		peerRoutines: make(map[types.NodeID]*tmsync.Closer),
-		observePanic: defaultObservePanic,
+		logger:        logger,
+		cfg:           cfg,
+		peerMgr:       peerMgr,
+		mempool:       txmp,
+		ids:           NewMempoolIDs(),
+		mempoolCh:     mempoolCh,
+		announceCh:    announceCh,
+		peerUpdates:   peerUpdates,
+		requests:      newTxRequests(maxTxRequests, txRequestTimeout),
+		peerRoutines:  make(map[types.NodeID]*tmsync.Closer),
+		peerAnnounces: make(map[types.NodeID]*peerAnnounces),
+		observePanic:  defaultObservePanic,
 	}
 
 	r.BaseService = *service.NewBaseService(logger, "Mempool", r)
@@ -108,6 +153,28 @@ func GetChannelDescriptor(cfg *config.MempoolConfig) *p2p.ChannelDescriptor {
 	}
 }
 
+// GetAnnounceChannelDescriptor produces an instance of a descriptor for the
+// channel carrying transaction key announcements and requests.
+func GetAnnounceChannelDescriptor() *p2p.ChannelDescriptor {
+	keys := make([][]byte, maxTxKeysPerMsg)
+	for i := range keys {
+		keys[i] = make([]byte, sha256.Size)
+	}
+	wantMsg := protomem.Message{
+		Sum: &protomem.Message_WantTxs{
+			WantTxs: &protomem.WantTxs{Keys: keys},
+		},
+	}
+
+	return &p2p.ChannelDescriptor{
+		ID:                  MempoolAnnounceChannel,
+		MessageType:         new(protomem.Message),
+		Priority:            5,
+		RecvMessageCapacity: wantMsg.Size(),
+		RecvBufferCapacity:  128,
+	}
+}
+
 // OnStart starts separate go routines for each p2p Channel and listens for
 // envelopes on each. In addition, it also listens for peer updates and handles
 // messages on that p2p channel accordingly. The caller must be sure to execute
@@ -118,22 +185,57 @@ func (r *Reactor) OnStart(ctx context.Context) error {
 	}
 
 	go r.processMempoolCh(ctx)
+	go r.processAnnounceCh(ctx)
 	go r.processPeerUpdates(ctx)
+	go r.requestExpiredTxsRoutine(ctx)
+
+	if r.cfg.Persist && r.cfg.PersistInterval > 0 {
+		go r.persistTxsRoutine(ctx)
+	}
 
 	return nil
 }
 
 // OnStop stops the reactor by signaling to all spawned goroutines to exit and
-// blocking until they all exit.
+// blocking until they all exit. If persistence is enabled, the transactions in
+// the mempool are saved one last time.
 func (r *Reactor) OnStop() {
 	r.mtx.Lock()
 	for _, c := range r.peerRoutines {
 		c.Close()
 	}
+	for _, pa := range r.peerAnnounces {
+		pa.closer.Close()
+	}
 	r.mtx.Unlock()
 
-	// wait for all spawned peer tx broadcasting goroutines to gracefully exit
+	// wait for all spawned peer tx broadcasting and announce goroutines to
+	// gracefully exit
 	r.peerWG.Wait()
+
+	if r.cfg.Persist {
+		if err := r.mempool.SaveTxs(); err != nil {
+			r.logger.Error("failed to persist mempool transactions", "err", err)
+		}
+	}
+}
+
+// persistTxsRoutine saves the transactions in the mempool every
+// PersistInterval, until the context is canceled.
+func (r *Reactor) persistTxsRoutine(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.PersistInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.mempool.SaveTxs(); err != nil {
+				r.logger.Error("failed to persist mempool transactions", "err", err)
+			}
+		}
+	}
 }
 
 // handleMempoolMessage handles envelopes sent from peers on the MempoolChannel.
@@ -159,6 +261,83 @@ func (r *Reactor) handleMempoolMessage(ctx context.Context, envelope *p2p.Envelo
 			if err := r.mempool.CheckTx(ctx, types.Tx(tx), nil, txInfo); err != nil {
 				logger.Error("checktx failed for tx", "tx", fmt.Sprintf("%X", types.Tx(tx).Hash()), "err", err)
 			}
+
+			r.requests.Received(types.Tx(tx).Key())
+		}
+
+	default:
+		return fmt.Errorf("received unknown message: %T", msg)
+	}
+
+	return nil
+}
+
+// handleAnnounceMessage handles envelopes sent from peers on the
+// MempoolAnnounceChannel. For announced tx keys, we request the txs that are
+// neither in the mempool nor in the cache nor already requested from another
+// peer, and record that the peer has the others so we don't announce them
+// back. For requested tx keys, we send the txs that are in the mempool. It
+// returns an error if an empty or invalid set of keys is sent in an envelope
+// or if we receive an unexpected message type.
+func (r *Reactor) handleAnnounceMessage(ctx context.Context, envelope *p2p.Envelope) error {
+	switch msg := envelope.Message.(type) {
+	case *protomem.HaveTxs:
+		keys, err := toTxKeys(msg.GetKeys())
+		if err != nil {
+			return err
+		}
+
+		peerMempoolID := r.ids.GetForPeer(envelope.From)
+		want := make([][]byte, 0, len(keys))
+		for _, key := range keys {
+			if wtx, _ := r.mempool.txStore.GetOrSetPeerByTxHash(key, peerMempoolID); wtx != nil {
+				continue
+			}
+
+			// the tx was already received, and is either being checked, was
+			// committed or was rejected
+			if r.mempool.cache.Has(key) {
+				continue
+			}
+
+			// the tx is requested from another peer, and will be requested
+			// from this one if that request expires
+			if !r.requests.Request(key, envelope.From, time.Now()) {
+				continue
+			}
+
+			want = append(want, key[:])
+		}
+
+		if len(want) > 0 {
+			return r.announceCh.Send(ctx, p2p.Envelope{
+				To:      envelope.From,
+				Message: &protomem.WantTxs{Keys: want},
+			})
+		}
+
+	case *protomem.WantTxs:
+		keys, err := toTxKeys(msg.GetKeys())
+		if err != nil {
+			return err
+		}
+
+		// NOTE: Transaction batching was disabled due to:
+		// https://github.com/tendermint/tendermint/issues/5796
+		for _, key := range keys {
+			wtx := r.mempool.txStore.GetTxByHash(key)
+			if wtx == nil {
+				continue
+			}
+
+			if err := r.mempoolCh.Send(ctx, p2p.Envelope{
+				To: envelope.From,
+				Message: &protomem.Txs{
+					Txs: [][]byte{wtx.tx},
+				},
+			}); err != nil {
+				return err
+			}
 		}
 
 	default:
@@ -190,6 +369,9 @@ func (r *Reactor) handleMessage(ctx context.Context, chID p2p.ChannelID, envelop
 	case MempoolChannel:
 		err = r.handleMempoolMessage(ctx, envelope)
 
+	case MempoolAnnounceChannel:
+		err = r.handleAnnounceMessage(ctx, envelope)
+
 	default:
 		err = fmt.Errorf("unknown channel ID (%d) for envelope (%T)", chID, envelope.Message)
 	}
@@ -215,11 +397,73 @@ func (r *Reactor) processMempoolCh(ctx context.Context) {
 	}
 }
 
+// processAnnounceCh implements a blocking event loop where we listen for p2p
+// Envelope messages from the announceCh and queue them for the announce
+// goroutine of the sending peer. Envelopes are dropped if the peer's queue is
+// full.
+func (r *Reactor) processAnnounceCh(ctx context.Context) {
+	iter := r.announceCh.Receive(ctx)
+	for iter.Next(ctx) {
+		envelope := iter.Envelope()
+
+		r.mtx.Lock()
+		pa, ok := r.peerAnnounces[envelope.From]
+		r.mtx.Unlock()
+
+		if !ok {
+			r.logger.Debug("dropping announce message from unknown peer", "peer", envelope.From)
+			continue
+		}
+
+		select {
+		case pa.envelopes <- envelope:
+		default:
+			r.logger.Debug("dropping announce message; peer queue is full", "peer", envelope.From)
+		}
+	}
+}
+
+// processPeerAnnounces handles the envelopes received from a peer on the
+// announceCh, until the peer goes down or the reactor stops.
+func (r *Reactor) processPeerAnnounces(ctx context.Context, peerID types.NodeID, pa *peerAnnounces) {
+	defer func() {
+		r.mtx.Lock()
+		delete(r.peerAnnounces, peerID)
+		r.mtx.Unlock()
+
+		r.peerWG.Done()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-pa.closer.Done():
+			return
+
+		case envelope := <-pa.envelopes:
+			if err := r.handleMessage(ctx, r.announceCh.ID, envelope); err != nil {
+				r.logger.Error("failed to process message", "ch_id", r.announceCh.ID, "envelope", envelope, "err", err)
+				if serr := r.announceCh.SendError(ctx, p2p.PeerError{
+					NodeID: envelope.From,
+					Err:    err,
+				}); serr != nil {
+					return
+				}
+			}
+		}
+	}
+}
+
 // processPeerUpdate processes a PeerUpdate. For added peers, PeerStatusUp, we
 // check if the reactor is running and if we've already started a tx broadcasting
-// goroutine or not. If not, we start one for the newly added peer. For down or
+// goroutine or not. If not, we start one for the newly added peer, which only
+// announces tx keys if we are configured to and the peer opened the
+// MempoolAnnounceChannel. We also start a goroutine handling the announcements
+// and requests of peers that opened the MempoolAnnounceChannel. For down or
 // removed peers, we remove the peer from the mempool peer ID set and signal to
-// stop the tx broadcasting goroutine.
+// stop the tx broadcasting and announce goroutines.
 func (r *Reactor) processPeerUpdate(ctx context.Context, peerUpdate p2p.PeerUpdate) {
 	r.logger.Debug("received peer update", "peer", peerUpdate.NodeID, "status", peerUpdate.Status)
 
@@ -249,11 +493,27 @@ func (r *Reactor) processPeerUpdate(ctx context.Context, peerUpdate p2p.PeerUpda
 
 				r.ids.ReserveForPeer(peerUpdate.NodeID)
 
+				announce := r.cfg.GossipMode == config.MempoolGossipAnnounce &&
+					peerUpdate.Channels.Contains(MempoolAnnounceChannel)
+
 				// start a broadcast routine ensuring all txs are forwarded to the peer
-				go r.broadcastTxRoutine(ctx, peerUpdate.NodeID, closer)
+				go r.broadcastTxRoutine(ctx, peerUpdate.NodeID, announce, closer)
 			}
 		}
 
+		_, ok := r.peerAnnounces[peerUpdate.NodeID]
+		if !ok && peerUpdate.Channels.Contains(MempoolAnnounceChannel) {
+			pa := &peerAnnounces{
+				envelopes: make(chan *p2p.Envelope, maxPeerAnnounces),
+				closer:    tmsync.NewCloser(),
+			}
+
+			r.peerAnnounces[peerUpdate.NodeID] = pa
+			r.peerWG.Add(1)
+
+			go r.processPeerAnnounces(ctx, peerUpdate.NodeID, pa)
+		}
+
 	case p2p.PeerStatusDown:
 		r.ids.Reclaim(peerUpdate.NodeID)
 
@@ -265,6 +525,12 @@ func (r *Reactor) processPeerUpdate(ctx context.Context, peerUpdate p2p.PeerUpda
 		if ok {
 			closer.Close()
 		}
+
+		if pa, ok := r.peerAnnounces[peerUpdate.NodeID]; ok {
+			pa.closer.Close()
+		}
+
+		r.requests.RemovePeer(peerUpdate.NodeID)
 	}
 }
 
@@ -283,7 +549,45 @@ func (r *Reactor) processPeerUpdates(ctx context.Context) {
 	}
 }
 
-func (r *Reactor) broadcastTxRoutine(ctx context.Context, peerID types.NodeID, closer *tmsync.Closer) {
+// requestExpiredTxsRoutine periodically requests the txs whose request expired
+// from the next peer that announced them, until the context is canceled.
+func (r *Reactor) requestExpiredTxsRoutine(ctx context.Context) {
+	ticker := time.NewTicker(txRequestCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for peerID, keys := range r.requests.Expire(time.Now()) {
+				for len(keys) > 0 {
+					n := len(keys)
+					if n > maxTxKeysPerMsg {
+						n = maxTxKeysPerMsg
+					}
+
+					want := make([][]byte, n)
+					for i := range want {
+						want[i] = keys[i][:]
+					}
+					keys = keys[n:]
+
+					if err := r.announceCh.Send(ctx, p2p.Envelope{
+						To:      peerID,
+						Message: &protomem.WantTxs{Keys: want},
+					}); err != nil {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// broadcastTxRoutine forwards all the txs in the mempool to a peer, either in
+// full or, if announce is true, by announcing their keys.
+func (r *Reactor) broadcastTxRoutine(ctx context.Context, peerID types.NodeID, announce bool, closer *tmsync.Closer) {
 	peerMempoolID := r.ids.GetForPeer(peerID)
 	var nextGossipTx *clist.CElement
 
@@ -343,14 +647,26 @@ func (r *Reactor) broadcastTxRoutine(ctx context.Context, peerID types.NodeID, c
 		// NOTE: Transaction batching was disabled due to:
 		// https://github.com/tendermint/tendermint/issues/5796
 		if ok := r.mempool.txStore.TxHasPeer(memTx.hash, peerMempoolID); !ok {
-			// Send the mempool tx to the corresponding peer. Note, the peer may be
-			// behind and thus would not be able to process the mempool tx correctly.
-			if err := r.mempoolCh.Send(ctx, p2p.Envelope{
-				To: peerID,
-				Message: &protomem.Txs{
-					Txs: [][]byte{memTx.tx},
-				},
-			}); err != nil {
+			// Send the mempool tx, or its key, to the corresponding peer. Note, the
+			// peer may be behind and thus would not be able to process the mempool
+			// tx correctly.
+			var err error
+			if announce {
+				err = r.announceCh.Send(ctx, p2p.Envelope{
+					To: peerID,
+					Message: &protomem.HaveTxs{
+						Keys: [][]byte{memTx.hash[:]},
+					},
+				})
+			} else {
+				err = r.mempoolCh.Send(ctx, p2p.Envelope{
+					To: peerID,
+					Message: &protomem.Txs{
+						Txs: [][]byte{memTx.tx},
+					},
+				})
+			}
+			if err != nil {
 				return
 			}
 
@@ -358,6 +674,7 @@ func (r *Reactor) broadcastTxRoutine(ctx context.Context, peerID types.NodeID, c
 				"gossiped tx to peer",
 				"tx", fmt.Sprintf("%X", memTx.tx.Hash()),
 				"peer", peerID,
+				"announced", announce,
 			)
 		}
 
@@ -373,3 +690,21 @@ func (r *Reactor) broadcastTxRoutine(ctx context.Context, peerID types.NodeID, c
 		}
 	}
 }
+
+// toTxKeys converts the tx keys of a HaveTxs or WantTxs message.
+func toTxKeys(bzs [][]byte) ([]types.TxKey, error) {
+	if len(bzs) == 0 {
+		return nil, errors.New("empty tx keys received from peer")
+	}
+
+	keys := make([]types.TxKey, len(bzs))
+	for i, bz := range bzs {
+		if len(bz) != sha256.Size {
+			return nil, fmt.Errorf("invalid tx key length: %d", len(bz))
+		}
+
+		copy(keys[i][:], bz)
+	}
+
+	return keys, nil
+}
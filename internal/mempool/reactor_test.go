@@ -2,6 +2,7 @@ package mempool
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"strings"
 	"sync"
@@ -26,10 +27,11 @@ type reactorTestSuite struct {
 	network *p2ptest.Network
 	logger  log.Logger
 
-	reactors        map[types.NodeID]*Reactor
-	mempoolChannels map[types.NodeID]*p2p.Channel
-	mempools        map[types.NodeID]*TxMempool
-	kvstores        map[types.NodeID]*kvstore.Application
+	reactors         map[types.NodeID]*Reactor
+	mempoolChannels  map[types.NodeID]*p2p.Channel
+	announceChannels map[types.NodeID]*p2p.Channel
+	mempools         map[types.NodeID]*TxMempool
+	kvstores         map[types.NodeID]*kvstore.Application
 
 	peerChans   map[types.NodeID]chan p2p.PeerUpdate
 	peerUpdates map[types.NodeID]*p2p.PeerUpdates
@@ -45,18 +47,20 @@ func setupReactors(ctx context.Context, t *testing.T, numNodes int, chBuf uint)
 	t.Cleanup(func() { os.RemoveAll(cfg.RootDir) })
 
 	rts := &reactorTestSuite{
-		logger:          log.TestingLogger().With("testCase", t.Name()),
-		network:         p2ptest.MakeNetwork(ctx, t, p2ptest.NetworkOptions{NumNodes: numNodes}),
-		reactors:        make(map[types.NodeID]*Reactor, numNodes),
-		mempoolChannels: make(map[types.NodeID]*p2p.Channel, numNodes),
-		mempools:        make(map[types.NodeID]*TxMempool, numNodes),
-		kvstores:        make(map[types.NodeID]*kvstore.Application, numNodes),
-		peerChans:       make(map[types.NodeID]chan p2p.PeerUpdate, numNodes),
-		peerUpdates:     make(map[types.NodeID]*p2p.PeerUpdates, numNodes),
+		logger:           log.TestingLogger().With("testCase", t.Name()),
+		network:          p2ptest.MakeNetwork(ctx, t, p2ptest.NetworkOptions{NumNodes: numNodes}),
+		reactors:         make(map[types.NodeID]*Reactor, numNodes),
+		mempoolChannels:  make(map[types.NodeID]*p2p.Channel, numNodes),
+		announceChannels: make(map[types.NodeID]*p2p.Channel, numNodes),
+		mempools:         make(map[types.NodeID]*TxMempool, numNodes),
+		kvstores:         make(map[types.NodeID]*kvstore.Application, numNodes),
+		peerChans:        make(map[types.NodeID]chan p2p.PeerUpdate, numNodes),
+		peerUpdates:      make(map[types.NodeID]*p2p.PeerUpdates, numNodes),
 	}
 
 	chDesc := GetChannelDescriptor(cfg.Mempool)
 	rts.mempoolChannels = rts.network.MakeChannelsNoCleanup(ctx, t, chDesc)
+	rts.announceChannels = rts.network.MakeChannelsNoCleanup(ctx, t, GetAnnounceChannelDescriptor())
 
 	for nodeID := range rts.network.Nodes {
 		rts.kvstores[nodeID] = kvstore.NewApplication()
@@ -74,6 +78,7 @@ func setupReactors(ctx context.Context, t *testing.T, numNodes int, chBuf uint)
 			rts.network.Nodes[nodeID].PeerManager,
 			mempool,
 			rts.mempoolChannels[nodeID],
+			rts.announceChannels[nodeID],
 			rts.peerUpdates[nodeID],
 		)
 
@@ -164,7 +169,7 @@ func TestReactorBroadcastDoesNotPanic(t *testing.T) {
 
 	closer := tmsync.NewCloser()
 	primaryReactor.peerWG.Add(1)
-	go primaryReactor.broadcastTxRoutine(ctx, secondary, closer)
+	go primaryReactor.broadcastTxRoutine(ctx, secondary, false, closer)
 
 	wg := &sync.WaitGroup{}
 	for i := 0; i < 50; i++ {
@@ -204,6 +209,156 @@ func TestReactorBroadcastTxs(t *testing.T) {
 	rts.waitForTxns(t, convertTex(txs), secondaries...)
 }
 
+func TestReactorBroadcastTxs_Announce(t *testing.T) {
+	numTxs := 1000
+	numNodes := 10
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rts := setupReactors(ctx, t, numNodes, uint(numTxs))
+
+	primary := rts.nodes[0]
+	secondaries := rts.nodes[1:]
+
+	// all the reactors share the same config
+	rts.reactors[primary].cfg.GossipMode = config.MempoolGossipAnnounce
+
+	txs := checkTxs(ctx, t, rts.reactors[primary].mempool, numTxs, UnknownPeerID)
+
+	require.Equal(t, numTxs, rts.reactors[primary].mempool.Size())
+
+	rts.start(ctx, t)
+
+	// Wait till all secondary suites (reactor) received all mempool txs from the
+	// primary suite (node).
+	rts.waitForTxns(t, convertTex(txs), secondaries...)
+}
+
+func TestReactorAnnounceTxs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rts := setupReactors(ctx, t, 1, 0)
+
+	primary := rts.nodes[0]
+	rts.reactors[primary].cfg.GossipMode = config.MempoolGossipAnnounce
+
+	peer := rts.network.MakeNode(ctx, t, p2ptest.NodeOptions{})
+	rts.network.Nodes[peer.NodeID] = peer
+	mempoolCh := peer.MakeChannelNoCleanup(ctx, t, GetChannelDescriptor(rts.reactors[primary].cfg))
+	announceCh := peer.MakeChannelNoCleanup(ctx, t, GetAnnounceChannelDescriptor())
+
+	rts.network.Start(ctx, t)
+
+	txs := checkTxs(ctx, t, rts.mempools[primary], 1, UnknownPeerID)
+	key := txs[0].tx.Key()
+
+	// the peer opened the announce channel, so it only receives the key
+	envelope := receiveEnvelope(ctx, t, announceCh)
+	require.Equal(t, primary, envelope.From)
+	require.Equal(t, &protomem.HaveTxs{Keys: [][]byte{key[:]}}, envelope.Message)
+
+	require.NoError(t, announceCh.Send(ctx, p2p.Envelope{
+		To:      primary,
+		Message: &protomem.WantTxs{Keys: [][]byte{key[:]}},
+	}))
+
+	envelope = receiveEnvelope(ctx, t, mempoolCh)
+	require.Equal(t, primary, envelope.From)
+	require.Equal(t, &protomem.Txs{Txs: [][]byte{txs[0].tx}}, envelope.Message)
+}
+
+func TestReactorAnnounceTxs_LegacyPeer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rts := setupReactors(ctx, t, 1, 0)
+
+	primary := rts.nodes[0]
+	rts.reactors[primary].cfg.GossipMode = config.MempoolGossipAnnounce
+
+	// the peer doesn't open the announce channel, like a node running a
+	// version without announcements
+	peer := rts.network.MakeNode(ctx, t, p2ptest.NodeOptions{})
+	rts.network.Nodes[peer.NodeID] = peer
+	mempoolCh := peer.MakeChannelNoCleanup(ctx, t, GetChannelDescriptor(rts.reactors[primary].cfg))
+
+	rts.network.Start(ctx, t)
+
+	txs := checkTxs(ctx, t, rts.mempools[primary], 1, UnknownPeerID)
+
+	envelope := receiveEnvelope(ctx, t, mempoolCh)
+	require.Equal(t, primary, envelope.From)
+	require.Equal(t, &protomem.Txs{Txs: [][]byte{txs[0].tx}}, envelope.Message)
+}
+
+func TestReactorAnnounceTxs_RequestOnce(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rts := setupReactors(ctx, t, 1, 0)
+
+	primary := rts.nodes[0]
+	cfg := rts.reactors[primary].cfg
+
+	timeout := 500 * time.Millisecond
+	requests := rts.reactors[primary].requests
+	requests.mtx.Lock()
+	requests.timeout = timeout
+	requests.mtx.Unlock()
+
+	peerA := rts.network.MakeNode(ctx, t, p2ptest.NodeOptions{})
+	rts.network.Nodes[peerA.NodeID] = peerA
+	peerA.MakeChannelNoCleanup(ctx, t, GetChannelDescriptor(cfg))
+	announceA := peerA.MakeChannelNoCleanup(ctx, t, GetAnnounceChannelDescriptor())
+
+	peerB := rts.network.MakeNode(ctx, t, p2ptest.NodeOptions{})
+	rts.network.Nodes[peerB.NodeID] = peerB
+	mempoolB := peerB.MakeChannelNoCleanup(ctx, t, GetChannelDescriptor(cfg))
+	announceB := peerB.MakeChannelNoCleanup(ctx, t, GetAnnounceChannelDescriptor())
+
+	rts.network.Start(ctx, t)
+
+	tx := types.Tx(fmt.Sprintf("sender-0-0=%X=1000", tmrand.Bytes(20)))
+	key := tx.Key()
+	haveTxs := &protomem.HaveTxs{Keys: [][]byte{key[:]}}
+	wantTxs := &protomem.WantTxs{Keys: [][]byte{key[:]}}
+
+	// the tx is requested from the first peer announcing it
+	requested := time.Now()
+	require.NoError(t, announceA.Send(ctx, p2p.Envelope{To: primary, Message: haveTxs}))
+
+	envelope := receiveEnvelope(ctx, t, announceA)
+	require.Equal(t, primary, envelope.From)
+	require.Equal(t, wantTxs, envelope.Message)
+
+	// the other peer announcing it is only asked once the request expires
+	require.NoError(t, announceB.Send(ctx, p2p.Envelope{To: primary, Message: haveTxs}))
+
+	envelope = receiveEnvelope(ctx, t, announceB)
+	require.Equal(t, primary, envelope.From)
+	require.Equal(t, wantTxs, envelope.Message)
+	require.GreaterOrEqual(t, time.Since(requested), timeout)
+
+	require.NoError(t, mempoolB.Send(ctx, p2p.Envelope{
+		To:      primary,
+		Message: &protomem.Txs{Txs: [][]byte{tx}},
+	}))
+	require.Eventually(t, func() bool { return rts.mempools[primary].Size() == 1 },
+		5*time.Second, 10*time.Millisecond)
+}
+
+func receiveEnvelope(ctx context.Context, t *testing.T, ch *p2p.Channel) *p2p.Envelope {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	iter := ch.Receive(ctx)
+	require.True(t, iter.Next(ctx), "timed out waiting for envelope")
+	return iter.Envelope()
+}
+
 // regression test for https://github.com/tendermint/tendermint/issues/5408
 func TestReactorConcurrency(t *testing.T) {
 	numTxs := 5
@@ -0,0 +1,158 @@
+package mempool
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+// txRequests tracks the txs requested from peers on the MempoolAnnounceChannel,
+// so that a tx announced by several peers is only downloaded once. A request
+// that isn't answered in time expires, and the tx is then requested from
+// another peer that announced it. The number of tracked requests is bounded,
+// the oldest ones are forgotten first.
+type txRequests struct {
+	mtx      sync.Mutex
+	size     int
+	timeout  time.Duration
+	requests map[types.TxKey]*list.Element
+	order    *list.List
+}
+
+// txRequest is a request for a tx sent to a peer.
+type txRequest struct {
+	key     types.TxKey
+	peerID  types.NodeID
+	expires time.Time
+
+	// announcers are the other peers that announced the tx, from which it is
+	// requested when the request expires.
+	announcers []types.NodeID
+}
+
+func newTxRequests(size int, timeout time.Duration) *txRequests {
+	return &txRequests{
+		size:     size,
+		timeout:  timeout,
+		requests: make(map[types.TxKey]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Request records that the tx with the given key was announced by a peer. It
+// returns true if the tx must be requested from the peer, which is the case
+// unless a request sent to another peer is still pending.
+func (r *txRequests) Request(key types.TxKey, peerID types.NodeID, now time.Time) bool {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if e, ok := r.requests[key]; ok {
+		req := e.Value.(*txRequest)
+		if now.Before(req.expires) {
+			req.addAnnouncer(peerID)
+			return false
+		}
+
+		req.peerID = peerID
+		req.expires = now.Add(r.timeout)
+		return true
+	}
+
+	if r.order.Len() >= r.size {
+		oldest := r.order.Front()
+		delete(r.requests, oldest.Value.(*txRequest).key)
+		r.order.Remove(oldest)
+	}
+
+	r.requests[key] = r.order.PushBack(&txRequest{
+		key:     key,
+		peerID:  peerID,
+		expires: now.Add(r.timeout),
+	})
+	return true
+}
+
+// Received removes the request for the tx with the given key, if any.
+func (r *txRequests) Received(key types.TxKey) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if e, ok := r.requests[key]; ok {
+		delete(r.requests, key)
+		r.order.Remove(e)
+	}
+}
+
+// RemovePeer expires the requests sent to a peer that went down, and removes
+// the peer from the announcers of the other requests.
+func (r *txRequests) RemovePeer(peerID types.NodeID) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	for e := r.order.Front(); e != nil; e = e.Next() {
+		req := e.Value.(*txRequest)
+		if req.peerID == peerID {
+			req.expires = time.Time{}
+		}
+
+		for i, announcer := range req.announcers {
+			if announcer == peerID {
+				req.announcers = append(req.announcers[:i], req.announcers[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// Expire sends the expired requests to the next peer that announced their tx,
+// and returns the keys to request from each peer. Expired requests that no
+// other peer announced are removed.
+func (r *txRequests) Expire(now time.Time) map[types.NodeID][]types.TxKey {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	var want map[types.NodeID][]types.TxKey
+	for e := r.order.Front(); e != nil; {
+		next := e.Next()
+
+		req := e.Value.(*txRequest)
+		switch {
+		case now.Before(req.expires):
+
+		case len(req.announcers) == 0:
+			delete(r.requests, req.key)
+			r.order.Remove(e)
+
+		default:
+			req.peerID, req.announcers = req.announcers[0], req.announcers[1:]
+			req.expires = now.Add(r.timeout)
+
+			if want == nil {
+				want = make(map[types.NodeID][]types.TxKey)
+			}
+			want[req.peerID] = append(want[req.peerID], req.key)
+		}
+
+		e = next
+	}
+
+	return want
+}
+
+// addAnnouncer records that a peer, other than the one the tx is requested
+// from, announced the tx.
+func (req *txRequest) addAnnouncer(peerID types.NodeID) {
+	if peerID == req.peerID {
+		return
+	}
+
+	for _, announcer := range req.announcers {
+		if announcer == peerID {
+			return
+		}
+	}
+
+	req.announcers = append(req.announcers, peerID)
+}
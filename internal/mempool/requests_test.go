@@ -0,0 +1,63 @@
+package mempool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestTxRequests(t *testing.T) {
+	var (
+		now   = time.Now()
+		key   = types.Tx("tx").Key()
+		peerA = types.NodeID("aa")
+		peerB = types.NodeID("bb")
+		peerC = types.NodeID("cc")
+	)
+
+	reqs := newTxRequests(10, time.Second)
+
+	// the tx is only requested from the first peer announcing it
+	require.True(t, reqs.Request(key, peerA, now))
+	require.False(t, reqs.Request(key, peerB, now))
+	require.False(t, reqs.Request(key, peerC, now))
+	require.False(t, reqs.Request(key, peerB, now))
+	require.Empty(t, reqs.Expire(now))
+
+	// once the request expires, it is sent to the next peer that announced it
+	now = now.Add(time.Second)
+	require.Equal(t, map[types.NodeID][]types.TxKey{peerB: {key}}, reqs.Expire(now))
+	require.Empty(t, reqs.Expire(now))
+
+	// a peer going down expires the requests sent to it
+	reqs.RemovePeer(peerB)
+	require.Equal(t, map[types.NodeID][]types.TxKey{peerC: {key}}, reqs.Expire(now))
+
+	// an expired request that no other peer announced is removed, and the tx
+	// is requested again from the next peer announcing it
+	now = now.Add(time.Second)
+	require.Empty(t, reqs.Expire(now))
+	require.True(t, reqs.Request(key, peerA, now))
+
+	// a received tx is no longer requested
+	reqs.Received(key)
+	require.True(t, reqs.Request(key, peerB, now))
+}
+
+func TestTxRequests_Size(t *testing.T) {
+	now := time.Now()
+	reqs := newTxRequests(2, time.Second)
+
+	keys := []types.TxKey{types.Tx("a").Key(), types.Tx("b").Key(), types.Tx("c").Key()}
+	for _, key := range keys {
+		require.True(t, reqs.Request(key, "aa", now))
+	}
+	require.Len(t, reqs.requests, 2)
+
+	// the oldest request was forgotten
+	require.True(t, reqs.Request(keys[0], "bb", now))
+	require.False(t, reqs.Request(keys[2], "bb", now))
+}
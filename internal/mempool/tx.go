@@ -44,6 +44,22 @@ type WrappedTx struct {
 	// the ResponseCheckTx response.
 	sender string
 
+	// nonce defines the transaction's position in its sender's lane as specified
+	// by the application in the ResponseCheckTx response.
+	nonce uint64
+
+	// lanePriority and laneTimestamp define the transaction's position in the
+	// priority index. A transaction cannot be reaped before the transactions
+	// preceding it in its sender's lane, so it takes the lowest priority and the
+	// latest timestamp of the lane up to and including itself.
+	lanePriority  int64
+	laneTimestamp time.Time
+
+	// gapped marks a transaction that follows a missing nonce in its sender's
+	// lane. Gapped transactions are held back and never reaped until the gap is
+	// filled.
+	gapped bool
+
 	// timestamp is the time at which the node first received the transaction from
 	// a peer. It is used as a second dimension is prioritizing transactions when
 	// two transactions have the same priority.
@@ -78,13 +94,21 @@ func (wtx *WrappedTx) Size() int {
 type TxStore struct {
 	mtx       sync.RWMutex
 	hashTxs   map[types.TxKey]*WrappedTx // primary index
-	senderTxs map[string]*WrappedTx      // sender is defined by the ABCI application
+	senderTxs map[string][]*WrappedTx    // sender is defined by the ABCI application, sorted by nonce
+
+	// senderNonces defines the nonce expected at the head of each sender's lane.
+	senderNonces map[string]uint64
+
+	// gappedSenders defines the senders whose lane has a gap in its nonces.
+	gappedSenders map[string]struct{}
 }
 
 func NewTxStore() *TxStore {
 	return &TxStore{
-		senderTxs: make(map[string]*WrappedTx),
-		hashTxs:   make(map[types.TxKey]*WrappedTx),
+		senderTxs:     make(map[string][]*WrappedTx),
+		senderNonces:  make(map[string]uint64),
+		gappedSenders: make(map[string]struct{}),
+		hashTxs:       make(map[types.TxKey]*WrappedTx),
 	}
 }
 
@@ -111,13 +135,87 @@ func (txs *TxStore) GetAllTxs() []*WrappedTx {
 	return wTxs
 }
 
-// GetTxBySender returns a *WrappedTx by the transaction's sender property
-// defined by the ABCI application.
+// GetTxBySender returns the *WrappedTx with the lowest nonce by the
+// transaction's sender property defined by the ABCI application.
 func (txs *TxStore) GetTxBySender(sender string) *WrappedTx {
 	txs.mtx.RLock()
 	defer txs.mtx.RUnlock()
 
-	return txs.senderTxs[sender]
+	if lane := txs.senderTxs[sender]; len(lane) > 0 {
+		return lane[0]
+	}
+
+	return nil
+}
+
+// GetTxBySenderNonce returns a *WrappedTx by the transaction's sender and nonce
+// properties defined by the ABCI application.
+func (txs *TxStore) GetTxBySenderNonce(sender string, nonce uint64) *WrappedTx {
+	txs.mtx.RLock()
+	defer txs.mtx.RUnlock()
+
+	lane := txs.senderTxs[sender]
+	if i := searchNonce(lane, nonce); i < len(lane) && lane[i].nonce == nonce {
+		return lane[i]
+	}
+
+	return nil
+}
+
+// GetTxsBySender returns all the transactions of a sender in ascending nonce
+// order.
+func (txs *TxStore) GetTxsBySender(sender string) []*WrappedTx {
+	txs.mtx.RLock()
+	defer txs.mtx.RUnlock()
+
+	lane := make([]*WrappedTx, len(txs.senderTxs[sender]))
+	copy(lane, txs.senderTxs[sender])
+
+	return lane
+}
+
+// GetSenderNonce returns the nonce expected at the head of a sender's lane. A
+// lane starts at the nonce of its first transaction and is advanced as the
+// transactions of the sender are committed.
+func (txs *TxStore) GetSenderNonce(sender string) uint64 {
+	txs.mtx.RLock()
+	defer txs.mtx.RUnlock()
+
+	return txs.senderNonces[sender]
+}
+
+// SetSenderNonce sets the nonce expected at the head of a sender's lane. It is
+// a no-op if the sender has no transactions in the store.
+func (txs *TxStore) SetSenderNonce(sender string, nonce uint64) {
+	txs.mtx.Lock()
+	defer txs.mtx.Unlock()
+
+	if _, ok := txs.senderTxs[sender]; ok {
+		txs.senderNonces[sender] = nonce
+	}
+}
+
+// IsSenderGapped returns true if the lane of a sender has a gap in its nonces.
+func (txs *TxStore) IsSenderGapped(sender string) bool {
+	txs.mtx.RLock()
+	defer txs.mtx.RUnlock()
+
+	_, ok := txs.gappedSenders[sender]
+	return ok
+}
+
+// SetSenderGapped sets whether the lane of a sender has a gap in its nonces.
+// It is a no-op if the sender has no transactions in the store.
+func (txs *TxStore) SetSenderGapped(sender string, gapped bool) {
+	txs.mtx.Lock()
+	defer txs.mtx.Unlock()
+
+	if _, ok := txs.senderTxs[sender]; !ok || !gapped {
+		delete(txs.gappedSenders, sender)
+		return
+	}
+
+	txs.gappedSenders[sender] = struct{}{}
 }
 
 // GetTxByHash returns a *WrappedTx by the transaction's hash.
@@ -150,7 +248,22 @@ func (txs *TxStore) SetTx(wtx *WrappedTx) {
 	defer txs.mtx.Unlock()
 
 	if len(wtx.sender) > 0 {
-		txs.senderTxs[wtx.sender] = wtx
+		lane := txs.senderTxs[wtx.sender]
+		if nonce, ok := txs.senderNonces[wtx.sender]; !ok || wtx.nonce < nonce {
+			txs.senderNonces[wtx.sender] = wtx.nonce
+		}
+
+		i := searchNonce(lane, wtx.nonce)
+
+		if i < len(lane) && lane[i].nonce == wtx.nonce {
+			lane[i] = wtx
+		} else {
+			lane = append(lane, nil)
+			copy(lane[i+1:], lane[i:])
+			lane[i] = wtx
+		}
+
+		txs.senderTxs[wtx.sender] = lane
 	}
 
 	txs.hashTxs[wtx.tx.Key()] = wtx
@@ -163,7 +276,18 @@ func (txs *TxStore) RemoveTx(wtx *WrappedTx) {
 	defer txs.mtx.Unlock()
 
 	if len(wtx.sender) > 0 {
-		delete(txs.senderTxs, wtx.sender)
+		lane := txs.senderTxs[wtx.sender]
+		if i := searchNonce(lane, wtx.nonce); i < len(lane) && lane[i] == wtx {
+			lane = append(lane[:i], lane[i+1:]...)
+		}
+
+		if len(lane) == 0 {
+			delete(txs.senderTxs, wtx.sender)
+			delete(txs.senderNonces, wtx.sender)
+			delete(txs.gappedSenders, wtx.sender)
+		} else {
+			txs.senderTxs[wtx.sender] = lane
+		}
 	}
 
 	delete(txs.hashTxs, wtx.tx.Key())
@@ -211,6 +335,14 @@ func (txs *TxStore) GetOrSetPeerByTxHash(hash types.TxKey, peerID uint16) (*Wrap
 	return wtx, false
 }
 
+// searchNonce returns the index of the first transaction in a nonce-sorted lane
+// whose nonce is not less than the given nonce.
+func searchNonce(lane []*WrappedTx, nonce uint64) int {
+	return sort.Search(len(lane), func(i int) bool {
+		return lane[i].nonce >= nonce
+	})
+}
+
 // WrappedTxList implements a thread-safe list of *WrappedTx objects that can be
 // used to build generic transaction indexes in the mempool. It accepts a
 // comparator function, less(a, b *WrappedTx) bool, that compares two WrappedTx
@@ -30,6 +30,71 @@ func TestTxStore_GetTxBySender(t *testing.T) {
 	require.Equal(t, wtx, res)
 }
 
+func TestTxStore_GetTxsBySender(t *testing.T) {
+	txs := NewTxStore()
+
+	for _, nonce := range []uint64{5, 3, 4} {
+		txs.SetTx(&WrappedTx{
+			tx:        []byte(fmt.Sprintf("test_tx_%d", nonce)),
+			sender:    "foo",
+			nonce:     nonce,
+			timestamp: time.Now(),
+		})
+	}
+
+	lane := txs.GetTxsBySender("foo")
+	require.Len(t, lane, 3)
+	for i, wtx := range lane {
+		require.Equal(t, uint64(3+i), wtx.nonce)
+	}
+
+	require.Equal(t, lane[0], txs.GetTxBySender("foo"))
+	require.Equal(t, lane[1], txs.GetTxBySenderNonce("foo", 4))
+	require.Nil(t, txs.GetTxBySenderNonce("foo", 6))
+	require.Equal(t, uint64(3), txs.GetSenderNonce("foo"))
+
+	txs.SetSenderNonce("foo", 4)
+	txs.RemoveTx(lane[0])
+	require.Equal(t, lane[1], txs.GetTxBySender("foo"))
+	require.Equal(t, uint64(4), txs.GetSenderNonce("foo"))
+
+	txs.RemoveTx(lane[1])
+	txs.RemoveTx(lane[2])
+	require.Empty(t, txs.GetTxsBySender("foo"))
+	require.Nil(t, txs.GetTxBySender("foo"))
+	require.Zero(t, txs.GetSenderNonce("foo"))
+
+	// the sender nonce of an empty lane is not retained
+	txs.SetSenderNonce("foo", 7)
+	require.Zero(t, txs.GetSenderNonce("foo"))
+}
+
+func TestTxStore_SenderGapped(t *testing.T) {
+	txs := NewTxStore()
+	wtx := &WrappedTx{
+		tx:        []byte("test_tx"),
+		sender:    "foo",
+		priority:  1,
+		nonce:     2,
+		timestamp: time.Now(),
+	}
+
+	// a sender without transactions is never gapped
+	txs.SetSenderGapped("foo", true)
+	require.False(t, txs.IsSenderGapped("foo"))
+
+	txs.SetTx(wtx)
+	txs.SetSenderGapped("foo", true)
+	require.True(t, txs.IsSenderGapped("foo"))
+	txs.SetSenderGapped("foo", false)
+	require.False(t, txs.IsSenderGapped("foo"))
+
+	// removing the last transaction of a lane forgets its gap
+	txs.SetSenderGapped("foo", true)
+	txs.RemoveTx(wtx)
+	require.False(t, txs.IsSenderGapped("foo"))
+}
+
 func TestTxStore_GetTxByHash(t *testing.T) {
 	txs := NewTxStore()
 	wtx := &WrappedTx{
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/internal/p2p"
@@ -13,6 +14,11 @@ import (
 const (
 	MempoolChannel = p2p.ChannelID(0x30)
 
+	// MempoolAnnounceChannel carries the announcements of and requests for
+	// transaction keys. Peers that don't open it only receive full
+	// transactions on MempoolChannel.
+	MempoolAnnounceChannel = p2p.ChannelID(0x31)
+
 	// PeerCatchupSleepIntervalMS defines how much time to sleep if a peer is behind
 	PeerCatchupSleepIntervalMS = 100
 
@@ -36,6 +42,14 @@ type Mempool interface {
 	// from the mempool.
 	RemoveTxByKey(txKey types.TxKey) error
 
+	// GetTxByKey returns the transaction, identified by its key, if it is in
+	// the mempool.
+	GetTxByKey(txKey types.TxKey) (types.Tx, bool)
+
+	// ListTxs returns the pending transactions that match the filter, with
+	// their metadata, in the order they would be reaped.
+	ListTxs(filter TxFilter) []PendingTx
+
 	// ReapMaxBytesMaxGas reaps transactions from the mempool up to maxBytes
 	// bytes total with the condition that the total gasWanted must be less than
 	// maxGas.
@@ -99,6 +113,67 @@ type Mempool interface {
 	SizeBytes() int64
 }
 
+// TxFilter selects pending transactions by their metadata. An empty Sender
+// matches all senders and a nil bound is not checked. The age of a transaction
+// is the time elapsed since the mempool first received it.
+type TxFilter struct {
+	Sender       string
+	MinPriority  *int64
+	MaxPriority  *int64
+	MinGasWanted *int64
+	MaxGasWanted *int64
+	MinAge       *time.Duration
+	MaxAge       *time.Duration
+}
+
+// PendingTx is a pending transaction along with the metadata the mempool keeps
+// for it.
+type PendingTx struct {
+	Tx        types.Tx
+	Priority  int64
+	Sender    string
+	Nonce     uint64
+	GasWanted int64
+
+	// Height and Timestamp are the height at which the transaction was
+	// validated and the time at which it was first received.
+	Height    int64
+	Timestamp time.Time
+
+	// Gapped is true if the transaction follows a missing nonce in its
+	// sender's lane, in which case it is not reaped until the gap is filled.
+	Gapped bool
+}
+
+// matches returns true if wtx matches the filter at time now.
+func (f TxFilter) matches(wtx *WrappedTx, now time.Time) bool {
+	if f.Sender != "" && wtx.sender != f.Sender {
+		return false
+	}
+	if f.MinPriority != nil && wtx.priority < *f.MinPriority {
+		return false
+	}
+	if f.MaxPriority != nil && wtx.priority > *f.MaxPriority {
+		return false
+	}
+	if f.MinGasWanted != nil && wtx.gasWanted < *f.MinGasWanted {
+		return false
+	}
+	if f.MaxGasWanted != nil && wtx.gasWanted > *f.MaxGasWanted {
+		return false
+	}
+
+	age := now.Sub(wtx.timestamp)
+	if f.MinAge != nil && age < *f.MinAge {
+		return false
+	}
+	if f.MaxAge != nil && age > *f.MaxAge {
+		return false
+	}
+
+	return true
+}
+
 // PreCheckFunc is an optional filter executed before CheckTx and rejects
 // transaction if false is returned. An example would be to ensure that a
 // transaction doesn't exceeded the block size.
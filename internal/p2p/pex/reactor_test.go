@@ -670,10 +670,8 @@ func (r *reactorTestSuite) connectPeers(ctx context.Context, t *testing.T, sourc
 
 	select {
 	case peerUpdate := <-targetSub.Updates():
-		require.Equal(t, p2p.PeerUpdate{
-			NodeID: node1,
-			Status: p2p.PeerStatusUp,
-		}, peerUpdate)
+		require.Equal(t, node1, peerUpdate.NodeID)
+		require.Equal(t, p2p.PeerStatusUp, peerUpdate.Status)
 		r.logger.Debug("target connected with source")
 	case <-time.After(2 * time.Second):
 		require.Fail(t, "timed out waiting for peer", "%v accepting %v",
@@ -682,10 +680,8 @@ func (r *reactorTestSuite) connectPeers(ctx context.Context, t *testing.T, sourc
 
 	select {
 	case peerUpdate := <-sourceSub.Updates():
-		require.Equal(t, p2p.PeerUpdate{
-			NodeID: node2,
-			Status: p2p.PeerStatusUp,
-		}, peerUpdate)
+		require.Equal(t, node2, peerUpdate.NodeID)
+		require.Equal(t, p2p.PeerStatusUp, peerUpdate.Status)
 		r.logger.Debug("source connected with target")
 	case <-time.After(2 * time.Second):
 		require.Fail(t, "timed out waiting for peer", "%v dialing %v",
@@ -92,7 +92,7 @@ func TestRouter_Network(t *testing.T) {
 	}))
 	p2ptest.RequireUpdates(t, peerUpdates, []p2p.PeerUpdate{
 		{NodeID: peers[0].NodeID, Status: p2p.PeerStatusDown},
-		{NodeID: peers[0].NodeID, Status: p2p.PeerStatusUp},
+		{NodeID: peers[0].NodeID, Status: p2p.PeerStatusUp, Channels: p2p.ChannelIDSet{chID: {}}},
 	})
 }
 
@@ -347,7 +347,7 @@ func TestRouter_Channel_Error(t *testing.T) {
 	p2ptest.RequireError(ctx, t, a, p2p.PeerError{NodeID: bID, Err: errors.New("boom")})
 	p2ptest.RequireUpdates(t, sub, []p2p.PeerUpdate{
 		{NodeID: bID, Status: p2p.PeerStatusDown},
-		{NodeID: bID, Status: p2p.PeerStatusUp},
+		{NodeID: bID, Status: p2p.PeerStatusUp, Channels: p2p.ChannelIDSet{chID: {}}},
 	})
 }
 
@@ -425,8 +425,9 @@ func TestRouter_AcceptPeers(t *testing.T) {
 
 			if tc.ok {
 				p2ptest.RequireUpdate(t, sub, p2p.PeerUpdate{
-					NodeID: tc.peerInfo.NodeID,
-					Status: p2p.PeerStatusUp,
+					NodeID:   tc.peerInfo.NodeID,
+					Status:   p2p.PeerStatusUp,
+					Channels: p2p.ChannelIDSet{0x01: {}, 0x02: {}},
 				})
 				// force a context switch so that the
 				// connection is handled.
@@ -673,8 +674,9 @@ func TestRouter_DialPeers(t *testing.T) {
 
 			if tc.ok {
 				p2ptest.RequireUpdate(t, sub, p2p.PeerUpdate{
-					NodeID: tc.peerInfo.NodeID,
-					Status: p2p.PeerStatusUp,
+					NodeID:   tc.peerInfo.NodeID,
+					Status:   p2p.PeerStatusUp,
+					Channels: p2p.ChannelIDSet{0x01: {}, 0x02: {}},
 				})
 				// force a context switch so that the
 				// connection is handled.
@@ -834,8 +836,9 @@ func TestRouter_EvictPeers(t *testing.T) {
 
 	// Wait for the mock peer to connect, then evict it by reporting an error.
 	p2ptest.RequireUpdate(t, sub, p2p.PeerUpdate{
-		NodeID: peerInfo.NodeID,
-		Status: p2p.PeerStatusUp,
+		NodeID:   peerInfo.NodeID,
+		Status:   p2p.PeerStatusUp,
+		Channels: p2p.ChannelIDSet{0x01: {}, 0x02: {}},
 	})
 
 	peerManager.Errored(peerInfo.NodeID, errors.New("boom"))
@@ -952,8 +955,9 @@ func TestRouter_DontSendOnInvalidChannel(t *testing.T) {
 	require.NoError(t, router.Start(ctx))
 
 	p2ptest.RequireUpdate(t, sub, p2p.PeerUpdate{
-		NodeID: peerInfo.NodeID,
-		Status: p2p.PeerStatusUp,
+		NodeID:   peerInfo.NodeID,
+		Status:   p2p.PeerStatusUp,
+		Channels: p2p.ChannelIDSet{0x02: {}},
 	})
 
 	channel, err := router.OpenChannel(ctx, chDesc)
@@ -1,9 +1,14 @@
 package core
 
 import (
+	"errors"
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/bytes"
 	tmmath "github.com/tendermint/tendermint/libs/math"
 	"github.com/tendermint/tendermint/rpc/coretypes"
 	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+	"github.com/tendermint/tendermint/types"
 )
 
 // Validators gets the validator set at the given block height.
@@ -47,6 +52,54 @@ func (env *Environment) Validators(
 		Total:       totalCount}, nil
 }
 
+// SigningInfo gets the signing history of the validator with the
+// given address over the last window commits, including the first missed
+// height and the uptime percentage. If no window is provided, the signing
+// window of the node is used.
+// More: https://docs.tendermint.com/master/rpc/#/Info/signing_info
+func (env *Environment) SigningInfo(
+	ctx *rpctypes.Context,
+	address bytes.HexBytes,
+	windowPtr *int64,
+) (*coretypes.ResultSigningInfo, error) {
+	if env.SigningIndex == nil {
+		return nil, errors.New("validator signing history is disabled on this node")
+	}
+	if len(address) == 0 {
+		return nil, fmt.Errorf("%w: no validator address was provided", coretypes.ErrInvalidRequest)
+	}
+
+	var window int64
+	if windowPtr != nil {
+		window = *windowPtr
+	}
+
+	info, err := env.SigningIndex.SigningInfo(types.Address(address), window)
+	if err != nil {
+		return nil, err
+	}
+	if len(info.Commits) == 0 {
+		return nil, fmt.Errorf("validator %X not found in the commits from height %d to %d",
+			address, info.FromHeight, info.ToHeight)
+	}
+
+	commits := make([]*coretypes.SignedCommit, 0, len(info.Commits))
+	for _, c := range info.Commits {
+		commits = append(commits, &coretypes.SignedCommit{Height: c.Height, Signed: c.Signed})
+	}
+
+	return &coretypes.ResultSigningInfo{
+		Address:           address,
+		FromHeight:        info.FromHeight,
+		ToHeight:          info.ToHeight,
+		Commits:           commits,
+		SignedCount:       info.SignedCount,
+		MissedCount:       info.MissedCount,
+		FirstMissedHeight: info.FirstMissedHeight,
+		Uptime:            info.Uptime(),
+	}, nil
+}
+
 // DumpConsensusState dumps consensus state.
 // UNSTABLE
 // More: https://docs.tendermint.com/master/rpc/#/Info/dump_consensus_state
@@ -97,6 +150,35 @@ func (env *Environment) GetConsensusState(ctx *rpctypes.Context) (*coretypes.Res
 	return &coretypes.ResultConsensusState{RoundState: bz}, err
 }
 
+// ConsensusTimeline returns what happened to the consensus state machine at
+// the given height: the step transitions, the arrival of the proposal, its
+// block parts and the votes, and the timeouts that fired. Only the timelines of
+// the last heights are kept. If no height is provided, the timeline of the
+// current height is returned.
+// UNSTABLE
+// More: https://docs.tendermint.com/master/rpc/#/Info/consensus_timeline
+func (env *Environment) ConsensusTimeline(
+	ctx *rpctypes.Context,
+	heightPtr *int64) (*coretypes.ResultConsensusTimeline, error) {
+
+	var height int64
+	if heightPtr != nil {
+		height = *heightPtr
+		if height <= 0 {
+			return nil, fmt.Errorf("%w (requested height: %d)", coretypes.ErrZeroOrNegativeHeight, height)
+		}
+	}
+
+	bz, err := env.ConsensusState.GetTimelineJSON(height)
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, fmt.Errorf("no consensus timeline kept for height %d", height)
+	}
+	return &coretypes.ResultConsensusTimeline{Timeline: bz}, nil
+}
+
 // ConsensusParams gets the consensus parameters at the given block height.
 // If no height is provided, it will fetch the latest consensus params.
 // More: https://docs.tendermint.com/master/rpc/#/Info/consensus_params
@@ -35,11 +35,17 @@ Endpoints that require arguments:
 /broadcast_tx_commit?tx=_
 /broadcast_tx_sync?tx=_
 /commit?height=_
+/committed_evidence?min_height=_&max_height=_&page=_&per_page=_
+/consensus_timeline?height=_
 /dial_seeds?seeds=_
 /dial_persistent_peers?persistent_peers=_
+/evidence?hash=_
+/mempool_txs?sender=_&min_priority=_&max_priority=_&min_gas_wanted=_&max_gas_wanted=_&min_age=_&max_age=_&page=_&per_page=_
+/pending_evidence?page=_&per_page=_
 /subscribe?event=_
 /tx?hash=_&prove=_
 /unsubscribe?event=_
+/signing_info?address=_&window=_
 ```
 */
 package core
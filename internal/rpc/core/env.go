@@ -9,12 +9,14 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/internal/consensus"
 	"github.com/tendermint/tendermint/internal/eventbus"
+	"github.com/tendermint/tendermint/internal/evidence"
 	"github.com/tendermint/tendermint/internal/mempool"
 	"github.com/tendermint/tendermint/internal/p2p"
 	"github.com/tendermint/tendermint/internal/proxy"
 	sm "github.com/tendermint/tendermint/internal/state"
 	"github.com/tendermint/tendermint/internal/state/indexer"
 	"github.com/tendermint/tendermint/internal/statesync"
+	"github.com/tendermint/tendermint/internal/store"
 	tmjson "github.com/tendermint/tendermint/libs/json"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/rpc/coretypes"
@@ -44,6 +46,17 @@ type consensusState interface {
 	GetLastHeight() int64
 	GetRoundStateJSON() ([]byte, error)
 	GetRoundStateSimpleJSON() ([]byte, error)
+	GetTimelineJSON(height int64) ([]byte, error)
+}
+
+type evidencePool interface {
+	sm.EvidencePool
+	CommittedEvidence(hash []byte) (*evidence.CommittedEvidence, error)
+	ListCommittedEvidence(minHeight, maxHeight int64) ([]*evidence.CommittedEvidence, error)
+}
+
+type signingIndex interface {
+	SigningInfo(address types.Address, window int64) (*store.SigningInfo, error)
 }
 
 type transport interface {
@@ -73,7 +86,8 @@ type Environment struct {
 	// interfaces defined in types and above
 	StateStore       sm.Store
 	BlockStore       sm.BlockStore
-	EvidencePool     sm.EvidencePool
+	EvidencePool     evidencePool
+	SigningIndex     signingIndex
 	ConsensusState   consensusState
 	ConsensusReactor consensusReactor
 
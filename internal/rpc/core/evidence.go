@@ -3,6 +3,8 @@ package core
 import (
 	"fmt"
 
+	"github.com/tendermint/tendermint/libs/bytes"
+	tmmath "github.com/tendermint/tendermint/libs/math"
 	"github.com/tendermint/tendermint/rpc/coretypes"
 	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
 	"github.com/tendermint/tendermint/types"
@@ -27,3 +29,106 @@ func (env *Environment) BroadcastEvidence(
 	}
 	return &coretypes.ResultBroadcastEvidence{Hash: ev.Hash()}, nil
 }
+
+// PendingEvidence lists the evidence (maximum ?per_page entries) that was
+// verified but not yet committed, along with the validators it punishes and
+// the total count.
+// More: https://docs.tendermint.com/master/rpc/#/Evidence/pending_evidence
+func (env *Environment) PendingEvidence(
+	ctx *rpctypes.Context,
+	pagePtr, perPagePtr *int,
+) (*coretypes.ResultEvidenceList, error) {
+	evList, _ := env.EvidencePool.PendingEvidence(-1)
+
+	results := make([]*coretypes.ResultEvidence, 0, len(evList))
+	for _, ev := range evList {
+		results = append(results, makeResultEvidence(ev, 0))
+	}
+
+	return env.paginateEvidence(results, pagePtr, perPagePtr)
+}
+
+// Evidence gets the committed evidence with the given hash, along with the
+// validators it punishes and the height of the block it was committed in.
+// More: https://docs.tendermint.com/master/rpc/#/Evidence/evidence
+func (env *Environment) Evidence(ctx *rpctypes.Context, hash bytes.HexBytes) (*coretypes.ResultEvidence, error) {
+	ev, err := env.EvidencePool.CommittedEvidence(hash)
+	if err != nil {
+		return nil, err
+	}
+	if ev == nil {
+		return nil, fmt.Errorf("committed evidence (%X) not found", hash)
+	}
+
+	return makeResultEvidence(ev.Evidence, ev.Height), nil
+}
+
+// CommittedEvidence lists the evidence (maximum ?per_page entries) committed
+// in blocks from minHeight to maxHeight inclusive, along with the validators
+// it punishes and the total count. If maxHeight is not set, it defaults to the
+// latest height.
+// More: https://docs.tendermint.com/master/rpc/#/Evidence/committed_evidence
+func (env *Environment) CommittedEvidence(
+	ctx *rpctypes.Context,
+	minHeight, maxHeight int64,
+	pagePtr, perPagePtr *int,
+) (*coretypes.ResultEvidenceList, error) {
+	height := env.BlockStore.Height()
+	minHeight, maxHeight, err := filterMinMax(1, height, minHeight, maxHeight, height)
+	if err != nil {
+		return nil, err
+	}
+
+	evList, err := env.EvidencePool.ListCommittedEvidence(minHeight, maxHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*coretypes.ResultEvidence, 0, len(evList))
+	for _, ev := range evList {
+		results = append(results, makeResultEvidence(ev.Evidence, ev.Height))
+	}
+
+	return env.paginateEvidence(results, pagePtr, perPagePtr)
+}
+
+func (env *Environment) paginateEvidence(
+	results []*coretypes.ResultEvidence,
+	pagePtr, perPagePtr *int,
+) (*coretypes.ResultEvidenceList, error) {
+	totalCount := len(results)
+	perPage := env.validatePerPage(perPagePtr)
+
+	page, err := validatePage(pagePtr, perPage, totalCount)
+	if err != nil {
+		return nil, err
+	}
+
+	skipCount := validateSkipCount(page, perPage)
+	pageSize := tmmath.MinInt(perPage, totalCount-skipCount)
+
+	return &coretypes.ResultEvidenceList{
+		Evidence:   results[skipCount : skipCount+pageSize],
+		TotalCount: totalCount,
+	}, nil
+}
+
+// makeResultEvidence collects the byzantine validators and total voting power
+// of the evidence from the misbehavior it reports to the application.
+func makeResultEvidence(ev types.Evidence, height int64) *coretypes.ResultEvidence {
+	result := &coretypes.ResultEvidence{
+		Hash:     ev.Hash(),
+		Evidence: ev,
+		Height:   height,
+	}
+
+	for _, mb := range ev.ABCI() {
+		result.ByzantineValidators = append(result.ByzantineValidators, &coretypes.ByzantineValidator{
+			Address: mb.Validator.Address,
+			Power:   mb.Validator.Power,
+		})
+		result.TotalVotingPower = mb.TotalVotingPower
+	}
+
+	return result
+}
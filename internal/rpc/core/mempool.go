@@ -9,6 +9,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/internal/mempool"
 	"github.com/tendermint/tendermint/internal/state/indexer"
+	tmmath "github.com/tendermint/tendermint/libs/math"
 	"github.com/tendermint/tendermint/rpc/coretypes"
 	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
 	"github.com/tendermint/tendermint/types"
@@ -132,6 +133,67 @@ func (env *Environment) UnconfirmedTxs(ctx *rpctypes.Context, limitPtr *int) (*c
 		Txs:        txs}, nil
 }
 
+// MempoolTxs lists the unconfirmed transactions (maximum ?per_page entries)
+// that match the given sender, priority range, gas wanted range and age range
+// in seconds, along with their metadata and total count. Transactions are
+// listed in the order they would be reaped.
+// More: https://docs.tendermint.com/master/rpc/#/Info/mempool_txs
+func (env *Environment) MempoolTxs(
+	ctx *rpctypes.Context,
+	sender string,
+	minPriority, maxPriority *int64,
+	minGasWanted, maxGasWanted *int64,
+	minAge, maxAge *int64,
+	pagePtr, perPagePtr *int,
+) (*coretypes.ResultMempoolTxs, error) {
+	filter := mempool.TxFilter{
+		Sender:       sender,
+		MinPriority:  minPriority,
+		MaxPriority:  maxPriority,
+		MinGasWanted: minGasWanted,
+		MaxGasWanted: maxGasWanted,
+	}
+	if minAge != nil {
+		d := time.Duration(*minAge) * time.Second
+		filter.MinAge = &d
+	}
+	if maxAge != nil {
+		d := time.Duration(*maxAge) * time.Second
+		filter.MaxAge = &d
+	}
+
+	txs := env.Mempool.ListTxs(filter)
+
+	// paginate results
+	totalCount := len(txs)
+	perPage := env.validatePerPage(perPagePtr)
+
+	page, err := validatePage(pagePtr, perPage, totalCount)
+	if err != nil {
+		return nil, err
+	}
+
+	skipCount := validateSkipCount(page, perPage)
+	pageSize := tmmath.MinInt(perPage, totalCount-skipCount)
+
+	apiResults := make([]*coretypes.ResultMempoolTx, 0, pageSize)
+	for _, ptx := range txs[skipCount : skipCount+pageSize] {
+		apiResults = append(apiResults, &coretypes.ResultMempoolTx{
+			Hash:      ptx.Tx.Hash(),
+			Tx:        ptx.Tx,
+			Priority:  ptx.Priority,
+			Sender:    ptx.Sender,
+			Nonce:     ptx.Nonce,
+			GasWanted: ptx.GasWanted,
+			Height:    ptx.Height,
+			Timestamp: ptx.Timestamp,
+			Gapped:    ptx.Gapped,
+		})
+	}
+
+	return &coretypes.ResultMempoolTxs{Txs: apiResults, TotalCount: totalCount}, nil
+}
+
 // NumUnconfirmedTxs gets number of unconfirmed transactions.
 // More: https://docs.tendermint.com/master/rpc/#/Info/num_unconfirmed_txs
 func (env *Environment) NumUnconfirmedTxs(ctx *rpctypes.Context) (*coretypes.ResultUnconfirmedTxs, error) {
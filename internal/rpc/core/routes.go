@@ -35,11 +35,14 @@ func (env *Environment) GetRoutes() RoutesMap {
 		"tx_search":            rpc.NewRPCFunc(env.TxSearch, "query,prove,page,per_page,order_by", false),
 		"block_search":         rpc.NewRPCFunc(env.BlockSearch, "query,page,per_page,order_by", false),
 		"validators":           rpc.NewRPCFunc(env.Validators, "height,page,per_page", true),
+		"signing_info":         rpc.NewRPCFunc(env.SigningInfo, "address,window", false),
 		"dump_consensus_state": rpc.NewRPCFunc(env.DumpConsensusState, "", false),
 		"consensus_state":      rpc.NewRPCFunc(env.GetConsensusState, "", false),
+		"consensus_timeline":   rpc.NewRPCFunc(env.ConsensusTimeline, "height", false),
 		"consensus_params":     rpc.NewRPCFunc(env.ConsensusParams, "height", true),
 		"unconfirmed_txs":      rpc.NewRPCFunc(env.UnconfirmedTxs, "limit", false),
 		"num_unconfirmed_txs":  rpc.NewRPCFunc(env.NumUnconfirmedTxs, "", false),
+		"mempool_txs":          rpc.NewRPCFunc(env.MempoolTxs, "sender,min_priority,max_priority,min_gas_wanted,max_gas_wanted,min_age,max_age,page,per_page", false),
 
 		// tx broadcast API
 		"broadcast_tx_commit": rpc.NewRPCFunc(env.BroadcastTxCommit, "tx", false),
@@ -52,6 +55,9 @@ func (env *Environment) GetRoutes() RoutesMap {
 
 		// evidence API
 		"broadcast_evidence": rpc.NewRPCFunc(env.BroadcastEvidence, "evidence", false),
+		"pending_evidence":   rpc.NewRPCFunc(env.PendingEvidence, "page,per_page", false),
+		"evidence":           rpc.NewRPCFunc(env.Evidence, "hash", true),
+		"committed_evidence": rpc.NewRPCFunc(env.CommittedEvidence, "min_height,max_height,page,per_page", false),
 	}
 }
 
@@ -120,14 +120,20 @@ func (env *Environment) TxSearch(
 			skipCount := validateSkipCount(page, perPage)
 			pageSize := tmmath.MinInt(perPage, totalCount-skipCount)
 
-			apiResults := make([]*coretypes.ResultTx, 0, pageSize)
+			var (
+				apiResults = make([]*coretypes.ResultTx, 0, pageSize)
+				heights    []int64
+				indices    = make(map[int64][]int)
+			)
 			for i := skipCount; i < skipCount+pageSize; i++ {
 				r := results[i]
 
-				var proof types.TxProof
+				// the txs of a height are proven together
 				if prove {
-					block := env.BlockStore.LoadBlock(r.Height)
-					proof = block.Data.Txs.Proof(int(r.Index)) // XXX: overflow on 32-bit machines
+					if _, ok := indices[r.Height]; !ok {
+						heights = append(heights, r.Height)
+					}
+					indices[r.Height] = append(indices[r.Height], int(r.Index)) // XXX: overflow on 32-bit machines
 				}
 
 				apiResults = append(apiResults, &coretypes.ResultTx{
@@ -136,11 +142,23 @@ func (env *Environment) TxSearch(
 					Index:    r.Index,
 					TxResult: r.Result,
 					Tx:       r.Tx,
-					Proof:    proof,
 				})
 			}
 
-			return &coretypes.ResultTxSearch{Txs: apiResults, TotalCount: totalCount}, nil
+			var proofs []coretypes.ResultTxProof
+			for _, height := range heights {
+				block := env.BlockStore.LoadBlock(height)
+				if block == nil {
+					return nil, fmt.Errorf("block at height %d not found", height)
+				}
+				proof, err := block.Data.Txs.MultiProof(indices[height])
+				if err != nil {
+					return nil, err
+				}
+				proofs = append(proofs, coretypes.ResultTxProof{Height: height, Proof: proof})
+			}
+
+			return &coretypes.ResultTxSearch{Txs: apiResults, TotalCount: totalCount, Proofs: proofs}, nil
 		}
 	}
 
@@ -48,6 +48,10 @@ type (
 	ErrNoABCIResponsesForHeight struct {
 		Height int64
 	}
+
+	ErrLegacyABCIResponses struct {
+		Height int64
+	}
 )
 
 func (e ErrUnknownBlock) Error() string {
@@ -99,3 +103,7 @@ func (e ErrNoConsensusParamsForHeight) Error() string {
 func (e ErrNoABCIResponsesForHeight) Error() string {
 	return fmt.Sprintf("could not find results for height #%d", e.Height)
 }
+
+func (e ErrLegacyABCIResponses) Error() string {
+	return fmt.Sprintf("results for height #%d were saved before FinalizeBlock and can't be read", e.Height)
+}
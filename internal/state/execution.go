@@ -2,7 +2,6 @@ package state
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
@@ -97,13 +96,18 @@ func (blockExec *BlockExecutor) SetEventBus(eventBus types.BlockEventPublisher)
 }
 
 // CreateProposalBlock calls state.MakeBlock with evidence from the evpool
-// and txs from the mempool. The max bytes must be big enough to fit the commit.
+// and txs from the mempool, as modified by the application's PrepareProposal.
+// votes are the precommits of the last commit indexed by validator index, if
+// they are available; their vote extensions are passed on to the application.
+// The max bytes must be big enough to fit the commit.
 // Up to 1/10th of the block space is allcoated for maximum sized evidence.
 // The rest is given to txs, up to the max gas.
 func (blockExec *BlockExecutor) CreateProposalBlock(
+	ctx context.Context,
 	height int64,
 	state State, commit *types.Commit,
 	proposerAddr []byte,
+	votes []*types.Vote,
 ) (*types.Block, *types.PartSet, error) {
 
 	maxBytes := state.ConsensusParams.Block.MaxBytes
@@ -116,7 +120,115 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 
 	txs := blockExec.mempool.ReapMaxBytesMaxGas(maxDataBytes, maxGas)
 
-	return state.MakeBlock(height, txs, commit, evidence, proposerAddr)
+	commitInfo := buildLastCommitInfo(height, commit, blockExec.store, state.InitialHeight)
+
+	// The proposer sets the time of the block to its local time.
+	blockTime := state.blockTime(height)
+
+	rpp, err := blockExec.proxyApp.PrepareProposalSync(
+		ctx,
+		abci.RequestPrepareProposal{
+			MaxTxBytes:          maxDataBytes,
+			Txs:                 txs.ToSliceOfBytes(),
+			LocalLastCommit:     buildExtendedCommitInfo(commitInfo, votes),
+			ByzantineValidators: types.EvidenceList(evidence).ToABCI(),
+			Height:              height,
+			Time:                blockTime,
+			NextValidatorsHash:  state.NextValidators.Hash(),
+			ProposerAddress:     proposerAddr,
+		},
+	)
+	if err != nil {
+		// The application is expected to only return transactions it can
+		// process, so there is no meaningful way to recover from an error
+		// here other than to skip proposing this block.
+		return nil, nil, err
+	}
+
+	newTxs := types.ToTxs(rpp.Txs)
+	if size := types.ComputeProtoSizeForTxs(newTxs); size > maxDataBytes {
+		return nil, nil, fmt.Errorf("transaction data size %d exceeds maximum %d", size, maxDataBytes)
+	}
+
+	// the last commit of a validator set of BLS12-381 keys is included with its
+	// signatures aggregated.
+	commit, err = types.AggregateCommit(commit, state.LastValidators)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return state.makeBlock(height, blockTime, newTxs, commit, evidence, proposerAddr)
+}
+
+// ProcessProposal asks the application whether the proposed block should be
+// accepted. It returns false if the application rejects the block.
+func (blockExec *BlockExecutor) ProcessProposal(
+	ctx context.Context,
+	block *types.Block,
+	state State,
+) (bool, error) {
+	resp, err := blockExec.proxyApp.ProcessProposalSync(
+		ctx,
+		abci.RequestProcessProposal{
+			Txs:                 block.Data.Txs.ToSliceOfBytes(),
+			ProposedLastCommit:  buildLastCommitInfo(block.Height, block.LastCommit, blockExec.store, state.InitialHeight),
+			ByzantineValidators: block.Evidence.Evidence.ToABCI(),
+			Hash:                block.Header.Hash(),
+			Height:              block.Header.Height,
+			Time:                block.Header.Time,
+			NextValidatorsHash:  block.NextValidatorsHash,
+			ProposerAddress:     block.ProposerAddress,
+		},
+	)
+	if err != nil {
+		return false, ErrInvalidBlock(err)
+	}
+	if resp.IsStatusUnknown() {
+		return false, fmt.Errorf("ProcessProposal responded with status %s", resp.Status.String())
+	}
+
+	return resp.IsAccepted(), nil
+}
+
+// ExtendVote asks the application for the vote extension to attach to the
+// given precommit.
+func (blockExec *BlockExecutor) ExtendVote(ctx context.Context, vote *types.Vote) ([]byte, error) {
+	resp, err := blockExec.proxyApp.ExtendVoteSync(
+		ctx,
+		abci.RequestExtendVote{
+			Hash:   vote.BlockID.Hash,
+			Height: vote.Height,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resp.VoteExtension, nil
+}
+
+// VerifyVoteExtension asks the application whether the vote extension of the
+// given precommit is valid. It returns types.ErrVoteInvalidExtension if the
+// application rejects it.
+func (blockExec *BlockExecutor) VerifyVoteExtension(ctx context.Context, vote *types.Vote) error {
+	resp, err := blockExec.proxyApp.VerifyVoteExtensionSync(
+		ctx,
+		abci.RequestVerifyVoteExtension{
+			Hash:             vote.BlockID.Hash,
+			ValidatorAddress: vote.ValidatorAddress,
+			Height:           vote.Height,
+			VoteExtension:    vote.Extension,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if resp.IsStatusUnknown() {
+		return fmt.Errorf("VerifyVoteExtension responded with status %s", resp.Status.String())
+	}
+	if !resp.IsOK() {
+		return types.ErrVoteInvalidExtension
+	}
+	return nil
 }
 
 // ValidateBlock validates the given block against the given state.
@@ -181,7 +293,7 @@ func (blockExec *BlockExecutor) ApplyBlock(
 	fail.Fail() // XXX
 
 	// validate the validator updates and convert to tendermint types
-	abciValUpdates := abciResponses.EndBlock.ValidatorUpdates
+	abciValUpdates := abciResponses.FinalizeBlock.ValidatorUpdates
 	err = validateValidatorUpdates(abciValUpdates, state.ConsensusParams.Validator)
 	if err != nil {
 		return state, fmt.Errorf("error in validator updates: %w", err)
@@ -202,7 +314,7 @@ func (blockExec *BlockExecutor) ApplyBlock(
 	}
 
 	// Lock mempool, commit app state, update mempoool.
-	appHash, retainHeight, err := blockExec.Commit(ctx, state, block, abciResponses.DeliverTxs)
+	appHash, retainHeight, err := blockExec.Commit(ctx, state, block, abciResponses.FinalizeBlock.TxResults)
 	if err != nil {
 		return state, fmt.Errorf("commit failed for application: %w", err)
 	}
@@ -304,87 +416,57 @@ func execBlockOnProxyApp(
 	store Store,
 	initialHeight int64,
 ) (*tmstate.ABCIResponses, error) {
-	var validTxs, invalidTxs = 0, 0
-
-	txIndex := 0
-	abciResponses := new(tmstate.ABCIResponses)
-	dtxs := make([]*abci.ResponseDeliverTx, len(block.Txs))
-	abciResponses.DeliverTxs = dtxs
-
-	// Execute transactions and get hash.
-	proxyCb := func(req *abci.Request, res *abci.Response) {
-		if r, ok := res.Value.(*abci.Response_DeliverTx); ok {
-			// TODO: make use of res.Log
-			// TODO: make use of this info
-			// Blocks may include invalid txs.
-			txRes := r.DeliverTx
-			if txRes.Code == abci.CodeTypeOK {
-				validTxs++
-			} else {
-				logger.Debug("invalid tx", "code", txRes.Code, "log", txRes.Log)
-				invalidTxs++
-			}
-
-			abciResponses.DeliverTxs[txIndex] = txRes
-			txIndex++
-		}
-	}
-	proxyAppConn.SetResponseCallback(proxyCb)
-
-	commitInfo := getBeginBlockValidatorInfo(block, store, initialHeight)
-
-	byzVals := make([]abci.Evidence, 0)
-	for _, evidence := range block.Evidence.Evidence {
-		byzVals = append(byzVals, evidence.ABCI()...)
-	}
-
-	// Begin block
-	var err error
-	pbh := block.Header.ToProto()
-	if pbh == nil {
-		return nil, errors.New("nil header")
-	}
-
-	abciResponses.BeginBlock, err = proxyAppConn.BeginBlockSync(
+	finalizeBlock, err := proxyAppConn.FinalizeBlockSync(
 		ctx,
-		abci.RequestBeginBlock{
+		abci.RequestFinalizeBlock{
+			Txs:                 block.Data.Txs.ToSliceOfBytes(),
+			DecidedLastCommit:   buildLastCommitInfo(block.Height, block.LastCommit, store, initialHeight),
+			ByzantineValidators: block.Evidence.Evidence.ToABCI(),
 			Hash:                block.Hash(),
-			Header:              *pbh,
-			LastCommitInfo:      commitInfo,
-			ByzantineValidators: byzVals,
+			Height:              block.Header.Height,
+			Time:                block.Header.Time,
+			NextValidatorsHash:  block.NextValidatorsHash,
+			ProposerAddress:     block.ProposerAddress,
 		},
 	)
 	if err != nil {
-		logger.Error("error in proxyAppConn.BeginBlock", "err", err)
+		logger.Error("error in proxyAppConn.FinalizeBlock", "err", err)
 		return nil, err
 	}
 
-	// run txs of block
-	for _, tx := range block.Txs {
-		_, err = proxyAppConn.DeliverTxAsync(ctx, abci.RequestDeliverTx{Tx: tx})
-		if err != nil {
-			return nil, err
-		}
+	// The app must return exactly one result per transaction, in block order.
+	if len(finalizeBlock.TxResults) != len(block.Txs) {
+		return nil, fmt.Errorf("expected %d tx results from FinalizeBlock, got %d",
+			len(block.Txs), len(finalizeBlock.TxResults))
 	}
 
-	abciResponses.EndBlock, err = proxyAppConn.EndBlockSync(ctx, abci.RequestEndBlock{Height: block.Height})
-	if err != nil {
-		logger.Error("error in proxyAppConn.EndBlock", "err", err)
-		return nil, err
+	// TODO: make use of res.Log
+	// TODO: make use of this info
+	// Blocks may include invalid txs.
+	var validTxs, invalidTxs = 0, 0
+	for _, txRes := range finalizeBlock.TxResults {
+		if txRes.Code == abci.CodeTypeOK {
+			validTxs++
+		} else {
+			logger.Debug("invalid tx", "code", txRes.Code, "log", txRes.Log)
+			invalidTxs++
+		}
 	}
 
 	logger.Info("executed block", "height", block.Height, "num_valid_txs", validTxs, "num_invalid_txs", invalidTxs)
-	return abciResponses, nil
+	return &tmstate.ABCIResponses{FinalizeBlock: finalizeBlock}, nil
 }
 
-func getBeginBlockValidatorInfo(block *types.Block, store Store,
+// buildLastCommitInfo returns the ABCI representation of the commit for the
+// block preceding the given height.
+func buildLastCommitInfo(height int64, commit *types.Commit, store Store,
 	initialHeight int64) abci.LastCommitInfo {
-	voteInfos := make([]abci.VoteInfo, block.LastCommit.Size())
+	voteInfos := make([]abci.VoteInfo, commit.Size())
 	// Initial block -> LastCommitInfo.Votes are empty.
 	// Remember that the first LastCommit is intentionally empty, so it makes
 	// sense for LastCommitInfo.Votes to also be empty.
-	if block.Height > initialHeight {
-		lastValSet, err := store.LoadValidators(block.Height - 1)
+	if height > initialHeight {
+		lastValSet, err := store.LoadValidators(height - 1)
 		if err != nil {
 			panic(err)
 		}
@@ -392,18 +474,18 @@ func getBeginBlockValidatorInfo(block *types.Block, store Store,
 		// Sanity check that commit size matches validator set size - only applies
 		// after first block.
 		var (
-			commitSize = block.LastCommit.Size()
+			commitSize = commit.Size()
 			valSetLen  = len(lastValSet.Validators)
 		)
 		if commitSize != valSetLen {
 			panic(fmt.Sprintf(
 				"commit size (%d) doesn't match valset length (%d) at height %d\n\n%v\n\n%v",
-				commitSize, valSetLen, block.Height, block.LastCommit.Signatures, lastValSet.Validators,
+				commitSize, valSetLen, height, commit.Signatures, lastValSet.Validators,
 			))
 		}
 
 		for i, val := range lastValSet.Validators {
-			commitSig := block.LastCommit.Signatures[i]
+			commitSig := commit.Signatures[i]
 			voteInfos[i] = abci.VoteInfo{
 				Validator:       types.TM2PB.Validator(val),
 				SignedLastBlock: !commitSig.Absent(),
@@ -412,11 +494,37 @@ func getBeginBlockValidatorInfo(block *types.Block, store Store,
 	}
 
 	return abci.LastCommitInfo{
-		Round: block.LastCommit.Round,
+		Round: commit.Round,
 		Votes: voteInfos,
 	}
 }
 
+// buildExtendedCommitInfo adds the vote extensions of the given precommits to
+// the LastCommitInfo. votes must be indexed by validator index and may be nil,
+// e.g. when the last commit was loaded from the block store, in which case no
+// vote extensions are set.
+func buildExtendedCommitInfo(ci abci.LastCommitInfo, votes []*types.Vote) abci.ExtendedCommitInfo {
+	vs := make([]abci.ExtendedVoteInfo, len(ci.Votes))
+	for i, vi := range ci.Votes {
+		var ext []byte
+		// Precommits for any block other than the committed one are absent
+		// from the commit, so their extensions are skipped here.
+		if vi.SignedLastBlock && i < len(votes) && votes[i] != nil {
+			ext = votes[i].Extension
+		}
+		vs[i] = abci.ExtendedVoteInfo{
+			Validator:       vi.Validator,
+			SignedLastBlock: vi.SignedLastBlock,
+			VoteExtension:   ext,
+		}
+	}
+
+	return abci.ExtendedCommitInfo{
+		Round: ci.Round,
+		Votes: vs,
+	}
+}
+
 func validateValidatorUpdates(abciUpdates []abci.ValidatorUpdate,
 	params types.ValidatorParams) error {
 	for _, valUpdate := range abciUpdates {
@@ -451,7 +559,7 @@ func updateState(
 	validatorUpdates []*types.Validator,
 ) (State, error) {
 
-	// Copy the valset so we can apply changes from EndBlock
+	// Copy the valset so we can apply changes from FinalizeBlock
 	// and update s.LastValidators and s.Validators.
 	nValSet := state.NextValidators.Copy()
 
@@ -472,9 +580,9 @@ func updateState(
 	// Update the params with the latest abciResponses.
 	nextParams := state.ConsensusParams
 	lastHeightParamsChanged := state.LastHeightConsensusParamsChanged
-	if abciResponses.EndBlock.ConsensusParamUpdates != nil {
+	if abciResponses.FinalizeBlock.ConsensusParamUpdates != nil {
 		// NOTE: must not mutate s.ConsensusParams
-		nextParams = state.ConsensusParams.UpdateConsensusParams(abciResponses.EndBlock.ConsensusParamUpdates)
+		nextParams = state.ConsensusParams.UpdateConsensusParams(abciResponses.FinalizeBlock.ConsensusParamUpdates)
 		err := nextParams.ValidateConsensusParams()
 		if err != nil {
 			return state, fmt.Errorf("error updating consensus params: %w", err)
@@ -521,19 +629,17 @@ func fireEvents(
 	validatorUpdates []*types.Validator,
 ) {
 	if err := eventBus.PublishEventNewBlock(ctx, types.EventDataNewBlock{
-		Block:            block,
-		BlockID:          blockID,
-		ResultBeginBlock: *abciResponses.BeginBlock,
-		ResultEndBlock:   *abciResponses.EndBlock,
+		Block:               block,
+		BlockID:             blockID,
+		ResultFinalizeBlock: *abciResponses.FinalizeBlock,
 	}); err != nil {
 		logger.Error("failed publishing new block", "err", err)
 	}
 
 	if err := eventBus.PublishEventNewBlockHeader(ctx, types.EventDataNewBlockHeader{
-		Header:           block.Header,
-		NumTxs:           int64(len(block.Txs)),
-		ResultBeginBlock: *abciResponses.BeginBlock,
-		ResultEndBlock:   *abciResponses.EndBlock,
+		Header:              block.Header,
+		NumTxs:              int64(len(block.Txs)),
+		ResultFinalizeBlock: *abciResponses.FinalizeBlock,
 	}); err != nil {
 		logger.Error("failed publishing new block header", "err", err)
 	}
@@ -554,7 +660,7 @@ func fireEvents(
 			Height: block.Height,
 			Index:  uint32(i),
 			Tx:     tx,
-			Result: *(abciResponses.DeliverTxs[i]),
+			Result: *(abciResponses.FinalizeBlock.TxResults[i]),
 		}}); err != nil {
 			logger.Error("failed publishing event TX", "err", err)
 		}
@@ -591,7 +697,7 @@ func ExecCommitBlock(
 
 	// the BlockExecutor condition is using for the final block replay process.
 	if be != nil {
-		abciValUpdates := abciResponses.EndBlock.ValidatorUpdates
+		abciValUpdates := abciResponses.FinalizeBlock.ValidatorUpdates
 		err = validateValidatorUpdates(abciValUpdates, s.ConsensusParams.Validator)
 		if err != nil {
 			logger.Error("err", err)
@@ -603,12 +709,18 @@ func ExecCommitBlock(
 			return nil, err
 		}
 
-		bps, err := block.MakePartSet(types.BlockPartSizeBytes)
-		if err != nil {
-			return nil, err
+		// the stored block ID has the part set header of the proposal,
+		// which may be erasure coded
+		var blockID types.BlockID
+		if meta := be.blockStore.LoadBlockMeta(block.Height); meta != nil {
+			blockID = meta.BlockID
+		} else {
+			bps, err := block.MakePartSet(types.BlockPartSizeBytes)
+			if err != nil {
+				return nil, err
+			}
+			blockID = types.BlockID{Hash: block.Hash(), PartSetHeader: bps.Header()}
 		}
-
-		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: bps.Header()}
 		fireEvents(ctx, be.logger, be.eventBus, block, blockID, abciResponses, validatorUpdates)
 	}
 
@@ -19,6 +19,7 @@ import (
 	"github.com/tendermint/tendermint/internal/eventbus"
 	mmock "github.com/tendermint/tendermint/internal/mempool/mock"
 	"github.com/tendermint/tendermint/internal/proxy"
+	proxymocks "github.com/tendermint/tendermint/internal/proxy/mocks"
 	"github.com/tendermint/tendermint/internal/pubsub"
 	sm "github.com/tendermint/tendermint/internal/state"
 	"github.com/tendermint/tendermint/internal/state/mocks"
@@ -26,6 +27,7 @@ import (
 	"github.com/tendermint/tendermint/internal/store"
 	"github.com/tendermint/tendermint/libs/log"
 	tmtime "github.com/tendermint/tendermint/libs/time"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	"github.com/tendermint/tendermint/types"
 	"github.com/tendermint/tendermint/version"
 )
@@ -66,8 +68,8 @@ func TestApplyBlock(t *testing.T) {
 	assert.EqualValues(t, 1, state.Version.Consensus.App, "App version wasn't updated")
 }
 
-// TestBeginBlockValidators ensures we send absent validators list.
-func TestBeginBlockValidators(t *testing.T) {
+// TestFinalizeBlockValidators ensures we send absent validators list.
+func TestFinalizeBlockValidators(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -133,8 +135,8 @@ func TestBeginBlockValidators(t *testing.T) {
 	}
 }
 
-// TestBeginBlockByzantineValidators ensures we send byzantine validators list.
-func TestBeginBlockByzantineValidators(t *testing.T) {
+// TestFinalizeBlockByzantineValidators ensures we send byzantine validators list.
+func TestFinalizeBlockByzantineValidators(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -365,8 +367,8 @@ func TestUpdateValidators(t *testing.T) {
 	}
 }
 
-// TestEndBlockValidatorUpdates ensures we update validator set and send an event.
-func TestEndBlockValidatorUpdates(t *testing.T) {
+// TestFinalizeBlockValidatorUpdates ensures we update validator set and send an event.
+func TestFinalizeBlockValidatorUpdates(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -398,7 +400,7 @@ func TestEndBlockValidatorUpdates(t *testing.T) {
 	blockExec.SetEventBus(eventBus)
 
 	updatesSub, err := eventBus.SubscribeWithArgs(ctx, pubsub.SubscribeArgs{
-		ClientID: "TestEndBlockValidatorUpdates",
+		ClientID: "TestFinalizeBlockValidatorUpdates",
 		Query:    types.EventQueryValidatorSetUpdates,
 	})
 	require.NoError(t, err)
@@ -439,9 +441,9 @@ func TestEndBlockValidatorUpdates(t *testing.T) {
 	}
 }
 
-// TestEndBlockValidatorUpdatesResultingInEmptySet checks that processing validator updates that
+// TestFinalizeBlockValidatorUpdatesResultingInEmptySet checks that processing validator updates that
 // would result in empty set causes no panic, an error is raised and NextValidators is not updated
-func TestEndBlockValidatorUpdatesResultingInEmptySet(t *testing.T) {
+func TestFinalizeBlockValidatorUpdatesResultingInEmptySet(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -482,6 +484,198 @@ func TestEndBlockValidatorUpdatesResultingInEmptySet(t *testing.T) {
 	assert.NotEmpty(t, state.NextValidators.Validators)
 }
 
+// TestCreateProposalBlockUsesPrepareProposal ensures that the proposal block
+// contains the transactions returned by the application.
+func TestCreateProposalBlockUsesPrepareProposal(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	state, stateDB, _ := makeState(1, 1)
+	stateStore := sm.NewStore(stateDB)
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+
+	txs := types.Txs{types.Tx("tx1"), types.Tx("tx2")}
+	app := &proxymocks.AppConnConsensus{}
+	app.On("PrepareProposalSync", mock.Anything, mock.Anything).Return(
+		&abci.ResponsePrepareProposal{Txs: txs.ToSliceOfBytes()}, nil,
+	)
+
+	blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), app,
+		mmock.Mempool{}, sm.EmptyEvidencePool{}, blockStore)
+
+	proposerAddr := state.Validators.Validators[0].Address
+	block, _, err := blockExec.CreateProposalBlock(ctx, 1, state, new(types.Commit), proposerAddr, nil)
+	require.NoError(t, err)
+	require.Equal(t, txs, block.Data.Txs)
+	app.AssertExpectations(t)
+}
+
+// TestCreateProposalBlockRejectsOversizedTxs ensures that an error is
+// returned if the application returns more transaction data than fits in
+// the block.
+func TestCreateProposalBlockRejectsOversizedTxs(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	state, stateDB, _ := makeState(1, 1)
+	state.ConsensusParams.Block.MaxBytes = 1024
+	stateStore := sm.NewStore(stateDB)
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+
+	app := &proxymocks.AppConnConsensus{}
+	app.On("PrepareProposalSync", mock.Anything, mock.Anything).Return(
+		&abci.ResponsePrepareProposal{Txs: [][]byte{make([]byte, 2048)}}, nil,
+	)
+
+	blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), app,
+		mmock.Mempool{}, sm.EmptyEvidencePool{}, blockStore)
+
+	proposerAddr := state.Validators.Validators[0].Address
+	block, _, err := blockExec.CreateProposalBlock(ctx, 1, state, new(types.Commit), proposerAddr, nil)
+	require.Error(t, err)
+	require.Nil(t, block)
+}
+
+// TestCreateProposalBlockPassesVoteExtensions ensures that the vote extensions
+// of the precommits for the last block are passed to PrepareProposal.
+func TestCreateProposalBlockPassesVoteExtensions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	state, stateDB, _ := makeState(2, 2)
+	stateStore := sm.NewStore(stateDB)
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+
+	lastBlockID := makeBlockID([]byte("lastblockhash"), 1, []byte("partshash"))
+	vals := state.LastValidators.Validators
+	commit := types.NewCommit(1, 0, lastBlockID, []types.CommitSig{
+		{
+			BlockIDFlag:      types.BlockIDFlagCommit,
+			ValidatorAddress: vals[0].Address,
+			Timestamp:        tmtime.Now(),
+			Signature:        []byte("signature"),
+		},
+		types.NewCommitSigAbsent(),
+	})
+	votes := []*types.Vote{
+		{ValidatorIndex: 0, BlockID: lastBlockID, Extension: []byte("extension")},
+		// a precommit for another block is absent from the commit
+		{ValidatorIndex: 1, Extension: []byte("other extension")},
+	}
+
+	var req abci.RequestPrepareProposal
+	app := &proxymocks.AppConnConsensus{}
+	app.On("PrepareProposalSync", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		req = args.Get(1).(abci.RequestPrepareProposal)
+	}).Return(&abci.ResponsePrepareProposal{}, nil)
+
+	blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), app,
+		mmock.Mempool{}, sm.EmptyEvidencePool{}, blockStore)
+
+	_, _, err := blockExec.CreateProposalBlock(ctx, 2, state, commit, vals[0].Address, votes)
+	require.NoError(t, err)
+
+	require.Len(t, req.LocalLastCommit.Votes, 2)
+	assert.True(t, req.LocalLastCommit.Votes[0].SignedLastBlock)
+	assert.Equal(t, []byte("extension"), req.LocalLastCommit.Votes[0].VoteExtension)
+	assert.False(t, req.LocalLastCommit.Votes[1].SignedLastBlock)
+	assert.Empty(t, req.LocalLastCommit.Votes[1].VoteExtension)
+}
+
+func TestVerifyVoteExtension(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		status abci.ResponseVerifyVoteExtension_VerifyStatus
+		expErr error
+	}{
+		{"accepted", abci.ResponseVerifyVoteExtension_ACCEPT, nil},
+		{"rejected", abci.ResponseVerifyVoteExtension_REJECT, types.ErrVoteInvalidExtension},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			state, stateDB, _ := makeState(1, 1)
+			stateStore := sm.NewStore(stateDB)
+			blockStore := store.NewBlockStore(dbm.NewMemDB())
+
+			vote := &types.Vote{
+				Type:             tmproto.PrecommitType,
+				Height:           1,
+				BlockID:          makeBlockID([]byte("blockhash"), 1, []byte("partshash")),
+				ValidatorAddress: state.Validators.Validators[0].Address,
+				Extension:        []byte("extension"),
+			}
+
+			app := &proxymocks.AppConnConsensus{}
+			app.On("VerifyVoteExtensionSync", mock.Anything, abci.RequestVerifyVoteExtension{
+				Hash:             vote.BlockID.Hash,
+				ValidatorAddress: vote.ValidatorAddress,
+				Height:           vote.Height,
+				VoteExtension:    vote.Extension,
+			}).Return(&abci.ResponseVerifyVoteExtension{Status: tc.status}, nil)
+
+			blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), app,
+				mmock.Mempool{}, sm.EmptyEvidencePool{}, blockStore)
+
+			err := blockExec.VerifyVoteExtension(ctx, vote)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+			app.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProcessProposal(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		status   abci.ResponseProcessProposal_ProposalStatus
+		accepted bool
+		expErr   bool
+	}{
+		{"accepted", abci.ResponseProcessProposal_ACCEPT, true, false},
+		{"rejected", abci.ResponseProcessProposal_REJECT, false, false},
+		{"unknown status", abci.ResponseProcessProposal_UNKNOWN, false, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			state, stateDB, _ := makeState(1, 1)
+			stateStore := sm.NewStore(stateDB)
+			blockStore := store.NewBlockStore(dbm.NewMemDB())
+
+			app := &proxymocks.AppConnConsensus{}
+			app.On("ProcessProposalSync", mock.Anything, mock.Anything).Return(
+				&abci.ResponseProcessProposal{Status: tc.status}, nil,
+			)
+
+			blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), app,
+				mmock.Mempool{}, sm.EmptyEvidencePool{}, blockStore)
+
+			block, err := sf.MakeBlock(state, 1, new(types.Commit))
+			require.NoError(t, err)
+
+			accepted, err := blockExec.ProcessProposal(ctx, block, state)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.accepted, accepted)
+		})
+	}
+}
+
 func makeBlockID(hash []byte, partSetSize uint32, partSetHash []byte) types.BlockID {
 	var (
 		h   = make([]byte, tmhash.Size)
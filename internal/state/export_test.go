@@ -29,6 +29,12 @@ func UpdateState(
 	return updateState(state, blockID, header, abciResponses, validatorUpdates)
 }
 
+// ABCIResponsesKey is an alias for abciResponsesKey exported from store.go,
+// exclusively and explicitly for testing.
+func ABCIResponsesKey(height int64) []byte {
+	return abciResponsesKey(height)
+}
+
 // ValidateValidatorUpdates is an alias for validateValidatorUpdates exported
 // from execution.go, exclusively and explicitly for testing.
 func ValidateValidatorUpdates(abciUpdates []abci.ValidatorUpdate, params types.ValidatorParams) error {
@@ -20,7 +20,7 @@ func TestBlockIndexer(t *testing.T) {
 
 	require.NoError(t, indexer.Index(types.EventDataNewBlockHeader{
 		Header: types.Header{Height: 1},
-		ResultBeginBlock: abci.ResponseBeginBlock{
+		ResultFinalizeBlock: abci.ResponseFinalizeBlock{
 			Events: []abci.Event{
 				{
 					Type: "begin_event",
@@ -32,10 +32,6 @@ func TestBlockIndexer(t *testing.T) {
 						},
 					},
 				},
-			},
-		},
-		ResultEndBlock: abci.ResponseEndBlock{
-			Events: []abci.Event{
 				{
 					Type: "end_event",
 					Attributes: []abci.EventAttribute{
@@ -58,7 +54,7 @@ func TestBlockIndexer(t *testing.T) {
 
 		require.NoError(t, indexer.Index(types.EventDataNewBlockHeader{
 			Header: types.Header{Height: int64(i)},
-			ResultBeginBlock: abci.ResponseBeginBlock{
+			ResultFinalizeBlock: abci.ResponseFinalizeBlock{
 				Events: []abci.Event{
 					{
 						Type: "begin_event",
@@ -70,10 +66,6 @@ func TestBlockIndexer(t *testing.T) {
 							},
 						},
 					},
-				},
-			},
-			ResultEndBlock: abci.ResponseEndBlock{
-				Events: []abci.Event{
 					{
 						Type: "end_event",
 						Attributes: []abci.EventAttribute{
@@ -33,7 +33,7 @@ func TestBlockFuncs(t *testing.T) {
 
 	require.NoError(t, indexer.IndexBlockEvents(types.EventDataNewBlockHeader{
 		Header: types.Header{Height: 1},
-		ResultBeginBlock: abci.ResponseBeginBlock{
+		ResultFinalizeBlock: abci.ResponseFinalizeBlock{
 			Events: []abci.Event{
 				{
 					Type: "begin_event",
@@ -45,10 +45,6 @@ func TestBlockFuncs(t *testing.T) {
 						},
 					},
 				},
-			},
-		},
-		ResultEndBlock: abci.ResponseEndBlock{
-			Events: []abci.Event{
 				{
 					Type: "end_event",
 					Attributes: []abci.EventAttribute{
@@ -75,7 +71,7 @@ func TestBlockFuncs(t *testing.T) {
 
 		require.NoError(t, indexer.IndexBlockEvents(types.EventDataNewBlockHeader{
 			Header: types.Header{Height: int64(i)},
-			ResultBeginBlock: abci.ResponseBeginBlock{
+			ResultFinalizeBlock: abci.ResponseFinalizeBlock{
 				Events: []abci.Event{
 					{
 						Type: "begin_event",
@@ -87,10 +83,6 @@ func TestBlockFuncs(t *testing.T) {
 							},
 						},
 					},
-				},
-			},
-			ResultEndBlock: abci.ResponseEndBlock{
-				Events: []abci.Event{
 					{
 						Type: "end_event",
 						Attributes: []abci.EventAttribute{
@@ -401,11 +401,12 @@ func (store dbStore) reverseBatchDelete(batch dbm.Batch, start, end []byte) ([]b
 //
 // See merkle.SimpleHashFromByteSlices
 func ABCIResponsesResultsHash(ar *tmstate.ABCIResponses) []byte {
-	return types.NewResults(ar.DeliverTxs).Hash()
+	return types.NewResults(ar.FinalizeBlock.GetTxResults()).Hash()
 }
 
 // LoadABCIResponses loads the ABCIResponses for the given height from the
-// database. If not found, ErrNoABCIResponsesForHeight is returned.
+// database. If not found, ErrNoABCIResponsesForHeight is returned, and if
+// saved by a version without FinalizeBlock, ErrLegacyABCIResponses.
 //
 // This is useful for recovering from crashes where we called app.Commit and
 // before we called s.Save(). It can also be used to produce Merkle proofs of
@@ -428,6 +429,12 @@ func (store dbStore) LoadABCIResponses(height int64) (*tmstate.ABCIResponses, er
 	}
 	// TODO: ensure that buf is completely read.
 
+	// The responses of BeginBlock, DeliverTx and EndBlock are skipped as
+	// unknown fields.
+	if abciResponses.FinalizeBlock == nil {
+		return nil, ErrLegacyABCIResponses{height}
+	}
+
 	return abciResponses, nil
 }
 
@@ -442,15 +449,17 @@ func (store dbStore) SaveABCIResponses(height int64, abciResponses *tmstate.ABCI
 }
 
 func (store dbStore) saveABCIResponses(height int64, abciResponses *tmstate.ABCIResponses) error {
-	var dtxs []*abci.ResponseDeliverTx
-	// strip nil values,
-	for _, tx := range abciResponses.DeliverTxs {
-		if tx != nil {
-			dtxs = append(dtxs, tx)
+	if abciResponses.FinalizeBlock != nil {
+		var dtxs []*abci.ResponseDeliverTx
+		// strip nil values,
+		for _, tx := range abciResponses.FinalizeBlock.TxResults {
+			if tx != nil {
+				dtxs = append(dtxs, tx)
+			}
 		}
-	}
 
-	abciResponses.DeliverTxs = dtxs
+		abciResponses.FinalizeBlock.TxResults = dtxs
+	}
 
 	bz, err := abciResponses.Marshal()
 	if err != nil {
@@ -22,8 +22,8 @@ func TestTxFilter(t *testing.T) {
 		tx    types.Tx
 		isErr bool
 	}{
-		{types.Tx(tmrand.Bytes(2155)), false},
-		{types.Tx(tmrand.Bytes(2156)), true},
+		{types.Tx(tmrand.Bytes(2122)), false},
+		{types.Tx(tmrand.Bytes(2123)), true},
 		{types.Tx(tmrand.Bytes(3000)), true},
 	}
 
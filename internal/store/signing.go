@@ -0,0 +1,302 @@
+package store
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/google/orderedcode"
+	dbm "github.com/tendermint/tm-db"
+
+	tmmath "github.com/tendermint/tendermint/libs/math"
+	"github.com/tendermint/tendermint/types"
+)
+
+// ValidatorStore loads the validator set that signed the commit of a height.
+// It is implemented by the state store.
+type ValidatorStore interface {
+	LoadValidators(height int64) (*types.ValidatorSet, error)
+}
+
+// SignedCommit records whether a validator signed the commit of a height.
+type SignedCommit struct {
+	Height int64
+	Signed bool
+}
+
+// SigningInfo is the signing history of a validator over a window of
+// commits. Commits lists the heights of the window at which the address was
+// in the validator set, oldest first.
+type SigningInfo struct {
+	Address           types.Address
+	FromHeight        int64
+	ToHeight          int64
+	Commits           []SignedCommit
+	SignedCount       int64
+	MissedCount       int64
+	FirstMissedHeight int64 // 0 if no commit of the window was missed
+}
+
+// Uptime returns the percentage of the commits in the window that the
+// validator signed.
+func (si *SigningInfo) Uptime() float64 {
+	total := si.SignedCount + si.MissedCount
+	if total == 0 {
+		return 0
+	}
+	return float64(si.SignedCount) * 100 / float64(total)
+}
+
+/*
+SigningIndex records, for every validator, which of the last window commits
+stored in the BlockStore it signed. The index lives in the BlockStore
+database and is brought up to date with the stored commits on every lookup,
+so that only the commits stored since the previous lookup are read.
+
+The commit of a height is stored along with the next block, so the index
+lags the BlockStore height by one.
+*/
+type SigningIndex struct {
+	mtx sync.Mutex
+
+	db         dbm.DB
+	blockStore *BlockStore
+	valStore   ValidatorStore
+	window     int64
+}
+
+// NewSigningIndex returns a SigningIndex that keeps the last window commits
+// of the given BlockStore, loading the validator sets from valStore.
+func NewSigningIndex(blockStore *BlockStore, valStore ValidatorStore, window int64) *SigningIndex {
+	return &SigningIndex{
+		db:         blockStore.db,
+		blockStore: blockStore,
+		valStore:   valStore,
+		window:     window,
+	}
+}
+
+// Window returns the number of commits kept by the index.
+func (si *SigningIndex) Window() int64 {
+	return si.window
+}
+
+// SigningInfo returns the signing history of the validator with the given
+// address over the last window commits, which must not exceed the window of
+// the index. A window of 0 selects the window of the index.
+func (si *SigningIndex) SigningInfo(address types.Address, window int64) (*SigningInfo, error) {
+	if window < 0 || window > si.window {
+		return nil, fmt.Errorf("window must be between 0 and %d, got %d", si.window, window)
+	}
+	if window == 0 {
+		window = si.window
+	}
+
+	si.mtx.Lock()
+	defer si.mtx.Unlock()
+
+	height, err := si.update()
+	if err != nil {
+		return nil, err
+	}
+
+	info := &SigningInfo{
+		Address:    address,
+		FromHeight: tmmath.MaxInt64(height-window+1, 1),
+		ToHeight:   height,
+	}
+	if height == 0 {
+		return info, nil
+	}
+
+	iter, err := si.db.Iterator(
+		signingInfoKey(address, info.FromHeight),
+		signingInfoKey(address, height+1),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		h, err := decodeSigningInfoKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+
+		signed := len(iter.Value()) == 1 && iter.Value()[0] == 1
+		info.Commits = append(info.Commits, SignedCommit{Height: h, Signed: signed})
+		if signed {
+			info.SignedCount++
+			continue
+		}
+
+		info.MissedCount++
+		if info.FirstMissedHeight == 0 {
+			info.FirstMissedHeight = h
+		}
+	}
+
+	return info, iter.Error()
+}
+
+// update indexes the commits stored since the previous update and returns the
+// last indexed height.
+func (si *SigningIndex) update() (int64, error) {
+	last, err := si.lastHeight()
+	if err != nil {
+		return 0, err
+	}
+
+	// the commit of the latest block is only stored with the next block
+	top := si.blockStore.Height() - 1
+	if top <= last {
+		return last, nil
+	}
+
+	// oldest is the first height that may still have entries in the index
+	oldest := last - si.window + 1
+	start := tmmath.MaxInt64(top-si.window+1, si.blockStore.Base())
+	if start > last+1 {
+		// all the indexed commits fell out of the window
+		if err := si.clear(); err != nil {
+			return 0, err
+		}
+		oldest = start
+	} else {
+		start = last + 1
+	}
+
+	batch := si.db.NewBatch()
+	defer batch.Close()
+
+	for height := start; height <= top; height++ {
+		commit := si.blockStore.LoadBlockCommit(height)
+		if commit == nil {
+			return 0, fmt.Errorf("commit for height %d not found", height)
+		}
+
+		vals, err := si.valStore.LoadValidators(height)
+		if err != nil {
+			return 0, fmt.Errorf("loading validators for height %d: %w", height, err)
+		}
+
+		for idx, val := range vals.Validators {
+			signed := idx < len(commit.Signatures) && !commit.Signatures[idx].Absent()
+			value := []byte{0}
+			if signed {
+				value[0] = 1
+			}
+
+			if err := batch.Set(signingInfoKey(val.Address, height), value); err != nil {
+				return 0, err
+			}
+		}
+
+		// remove the commit that fell out of the window. Its validator set
+		// may have been pruned, in which case the entries stay behind but are
+		// never read.
+		if old := height - si.window; old >= oldest && old > 0 {
+			if oldVals, err := si.valStore.LoadValidators(old); err == nil {
+				for _, val := range oldVals.Validators {
+					if err := batch.Delete(signingInfoKey(val.Address, old)); err != nil {
+						return 0, err
+					}
+				}
+			}
+		}
+	}
+
+	bz, err := proto.Marshal(&gogotypes.Int64Value{Value: top})
+	if err != nil {
+		return 0, err
+	}
+	if err := batch.Set(signingHeightKey(), bz); err != nil {
+		return 0, err
+	}
+
+	if err := batch.WriteSync(); err != nil {
+		return 0, err
+	}
+
+	return top, nil
+}
+
+func (si *SigningIndex) lastHeight() (int64, error) {
+	bz, err := si.db.Get(signingHeightKey())
+	if err != nil || len(bz) == 0 {
+		return 0, err
+	}
+
+	var height gogotypes.Int64Value
+	if err := proto.Unmarshal(bz, &height); err != nil {
+		return 0, fmt.Errorf("error reading signing index height: %w", err)
+	}
+	return height.Value, nil
+}
+
+func (si *SigningIndex) clear() error {
+	start, err := orderedcode.Append(nil, prefixSigningInfo)
+	if err != nil {
+		return err
+	}
+	end, err := orderedcode.Append(nil, prefixSigningInfo+1)
+	if err != nil {
+		return err
+	}
+
+	iter, err := si.db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	batch := si.db.NewBatch()
+	defer batch.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if err := batch.Delete(iter.Key()); err != nil {
+			return err
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
+func signingInfoKey(address types.Address, height int64) []byte {
+	key, err := orderedcode.Append(nil, prefixSigningInfo, string(address), height)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+func decodeSigningInfoKey(key []byte) (height int64, err error) {
+	var (
+		prefix  int64
+		address string
+	)
+	remaining, err := orderedcode.Parse(string(key), &prefix, &address, &height)
+	if err != nil {
+		return
+	}
+	if len(remaining) != 0 {
+		return -1, fmt.Errorf("expected complete key but got remainder: %s", remaining)
+	}
+	if prefix != prefixSigningInfo {
+		return -1, fmt.Errorf("incorrect prefix. Expected %v, got %v", prefixSigningInfo, prefix)
+	}
+	return
+}
+
+func signingHeightKey() []byte {
+	key, err := orderedcode.Append(nil, prefixSigningHeight)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
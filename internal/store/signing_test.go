@@ -0,0 +1,145 @@
+package store
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/internal/state/test/factory"
+	tmtime "github.com/tendermint/tendermint/libs/time"
+	"github.com/tendermint/tendermint/types"
+)
+
+type staticValidatorStore struct {
+	vals *types.ValidatorSet
+}
+
+func (s staticValidatorStore) LoadValidators(height int64) (*types.ValidatorSet, error) {
+	if height < 1 {
+		return nil, fmt.Errorf("no validators for height %d", height)
+	}
+	return s.vals, nil
+}
+
+// makeSignedCommit makes a commit for height in which the validators for
+// which missed returns true are absent.
+func makeSignedCommit(height int64, vals *types.ValidatorSet, missed func(idx int) bool) *types.Commit {
+	sigs := make([]types.CommitSig, len(vals.Validators))
+	for idx, val := range vals.Validators {
+		if missed(idx) {
+			sigs[idx] = types.NewCommitSigAbsent()
+			continue
+		}
+		sigs[idx] = types.CommitSig{
+			BlockIDFlag:      types.BlockIDFlagCommit,
+			ValidatorAddress: val.Address,
+			Timestamp:        tmtime.Now(),
+			Signature:        []byte("Signature"),
+		}
+	}
+	return types.NewCommit(
+		height,
+		0,
+		types.BlockID{
+			Hash:          crypto.CRandBytes(32),
+			PartSetHeader: types.PartSetHeader{Hash: crypto.CRandBytes(32), Total: 2},
+		},
+		sigs)
+}
+
+func TestSigningIndex(t *testing.T) {
+	vals := types.NewValidatorSet([]*types.Validator{
+		types.NewValidator(ed25519.GenPrivKey().PubKey(), 10),
+		types.NewValidator(ed25519.GenPrivKey().PubKey(), 10),
+	})
+	reliable, flaky := vals.Validators[0].Address, vals.Validators[1].Address
+
+	bs, _ := freshBlockStore()
+	saveBlocks := func(from, to int64) {
+		for h := from; h <= to; h++ {
+			lastCommit := new(types.Commit)
+			if h > 1 {
+				lastCommit = makeSignedCommit(h-1, vals, func(idx int) bool {
+					// the second validator misses every height divisible by 7
+					return idx == 1 && (h-1)%7 == 0
+				})
+			}
+			block, err := factory.MakeBlock(state, h, lastCommit)
+			require.NoError(t, err)
+			partSet, err := block.MakePartSet(2)
+			require.NoError(t, err)
+			bs.SaveBlock(block, partSet, makeTestCommit(h, tmtime.Now()))
+		}
+	}
+
+	si := NewSigningIndex(bs, staticValidatorStore{vals}, 10)
+
+	// an empty block store has no commits
+	info, err := si.SigningInfo(flaky, 0)
+	require.NoError(t, err)
+	assert.Empty(t, info.Commits)
+
+	saveBlocks(1, 20)
+
+	// the commit of the latest block is not stored yet
+	info, err = si.SigningInfo(flaky, 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 10, info.FromHeight)
+	assert.EqualValues(t, 19, info.ToHeight)
+	require.Len(t, info.Commits, 10)
+	assert.Equal(t, SignedCommit{Height: 14, Signed: false}, info.Commits[4])
+	assert.EqualValues(t, 9, info.SignedCount)
+	assert.EqualValues(t, 1, info.MissedCount)
+	assert.EqualValues(t, 14, info.FirstMissedHeight)
+	assert.Equal(t, float64(90), info.Uptime())
+
+	info, err = si.SigningInfo(reliable, 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 10, info.SignedCount)
+	assert.Zero(t, info.FirstMissedHeight)
+	assert.Equal(t, float64(100), info.Uptime())
+
+	// a smaller window only reads the latest commits
+	info, err = si.SigningInfo(flaky, 5)
+	require.NoError(t, err)
+	assert.EqualValues(t, 15, info.FromHeight)
+	assert.EqualValues(t, 5, info.SignedCount)
+	assert.Zero(t, info.MissedCount)
+
+	_, err = si.SigningInfo(flaky, 11)
+	require.Error(t, err)
+
+	info, err = si.SigningInfo(crypto.CRandBytes(crypto.AddressSize), 0)
+	require.NoError(t, err)
+	assert.Empty(t, info.Commits)
+
+	// commits that fall out of the window are pruned
+	saveBlocks(21, 25)
+	info, err = si.SigningInfo(flaky, 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 15, info.FromHeight)
+	assert.EqualValues(t, 24, info.ToHeight)
+	assert.EqualValues(t, 9, info.SignedCount)
+	assert.EqualValues(t, 21, info.FirstMissedHeight)
+
+	ok, err := bs.db.Has(signingInfoKey(flaky, 14))
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// a jump past the window starts the index over
+	saveBlocks(26, 60)
+	info, err = si.SigningInfo(flaky, 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 50, info.FromHeight)
+	assert.EqualValues(t, 59, info.ToHeight)
+	require.Len(t, info.Commits, 10)
+	assert.EqualValues(t, 56, info.FirstMissedHeight)
+
+	ok, err = bs.db.Has(signingInfoKey(flaky, 24))
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -135,17 +135,22 @@ func (bs *BlockStore) LoadBlock(height int64) *types.Block {
 	}
 
 	pbb := new(tmproto.Block)
-	buf := []byte{}
-	for i := 0; i < int(blockMeta.BlockID.PartSetHeader.Total); i++ {
+	// the parity parts of an erasure coded block are not needed
+	parts := make([]*types.Part, blockMeta.BlockID.PartSetHeader.DataTotal())
+	for i := range parts {
 		part := bs.LoadBlockPart(height, i)
 		// If the part is missing (e.g. since it has been deleted after we
 		// loaded the block meta) we consider the whole block to be missing.
 		if part == nil {
 			return nil
 		}
-		buf = append(buf, part.Bytes...)
+		parts[i] = part
 	}
-	err := proto.Unmarshal(buf, pbb)
+	buf, err := types.PartSetData(blockMeta.BlockID.PartSetHeader, parts)
+	if err != nil {
+		panic(fmt.Errorf("error reading block: %w", err))
+	}
+	err = proto.Unmarshal(buf, pbb)
 	if err != nil {
 		// NOTE: The existence of meta should imply the existence of the
 		// block. So, make sure meta is only saved after blocks are saved.
@@ -586,6 +591,9 @@ const (
 	prefixBlockCommit = int64(2)
 	prefixSeenCommit  = int64(3)
 	prefixBlockHash   = int64(4)
+
+	prefixSigningInfo   = int64(15)
+	prefixSigningHeight = int64(16)
 )
 
 func blockMetaKey(height int64) []byte {
@@ -39,5 +39,6 @@ func MakeVote(
 		panic(err)
 	}
 	v.Signature = vpb.Signature
+	v.ExtensionSignature = vpb.ExtensionSignature
 	return v, nil
 }
@@ -1,6 +1,8 @@
 package proxy
 
 import (
+	"time"
+
 	"github.com/tendermint/tendermint/libs/bytes"
 	lrpc "github.com/tendermint/tendermint/light/rpc"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
@@ -34,11 +36,14 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 		"tx_search":            rpcserver.NewRPCFunc(makeTxSearchFunc(c), "query,prove,page,per_page,order_by", false),
 		"block_search":         rpcserver.NewRPCFunc(makeBlockSearchFunc(c), "query,page,per_page,order_by", false),
 		"validators":           rpcserver.NewRPCFunc(makeValidatorsFunc(c), "height,page,per_page", true),
+		"signing_info":         rpcserver.NewRPCFunc(makeSigningInfoFunc(c), "address,window", false),
 		"dump_consensus_state": rpcserver.NewRPCFunc(makeDumpConsensusStateFunc(c), "", false),
 		"consensus_state":      rpcserver.NewRPCFunc(makeConsensusStateFunc(c), "", false),
+		"consensus_timeline":   rpcserver.NewRPCFunc(makeConsensusTimelineFunc(c), "height", false),
 		"consensus_params":     rpcserver.NewRPCFunc(makeConsensusParamsFunc(c), "height", true),
 		"unconfirmed_txs":      rpcserver.NewRPCFunc(makeUnconfirmedTxsFunc(c), "limit", false),
 		"num_unconfirmed_txs":  rpcserver.NewRPCFunc(makeNumUnconfirmedTxsFunc(c), "", false),
+		"mempool_txs":          rpcserver.NewRPCFunc(makeMempoolTxsFunc(c), "sender,min_priority,max_priority,min_gas_wanted,max_gas_wanted,min_age,max_age,page,per_page", false),
 
 		// tx broadcast API
 		"broadcast_tx_commit": rpcserver.NewRPCFunc(makeBroadcastTxCommitFunc(c), "tx", false),
@@ -51,6 +56,9 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 
 		// evidence API
 		"broadcast_evidence": rpcserver.NewRPCFunc(makeBroadcastEvidenceFunc(c), "evidence", false),
+		"pending_evidence":   rpcserver.NewRPCFunc(makePendingEvidenceFunc(c), "page,per_page", false),
+		"evidence":           rpcserver.NewRPCFunc(makeEvidenceFunc(c), "hash", true),
+		"committed_evidence": rpcserver.NewRPCFunc(makeCommittedEvidenceFunc(c), "min_height,max_height,page,per_page", false),
 	}
 }
 
@@ -208,6 +216,15 @@ func makeValidatorsFunc(c *lrpc.Client) rpcValidatorsFunc {
 	}
 }
 
+type rpcSigningInfoFunc func(ctx *rpctypes.Context, address bytes.HexBytes,
+	window *int64) (*coretypes.ResultSigningInfo, error)
+
+func makeSigningInfoFunc(c *lrpc.Client) rpcSigningInfoFunc {
+	return func(ctx *rpctypes.Context, address bytes.HexBytes, window *int64) (*coretypes.ResultSigningInfo, error) {
+		return c.SigningInfo(ctx.Context(), address, window)
+	}
+}
+
 type rpcDumpConsensusStateFunc func(ctx *rpctypes.Context) (*coretypes.ResultDumpConsensusState, error)
 
 func makeDumpConsensusStateFunc(c *lrpc.Client) rpcDumpConsensusStateFunc {
@@ -224,6 +241,14 @@ func makeConsensusStateFunc(c *lrpc.Client) rpcConsensusStateFunc {
 	}
 }
 
+type rpcConsensusTimelineFunc func(ctx *rpctypes.Context, height *int64) (*coretypes.ResultConsensusTimeline, error)
+
+func makeConsensusTimelineFunc(c *lrpc.Client) rpcConsensusTimelineFunc {
+	return func(ctx *rpctypes.Context, height *int64) (*coretypes.ResultConsensusTimeline, error) {
+		return c.ConsensusTimeline(ctx.Context(), height)
+	}
+}
+
 type rpcConsensusParamsFunc func(ctx *rpctypes.Context, height *int64) (*coretypes.ResultConsensusParams, error)
 
 func makeConsensusParamsFunc(c *lrpc.Client) rpcConsensusParamsFunc {
@@ -248,6 +273,43 @@ func makeNumUnconfirmedTxsFunc(c *lrpc.Client) rpcNumUnconfirmedTxsFunc {
 	}
 }
 
+type rpcMempoolTxsFunc func(
+	ctx *rpctypes.Context,
+	sender string,
+	minPriority, maxPriority *int64,
+	minGasWanted, maxGasWanted *int64,
+	minAge, maxAge *int64,
+	page, perPage *int,
+) (*coretypes.ResultMempoolTxs, error)
+
+func makeMempoolTxsFunc(c *lrpc.Client) rpcMempoolTxsFunc {
+	return func(
+		ctx *rpctypes.Context,
+		sender string,
+		minPriority, maxPriority *int64,
+		minGasWanted, maxGasWanted *int64,
+		minAge, maxAge *int64,
+		page, perPage *int,
+	) (*coretypes.ResultMempoolTxs, error) {
+		filter := coretypes.MempoolTxFilter{
+			Sender:       sender,
+			MinPriority:  minPriority,
+			MaxPriority:  maxPriority,
+			MinGasWanted: minGasWanted,
+			MaxGasWanted: maxGasWanted,
+		}
+		if minAge != nil {
+			d := time.Duration(*minAge) * time.Second
+			filter.MinAge = &d
+		}
+		if maxAge != nil {
+			d := time.Duration(*maxAge) * time.Second
+			filter.MaxAge = &d
+		}
+		return c.MempoolTxs(ctx.Context(), filter, page, perPage)
+	}
+}
+
 type rpcBroadcastTxCommitFunc func(ctx *rpctypes.Context, tx types.Tx) (*coretypes.ResultBroadcastTxCommit, error)
 
 func makeBroadcastTxCommitFunc(c *lrpc.Client) rpcBroadcastTxCommitFunc {
@@ -302,3 +364,35 @@ func makeBroadcastEvidenceFunc(c *lrpc.Client) rpcBroadcastEvidenceFunc {
 		return c.BroadcastEvidence(ctx.Context(), ev)
 	}
 }
+
+type rpcPendingEvidenceFunc func(ctx *rpctypes.Context, page, perPage *int) (*coretypes.ResultEvidenceList, error)
+
+func makePendingEvidenceFunc(c *lrpc.Client) rpcPendingEvidenceFunc {
+	return func(ctx *rpctypes.Context, page, perPage *int) (*coretypes.ResultEvidenceList, error) {
+		return c.PendingEvidence(ctx.Context(), page, perPage)
+	}
+}
+
+type rpcEvidenceFunc func(ctx *rpctypes.Context, hash bytes.HexBytes) (*coretypes.ResultEvidence, error)
+
+func makeEvidenceFunc(c *lrpc.Client) rpcEvidenceFunc {
+	return func(ctx *rpctypes.Context, hash bytes.HexBytes) (*coretypes.ResultEvidence, error) {
+		return c.Evidence(ctx.Context(), hash)
+	}
+}
+
+type rpcCommittedEvidenceFunc func(
+	ctx *rpctypes.Context,
+	minHeight, maxHeight int64,
+	page, perPage *int,
+) (*coretypes.ResultEvidenceList, error)
+
+func makeCommittedEvidenceFunc(c *lrpc.Client) rpcCommittedEvidenceFunc {
+	return func(
+		ctx *rpctypes.Context,
+		minHeight, maxHeight int64,
+		page, perPage *int,
+	) (*coretypes.ResultEvidenceList, error) {
+		return c.CommittedEvidence(ctx.Context(), minHeight, maxHeight, page, perPage)
+	}
+}
@@ -8,9 +8,6 @@ import (
 	"regexp"
 	"time"
 
-	"github.com/gogo/protobuf/proto"
-
-	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/merkle"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	"github.com/tendermint/tendermint/libs/log"
@@ -219,6 +216,14 @@ func (c *Client) NumUnconfirmedTxs(ctx context.Context) (*coretypes.ResultUnconf
 	return c.next.NumUnconfirmedTxs(ctx)
 }
 
+func (c *Client) MempoolTxs(
+	ctx context.Context,
+	filter coretypes.MempoolTxFilter,
+	page, perPage *int,
+) (*coretypes.ResultMempoolTxs, error) {
+	return c.next.MempoolTxs(ctx, filter, page, perPage)
+}
+
 func (c *Client) CheckTx(ctx context.Context, tx types.Tx) (*coretypes.ResultCheckTx, error) {
 	return c.next.CheckTx(ctx, tx)
 }
@@ -239,6 +244,10 @@ func (c *Client) ConsensusState(ctx context.Context) (*coretypes.ResultConsensus
 	return c.next.ConsensusState(ctx)
 }
 
+func (c *Client) ConsensusTimeline(ctx context.Context, height *int64) (*coretypes.ResultConsensusTimeline, error) {
+	return c.next.ConsensusTimeline(ctx, height)
+}
+
 func (c *Client) ConsensusParams(ctx context.Context, height *int64) (*coretypes.ResultConsensusParams, error) {
 	res, err := c.next.ConsensusParams(ctx, height)
 	if err != nil {
@@ -422,27 +431,8 @@ func (c *Client) BlockResults(ctx context.Context, height *int64) (*coretypes.Re
 		return nil, err
 	}
 
-	// proto-encode BeginBlock events
-	bbeBytes, err := proto.Marshal(&abci.ResponseBeginBlock{
-		Events: res.BeginBlockEvents,
-	})
-	if err != nil {
-		return nil, err
-	}
-
 	// Build a Merkle tree of proto-encoded DeliverTx results and get a hash.
-	results := types.NewResults(res.TxsResults)
-
-	// proto-encode EndBlock events.
-	ebeBytes, err := proto.Marshal(&abci.ResponseEndBlock{
-		Events: res.EndBlockEvents,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	// Build a Merkle tree out of the above 3 binary slices.
-	rH := merkle.HashFromByteSlices([][]byte{bbeBytes, results.Hash(), ebeBytes})
+	rH := types.NewResults(res.TxsResults).Hash()
 
 	// Verify block results.
 	if !bytes.Equal(rH, trustedBlock.LastResultsHash) {
@@ -531,7 +521,48 @@ func (c *Client) TxSearch(
 	page, perPage *int,
 	orderBy string,
 ) (*coretypes.ResultTxSearch, error) {
-	return c.next.TxSearch(ctx, query, prove, page, perPage, orderBy)
+	res, err := c.next.TxSearch(ctx, query, prove, page, perPage, orderBy)
+	if err != nil || !prove {
+		return res, err
+	}
+
+	// Validate the proof of the txs of every height.
+	proofs := make(map[int64]types.TxMultiProof, len(res.Proofs))
+	for _, p := range res.Proofs {
+		if p.Height <= 0 {
+			return nil, coretypes.ErrZeroOrNegativeHeight
+		}
+		if _, ok := proofs[p.Height]; ok {
+			return nil, fmt.Errorf("duplicate proof of txs at height %d", p.Height)
+		}
+
+		// Update the light client if we're behind.
+		l, err := c.updateLightClientIfNeededTo(ctx, &p.Height)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.Proof.Validate(l.DataHash); err != nil {
+			return nil, fmt.Errorf("invalid proof of txs at height %d: %w", p.Height, err)
+		}
+		proofs[p.Height] = p.Proof
+	}
+
+	// Check every tx is proven.
+	for _, tx := range res.Txs {
+		proof, ok := proofs[tx.Height]
+		if !ok {
+			return nil, fmt.Errorf("missing proof of tx %X at height %d", tx.Hash, tx.Height)
+		}
+		i := proof.IndexOf(int64(tx.Index))
+		if i == -1 || !bytes.Equal(proof.Data[i], tx.Tx) {
+			return nil, fmt.Errorf("tx %X is not proven at height %d, index %d", tx.Hash, tx.Height, tx.Index)
+		}
+		if !bytes.Equal(tx.Hash, tx.Tx.Hash()) {
+			return nil, fmt.Errorf("tx hash %X does not match tx %X", tx.Hash, tx.Tx.Hash())
+		}
+	}
+
+	return res, nil
 }
 
 func (c *Client) BlockSearch(
@@ -575,10 +606,34 @@ func (c *Client) Validators(
 	}, nil
 }
 
+func (c *Client) SigningInfo(
+	ctx context.Context,
+	address tmbytes.HexBytes,
+	window *int64,
+) (*coretypes.ResultSigningInfo, error) {
+	return c.next.SigningInfo(ctx, address, window)
+}
+
 func (c *Client) BroadcastEvidence(ctx context.Context, ev types.Evidence) (*coretypes.ResultBroadcastEvidence, error) {
 	return c.next.BroadcastEvidence(ctx, ev)
 }
 
+func (c *Client) PendingEvidence(ctx context.Context, page, perPage *int) (*coretypes.ResultEvidenceList, error) {
+	return c.next.PendingEvidence(ctx, page, perPage)
+}
+
+func (c *Client) Evidence(ctx context.Context, hash tmbytes.HexBytes) (*coretypes.ResultEvidence, error) {
+	return c.next.Evidence(ctx, hash)
+}
+
+func (c *Client) CommittedEvidence(
+	ctx context.Context,
+	minHeight, maxHeight int64,
+	page, perPage *int,
+) (*coretypes.ResultEvidenceList, error) {
+	return c.next.CommittedEvidence(ctx, minHeight, maxHeight, page, perPage)
+}
+
 func (c *Client) Subscribe(ctx context.Context, subscriber, query string,
 	outCapacity ...int) (out <-chan coretypes.ResultEvent, err error) {
 	return c.next.Subscribe(ctx, subscriber, query, outCapacity...)
@@ -63,8 +63,11 @@ func VerifyNonAdjacent(
 	}
 
 	// Ensure that +`trustLevel` (default 1/3) or more in voting power of the last trusted validator
-	// set signed correctly.
-	err := trustedVals.VerifyCommitLightTrusting(trustedHeader.ChainID, untrustedHeader.Commit, trustLevel)
+	// set signed correctly. The keys of untrustedVals, which hash to the
+	// untrusted header's ValidatorsHash, are needed to verify an aggregated
+	// commit.
+	err := trustedVals.VerifyAggregatedCommitLightTrusting(trustedHeader.ChainID, untrustedVals,
+		untrustedHeader.Commit, trustLevel)
 	if err != nil {
 		switch e := err.(type) {
 		case types.ErrNotEnoughVotingPowerSigned:
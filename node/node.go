@@ -105,7 +105,11 @@ func newDefaultNode(
 
 	var pval *privval.FilePV
 	if cfg.Mode == config.ModeValidator {
-		pval, err = privval.LoadOrGenFilePV(cfg.PrivValidator.KeyFile(), cfg.PrivValidator.StateFile())
+		passphrase, err := privval.ReadPassphrase(cfg.PrivValidator.KeyPassphraseFile())
+		if err != nil {
+			return nil, err
+		}
+		pval, err = privval.LoadOrGenFilePV(cfg.PrivValidator.KeyFile(), cfg.PrivValidator.StateFile(), passphrase)
 		if err != nil {
 			return nil, err
 		}
@@ -193,8 +197,16 @@ func makeNode(
 	}
 
 	// If an address is provided, listen on the socket for a connection from an
-	// external signing process.
-	if cfg.PrivValidator.ListenAddr != "" {
+	// external signing process. With several addresses, fail over between the
+	// signers.
+	if addrs := cfg.PrivValidator.ListenAddresses(); len(addrs) > 1 {
+		privValidator, err = createAndStartPrivValidatorFailoverClient(ctx, cfg, addrs, genDoc.ChainID, logger)
+		if err != nil {
+			return nil, combineCloseError(
+				fmt.Errorf("error with private validator failover client: %w", err),
+				makeCloser(closers))
+		}
+	} else if cfg.PrivValidator.ListenAddr != "" {
 		protocol, _ := tmnet.ProtocolAndAddress(cfg.PrivValidator.ListenAddr)
 		// FIXME: we should start services inside OnStart
 		switch protocol {
@@ -243,13 +255,16 @@ func makeNode(
 
 	// Create the handshaker, which calls RequestInfo, sets the AppVersion on the state,
 	// and replays any blocks as necessary to sync tendermint with the app.
+	var appVersion uint64
 	if !stateSync {
-		if err := consensus.NewHandshaker(
+		handshaker := consensus.NewHandshaker(
 			logger.With("module", "handshaker"),
 			stateStore, state, blockStore, eventBus, genDoc,
-		).Handshake(ctx, proxyApp); err != nil {
+		)
+		if err := handshaker.Handshake(ctx, proxyApp); err != nil {
 			return nil, combineCloseError(err, makeCloser(closers))
 		}
+		appVersion = handshaker.AppVersion()
 
 		// Reload the state. It will have the Version.Consensus.App set by the
 		// Handshake, and may have other modifications as well (ie. depending on
@@ -291,9 +306,11 @@ func makeNode(
 			makeCloser(closers))
 	}
 
-	mpReactor, mp, err := createMempoolReactor(ctx,
-		cfg, proxyApp, state, nodeMetrics.mempool, peerManager, router, logger,
+	mpReactor, mp, mpCloser, err := createMempoolReactor(ctx,
+		cfg, dbProvider, proxyApp, state, blockStore, nodeMetrics.mempool, eventBus,
+		peerManager, router, logger,
 	)
+	closers = append(closers, mpCloser)
 	if err != nil {
 		return nil, combineCloseError(err, makeCloser(closers))
 	}
@@ -318,7 +335,7 @@ func makeNode(
 
 	csReactor, csState, err := createConsensusReactor(ctx,
 		cfg, state, blockExec, blockStore, mp, evPool,
-		privValidator, nodeMetrics.consensus, stateSync || blockSync, eventBus,
+		privValidator, appVersion, nodeMetrics.consensus, stateSync || blockSync, eventBus,
 		peerManager, router, logger,
 	)
 	if err != nil {
@@ -439,6 +456,10 @@ func makeNode(
 
 	node.rpcEnv.P2PTransport = node
 
+	if cfg.RPC.SigningWindow > 0 {
+		node.rpcEnv.SigningIndex = store.NewSigningIndex(blockStore, stateStore, cfg.RPC.SigningWindow)
+	}
+
 	node.BaseService = *service.NewBaseService(logger, "Node", node)
 
 	return node, nil
@@ -873,6 +894,25 @@ func (n *nodeImpl) ConsensusReactor() *consensus.Reactor {
 	return n.consensusReactor
 }
 
+// Halted returns a channel that is closed once consensus halted at the halt
+// height or halt time. It implements Halter; for seed nodes the channel is nil
+// and never closes.
+func (n *nodeImpl) Halted() <-chan struct{} {
+	if n.consensusReactor == nil {
+		return nil
+	}
+	return n.consensusReactor.GetConsensusState().Halted()
+}
+
+// HaltError returns a consensus.HaltError once Halted is closed, and nil
+// before. It implements Halter.
+func (n *nodeImpl) HaltError() error {
+	if n.consensusReactor == nil {
+		return nil
+	}
+	return n.consensusReactor.GetConsensusState().HaltError()
+}
+
 // Mempool returns the Node's mempool.
 func (n *nodeImpl) Mempool() mempool.Mempool {
 	return n.mempool
@@ -1053,6 +1093,63 @@ func createAndStartPrivValidatorGRPCClient(
 	return pvsc, nil
 }
 
+func createAndStartPrivValidatorFailoverClient(
+	ctx context.Context,
+	cfg *config.Config,
+	addrs []string,
+	chainID string,
+	logger log.Logger,
+) (types.PrivValidator, error) {
+	signers := make([]privval.FailoverSigner, 0, len(addrs))
+	for _, addr := range addrs {
+		protocol, _ := tmnet.ProtocolAndAddress(addr)
+		switch protocol {
+		case "grpc":
+			pvCfg := *cfg.PrivValidator
+			pvCfg.ListenAddr = addr
+			pvsc, err := tmgrpc.DialRemoteSigner(
+				ctx,
+				&pvCfg,
+				chainID,
+				logger.With("signer", addr),
+				cfg.Instrumentation.Prometheus,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to start private validator %s: %w", addr, err)
+			}
+			signers = append(signers, privval.FailoverSigner{PrivValidator: pvsc, Addr: addr})
+		default:
+			pve, err := privval.NewSignerListener(addr, logger.With("signer", addr))
+			if err != nil {
+				return nil, fmt.Errorf("failed to start private validator %s: %w", addr, err)
+			}
+			pvsc, err := privval.NewSignerClient(ctx, pve, chainID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to start private validator %s: %w", addr, err)
+			}
+			signers = append(signers, privval.FailoverSigner{PrivValidator: pvsc, Addr: addr})
+		}
+	}
+
+	pvsc, err := privval.NewFailoverSignerClient(
+		logger,
+		signers,
+		cfg.PrivValidator.DeadSignerTimeout,
+		cfg.PrivValidator.FailoverStateFile(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start private validator: %w", err)
+	}
+
+	// try to get a pubkey from one of the signers first time
+	_, err = pvsc.GetPubKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("can't get pubkey: %w", err)
+	}
+
+	return pvsc, nil
+}
+
 func getRouterConfig(conf *config.Config, proxyApp proxy.AppConns) p2p.RouterOptions {
 	opts := p2p.RouterOptions{
 		QueueType: conf.P2P.QueueType,
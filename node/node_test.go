@@ -183,6 +183,43 @@ func TestNodeSetPrivValTCP(t *testing.T) {
 	assert.IsType(t, &privval.RetrySignerClient{}, n.PrivValidator())
 }
 
+func TestNodeSetPrivValTCPFailover(t *testing.T) {
+	addr := "tcp://" + testFreeAddr(t)
+	deadAddr := "tcp://" + testFreeAddr(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logger := log.NewTestingLogger(t)
+
+	cfg, err := config.ResetTestRoot("node_priv_val_tcp_failover_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(cfg.RootDir)
+	// the first signer never connects
+	cfg.PrivValidator.ListenAddr = deadAddr + "," + addr
+
+	// the signer stays connected while the node waits for the first one
+	dialer := privval.DialTCPFn(addr, 100*time.Millisecond, ed25519.GenPrivKey())
+	dialerEndpoint := privval.NewSignerDialerEndpoint(logger, dialer)
+
+	signerServer := privval.NewSignerServer(
+		dialerEndpoint,
+		cfg.ChainID(),
+		types.NewMockPV(),
+	)
+
+	go func() {
+		err := signerServer.Start(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}()
+	defer signerServer.Stop() //nolint:errcheck // ignore for tests
+
+	n := getTestNode(ctx, t, cfg, logger)
+	assert.IsType(t, &privval.FailoverSignerClient{}, n.PrivValidator())
+}
+
 // address without a protocol must result in error
 func TestPrivValidatorListenAddrNoProtocol(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -325,9 +362,11 @@ func TestCreateProposalBlock(t *testing.T) {
 
 	commit := types.NewCommit(height-1, 0, types.BlockID{}, nil)
 	block, _, err := blockExec.CreateProposalBlock(
+		ctx,
 		height,
 		state, commit,
 		proposerAddr,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -399,9 +438,11 @@ func TestMaxTxsProposalBlockSize(t *testing.T) {
 
 	commit := types.NewCommit(height-1, 0, types.BlockID{}, nil)
 	block, _, err := blockExec.CreateProposalBlock(
+		ctx,
 		height,
 		state, commit,
 		proposerAddr,
+		nil,
 	)
 	require.NoError(t, err)
 
@@ -508,10 +549,19 @@ func TestMaxProposalBlockSize(t *testing.T) {
 		commit.Signatures = append(commit.Signatures, cs)
 	}
 
+	// save the validator set of the previous height, which the block executor
+	// loads to build the last commit info sent in PrepareProposal.
+	state.LastBlockHeight = math.MaxInt64 - 3
+	state.LastHeightValidatorsChanged = math.MaxInt64 - 1
+	require.NoError(t, stateStore.Save(state))
+	state.LastBlockHeight = math.MaxInt64 - 1
+
 	block, partSet, err := blockExec.CreateProposalBlock(
+		ctx,
 		math.MaxInt64,
 		state, commit,
 		proposerAddr,
+		nil,
 	)
 	require.NoError(t, err)
 
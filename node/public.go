@@ -13,6 +13,15 @@ import (
 	"github.com/tendermint/tendermint/types"
 )
 
+// Halter is implemented by the nodes returned by New and NewDefault. Halted
+// is closed once consensus stopped at the configured halt height or halt
+// time, after which HaltError describes the halt and the node should be
+// stopped so that an upgraded binary can take over.
+type Halter interface {
+	Halted() <-chan struct{}
+	HaltError() error
+}
+
 // NewDefault constructs a tendermint node service for use in go
 // process that host their own process-local tendermint node. This is
 // equivalent to running tendermint in it's own process communicating
@@ -53,7 +62,11 @@ func New(
 
 	switch conf.Mode {
 	case config.ModeFull, config.ModeValidator:
-		pval, err := privval.LoadOrGenFilePV(conf.PrivValidator.KeyFile(), conf.PrivValidator.StateFile())
+		passphrase, err := privval.ReadPassphrase(conf.PrivValidator.KeyPassphraseFile())
+		if err != nil {
+			return nil, err
+		}
+		pval, err := privval.LoadOrGenFilePV(conf.PrivValidator.KeyFile(), conf.PrivValidator.StateFile(), passphrase)
 		if err != nil {
 			return nil, err
 		}
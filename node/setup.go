@@ -168,19 +168,45 @@ func onlyValidatorIsUs(state sm.State, pubKey crypto.PubKey) bool {
 func createMempoolReactor(
 	ctx context.Context,
 	cfg *config.Config,
+	dbProvider config.DBProvider,
 	proxyApp proxy.AppConns,
 	state sm.State,
+	blockStore *store.BlockStore,
 	memplMetrics *mempool.Metrics,
+	eventBus *eventbus.EventBus,
 	peerManager *p2p.PeerManager,
 	router *p2p.Router,
 	logger log.Logger,
-) (service.Service, mempool.Mempool, error) {
+) (service.Service, mempool.Mempool, closer, error) {
 
 	logger = logger.With("module", "mempool")
 
 	ch, err := router.OpenChannel(ctx, mempool.GetChannelDescriptor(cfg.Mempool))
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, func() error { return nil }, err
+	}
+
+	announceCh, err := router.OpenChannel(ctx, mempool.GetAnnounceChannelDescriptor())
+	if err != nil {
+		return nil, nil, func() error { return nil }, err
+	}
+
+	options := []mempool.TxMempoolOption{
+		mempool.WithMetrics(memplMetrics),
+		mempool.WithEventBus(eventBus),
+		mempool.WithPreCheck(sm.TxPreCheck(state)),
+		mempool.WithPostCheck(sm.TxPostCheck(state)),
+	}
+
+	dbCloser := func() error { return nil }
+	if cfg.Mempool.Persist {
+		mempoolDB, err := dbProvider(&config.DBContext{ID: "mempool", Config: cfg})
+		if err != nil {
+			return nil, nil, dbCloser, fmt.Errorf("unable to initialize mempool db: %w", err)
+		}
+
+		dbCloser = mempoolDB.Close
+		options = append(options, mempool.WithDB(mempoolDB))
 	}
 
 	mp := mempool.NewTxMempool(
@@ -188,9 +214,7 @@ func createMempoolReactor(
 		cfg.Mempool,
 		proxyApp.Mempool(),
 		state.LastBlockHeight,
-		mempool.WithMetrics(memplMetrics),
-		mempool.WithPreCheck(sm.TxPreCheck(state)),
-		mempool.WithPostCheck(sm.TxPostCheck(state)),
+		options...,
 	)
 
 	reactor := mempool.NewReactor(
@@ -199,6 +223,7 @@ func createMempoolReactor(
 		peerManager,
 		mp,
 		ch,
+		announceCh,
 		peerManager.Subscribe(ctx),
 	)
 
@@ -206,7 +231,11 @@ func createMempoolReactor(
 		mp.EnableTxsAvailable()
 	}
 
-	return reactor, mp, nil
+	if err := mp.LoadTxs(ctx, blockStore); err != nil {
+		return nil, nil, dbCloser, fmt.Errorf("loading persisted mempool transactions: %w", err)
+	}
+
+	return reactor, mp, dbCloser, nil
 }
 
 func createEvidenceReactor(
@@ -289,6 +318,7 @@ func createConsensusReactor(
 	mp mempool.Mempool,
 	evidencePool *evidence.Pool,
 	privValidator types.PrivValidator,
+	appVersion uint64,
 	csMetrics *consensus.Metrics,
 	waitSync bool,
 	eventBus *eventbus.EventBus,
@@ -307,6 +337,7 @@ func createConsensusReactor(
 		mp,
 		evidencePool,
 		consensus.StateMetrics(csMetrics),
+		consensus.StateAppVersion(appVersion),
 	)
 
 	if privValidator != nil && cfg.Mode == config.ModeValidator {
@@ -332,9 +363,11 @@ func createConsensusReactor(
 		channels[consensus.DataChannel],
 		channels[consensus.VoteChannel],
 		channels[consensus.VoteSetBitsChannel],
+		channels[consensus.CompactBlockChannel],
 		peerManager.Subscribe(ctx),
 		waitSync,
 		consensus.ReactorMetrics(csMetrics),
+		consensus.ReactorMempool(mp),
 	)
 
 	// Services which will be publishing and/or subscribing for messages (events)
@@ -500,7 +533,9 @@ func makeNodeInfo(
 			byte(consensus.DataChannel),
 			byte(consensus.VoteChannel),
 			byte(consensus.VoteSetBitsChannel),
+			byte(consensus.CompactBlockChannel),
 			byte(mempool.MempoolChannel),
+			byte(mempool.MempoolAnnounceChannel),
 			byte(evidence.EvidenceChannel),
 			byte(statesync.SnapshotChannel),
 			byte(statesync.ChunkChannel),
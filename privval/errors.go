@@ -22,6 +22,12 @@ var (
 	ErrWriteTimeout       = errors.New("endpoint write timed out")
 )
 
+// Key file errors.
+var (
+	ErrKeyEncrypted  = errors.New("private validator key file is encrypted, but no passphrase was given")
+	ErrKeyPassphrase = errors.New("wrong passphrase, or the private validator key file was modified")
+)
+
 // RemoteSignerError allows (remote) validators to include meaningful error
 // descriptions in their reply.
 type RemoteSignerError struct {
@@ -0,0 +1,438 @@
+package privval
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/internal/libs/tempfile"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	// failoverRetryInterval is the time between the attempts of a request.
+	failoverRetryInterval = 100 * time.Millisecond
+	// failoverProbeTimeout bounds the time a signer has to answer a health
+	// check.
+	failoverProbeTimeout = time.Second
+)
+
+// ErrSignedByDeadSigner is returned when a signer died after signing for a
+// height/round/step, and it is requested again. Any other signer could sign
+// something different for it, so the request is refused.
+var ErrSignedByDeadSigner = errors.New("the signer that signed for this height/round/step is dead")
+
+// signHRS is the height/round/step of a sign request.
+type signHRS struct {
+	Height int64
+	Round  int32
+	Step   int8
+}
+
+func (hrs signHRS) less(other signHRS) bool {
+	if hrs.Height != other.Height {
+		return hrs.Height < other.Height
+	}
+	if hrs.Round != other.Round {
+		return hrs.Round < other.Round
+	}
+	return hrs.Step < other.Step
+}
+
+// failoverSignerState is the health of a signer.
+type failoverSignerState struct {
+	checked  bool      // whether the signer answered with the validator's key
+	failedAt time.Time // when the signer started failing, zero if it is healthy
+}
+
+// lastSignRequest tracks the last sign request sent to a signer.
+type lastSignRequest struct {
+	hrs    signHRS
+	signer int  // -1 if the request was not sent, or sent to a removed signer
+	signed bool // whether the signer returned a signature
+}
+
+// failoverLastSignState is a lastSignRequest as saved in the state file. The
+// signer is identified by its address, which stays the same when the signers
+// are reordered or removed across restarts.
+type failoverLastSignState struct {
+	Height int64  `json:"height"`
+	Round  int32  `json:"round"`
+	Step   int8   `json:"step"`
+	Signer string `json:"signer"`
+	Signed bool   `json:"signed"`
+}
+
+// FailoverSigner is a remote signer of a FailoverSignerClient.
+type FailoverSigner struct {
+	types.PrivValidator
+
+	// Addr identifies the signer in the state file, and must not change
+	// across restarts.
+	Addr string
+}
+
+// FailoverSignerClient implements PrivValidator over several remote signers
+// holding the same key. It sends requests to one signer at a time, and fails
+// over to the next one when it stops answering.
+//
+// To prevent double signing without sharing state between the signers, a
+// request for the height/round/step (HRS) of the last request is only sent to
+// the signer the last request was sent to, until that signer is confirmed
+// dead: it has failed to answer for the dead timeout. Even then, the request
+// is refused if the dead signer returned a signature for that HRS. Requests
+// for an HRS lower than the last one are refused, so that every signer only
+// sees increasing HRSs.
+//
+// The last request is saved to a state file before it is sent, so that the
+// rules above still hold after a restart. A request that was sent to a signer
+// since removed from the configuration is treated as sent to a dead signer.
+type FailoverSignerClient struct {
+	logger      log.Logger
+	signers     []FailoverSigner
+	deadTimeout time.Duration
+	stateFile   string
+
+	mtx    sync.Mutex
+	active int
+	states []failoverSignerState
+	pubKey crypto.PubKey
+	last   lastSignRequest
+	saved  lastSignRequest // the last request as saved in the state file
+}
+
+var _ types.PrivValidator = (*FailoverSignerClient)(nil)
+
+// NewFailoverSignerClient returns a FailoverSignerClient over the signers, in
+// order of preference. A signer is confirmed dead once it has failed to answer
+// for deadTimeout. The last sign request is loaded from stateFilePath, if it
+// exists, and saved to it.
+func NewFailoverSignerClient(
+	logger log.Logger,
+	signers []FailoverSigner,
+	deadTimeout time.Duration,
+	stateFilePath string,
+) (*FailoverSignerClient, error) {
+	if len(signers) == 0 {
+		return nil, errors.New("no signers")
+	}
+	if stateFilePath == "" {
+		return nil, errors.New("no state file")
+	}
+
+	sc := &FailoverSignerClient{
+		logger:      logger,
+		signers:     signers,
+		deadTimeout: deadTimeout,
+		stateFile:   stateFilePath,
+		states:      make([]failoverSignerState, len(signers)),
+		last:        lastSignRequest{signer: -1},
+	}
+	if err := sc.loadLast(); err != nil {
+		return nil, err
+	}
+	return sc, nil
+}
+
+// Close closes the signers.
+func (sc *FailoverSignerClient) Close() error {
+	var firstErr error
+	for _, signer := range sc.signers {
+		if closer, ok := signer.PrivValidator.(io.Closer); ok {
+			if err := closer.Close(); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+//--------------------------------------------------------
+// Implement PrivValidator
+
+// GetPubKey returns the public key of the first signer that answers. All the
+// signers are expected to have the same key; a signer answering with another
+// one is treated as failed.
+func (sc *FailoverSignerClient) GetPubKey(ctx context.Context) (crypto.PubKey, error) {
+	for {
+		if pubKey, err := sc.getPubKey(ctx); err == nil {
+			return pubKey, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("exhausted all attempts to get pubkey: %w", ctx.Err())
+		case <-time.After(failoverRetryInterval):
+		}
+	}
+}
+
+// getPubKey returns the public key, probing the signers for it if it is not
+// known yet.
+func (sc *FailoverSignerClient) getPubKey(ctx context.Context) (crypto.PubKey, error) {
+	sc.mtx.Lock()
+	defer sc.mtx.Unlock()
+
+	if sc.pubKey != nil {
+		return sc.pubKey, nil
+	}
+	if _, err := sc.pickSigner(ctx); err != nil {
+		return nil, err
+	}
+	return sc.pubKey, nil
+}
+
+// SignVote signs the vote with the active signer, failing over to the next
+// one if it is allowed to.
+func (sc *FailoverSignerClient) SignVote(ctx context.Context, chainID string, vote *tmproto.Vote) error {
+	step, err := voteToStep(vote)
+	if err != nil {
+		return err
+	}
+	hrs := signHRS{Height: vote.Height, Round: vote.Round, Step: step}
+	err = sc.sign(ctx, hrs, func(signer types.PrivValidator) error {
+		return signer.SignVote(ctx, chainID, vote)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to sign vote: %w", err)
+	}
+	return nil
+}
+
+// SignProposal signs the proposal with the active signer, failing over to
+// the next one if it is allowed to.
+func (sc *FailoverSignerClient) SignProposal(ctx context.Context, chainID string, proposal *tmproto.Proposal) error {
+	hrs := signHRS{Height: proposal.Height, Round: proposal.Round, Step: stepPropose}
+	err := sc.sign(ctx, hrs, func(signer types.PrivValidator) error {
+		return signer.SignProposal(ctx, chainID, proposal)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to sign proposal: %w", err)
+	}
+	return nil
+}
+
+// sign sends a sign request for hrs to a signer it may go to, retrying until
+// it succeeds, a signer refuses it, or the context is done. The lock is
+// released between the attempts, so that other callers aren't blocked.
+func (sc *FailoverSignerClient) sign(ctx context.Context, hrs signHRS, sign func(types.PrivValidator) error) error {
+	for {
+		done, err := sc.trySign(ctx, hrs, sign)
+		if done {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("exhausted all attempts: %w", err)
+		case <-time.After(failoverRetryInterval):
+		}
+	}
+}
+
+// trySign makes one attempt of sign. It returns whether the request is done,
+// i.e. it was signed or must not be retried, and the error if any.
+func (sc *FailoverSignerClient) trySign(
+	ctx context.Context,
+	hrs signHRS,
+	sign func(types.PrivValidator) error,
+) (bool, error) {
+	sc.mtx.Lock()
+	defer sc.mtx.Unlock()
+
+	// Another request may have been sent since the last attempt.
+	if hrs.less(sc.last.hrs) {
+		return true, fmt.Errorf("height/round/step regression: got %v, last %v", hrs, sc.last.hrs)
+	}
+	if hrs != sc.last.hrs {
+		sc.last = lastSignRequest{hrs: hrs, signer: -1}
+	}
+
+	i, err := sc.pickSignerFor(ctx, hrs)
+	if errors.Is(err, ErrSignedByDeadSigner) {
+		return true, err
+	}
+	if err != nil {
+		return false, err
+	}
+
+	prev := sc.last
+	if i != sc.last.signer {
+		sc.last.signer, sc.last.signed = i, false
+	}
+	// The request is saved before it is sent, so that after a restart a
+	// request for the same HRS still only goes to this signer.
+	if err := sc.saveLast(); err != nil {
+		return true, fmt.Errorf("failed to save failover signer state: %w", err)
+	}
+
+	err = sign(sc.signers[i])
+	if err == nil {
+		sc.states[i].failedAt = time.Time{}
+		sc.last.signed = true
+		if err := sc.saveLast(); err != nil {
+			return true, fmt.Errorf("failed to save failover signer state: %w", err)
+		}
+		return true, nil
+	}
+	// If remote signer errors, it is alive and did not sign, and we don't
+	// retry.
+	if _, ok := err.(*RemoteSignerError); ok {
+		sc.states[i].failedAt = time.Time{}
+		return true, err
+	}
+	// If there was no connection to send the request on, the signer can't
+	// have signed it. The state file is left as is, which is only more
+	// restrictive.
+	if errors.Is(err, ErrConnectionTimeout) {
+		sc.last = prev
+	}
+	sc.markFailed(i)
+	sc.logger.Error("signer failed to sign", "signer", sc.signers[i].Addr, "height", hrs.Height,
+		"round", hrs.Round, "step", hrs.Step, "err", err)
+	return false, err
+}
+
+// pickSignerFor returns the signer a sign request for hrs may be sent to.
+func (sc *FailoverSignerClient) pickSignerFor(ctx context.Context, hrs signHRS) (int, error) {
+	if hrs == sc.last.hrs && sc.last.signer >= 0 {
+		i := sc.last.signer
+		if sc.isHealthy(i) || sc.probe(ctx, i) {
+			return i, nil
+		}
+		if !sc.isDead(i) {
+			return -1, fmt.Errorf("signer %s failed for %v, and is not confirmed dead yet",
+				sc.signers[i].Addr, hrs)
+		}
+		if sc.last.signed {
+			return -1, ErrSignedByDeadSigner
+		}
+	}
+	if hrs == sc.last.hrs && sc.last.signed {
+		// signed by a signer that was removed
+		return -1, ErrSignedByDeadSigner
+	}
+	return sc.pickSigner(ctx)
+}
+
+// pickSigner returns the active signer if it is healthy, and otherwise makes
+// the next signer that answers the active one.
+func (sc *FailoverSignerClient) pickSigner(ctx context.Context) (int, error) {
+	for k := 0; k < len(sc.signers); k++ {
+		i := (sc.active + k) % len(sc.signers)
+		if sc.isHealthy(i) || sc.probe(ctx, i) {
+			if i != sc.active {
+				sc.logger.Info("failing over to signer",
+					"from", sc.signers[sc.active].Addr, "to", sc.signers[i].Addr)
+				sc.active = i
+			}
+			return i, nil
+		}
+	}
+	return -1, errors.New("no signer is available")
+}
+
+// probe checks that signer i answers with the public key of the validator,
+// and updates its health.
+func (sc *FailoverSignerClient) probe(ctx context.Context, i int) bool {
+	ctx, cancel := context.WithTimeout(ctx, failoverProbeTimeout)
+	defer cancel()
+
+	pubKey, err := sc.signers[i].GetPubKey(ctx)
+	if err != nil {
+		sc.markFailed(i)
+		return false
+	}
+	if sc.pubKey != nil && !sc.pubKey.Equals(pubKey) {
+		sc.logger.Error("signer has a different key", "signer", sc.signers[i].Addr,
+			"pubKey", pubKey, "expected", sc.pubKey)
+		sc.markFailed(i)
+		return false
+	}
+
+	sc.pubKey = pubKey
+	sc.states[i] = failoverSignerState{checked: true}
+	return true
+}
+
+func (sc *FailoverSignerClient) markFailed(i int) {
+	if sc.states[i].failedAt.IsZero() {
+		sc.states[i].failedAt = time.Now()
+	}
+}
+
+// isHealthy returns whether signer i answered with the validator's key, and
+// has not failed since.
+func (sc *FailoverSignerClient) isHealthy(i int) bool {
+	return sc.states[i].checked && sc.states[i].failedAt.IsZero()
+}
+
+// isDead returns whether signer i has been failing for the dead timeout.
+func (sc *FailoverSignerClient) isDead(i int) bool {
+	failedAt := sc.states[i].failedAt
+	return !failedAt.IsZero() && time.Since(failedAt) >= sc.deadTimeout
+}
+
+// loadLast loads the last sign request from the state file, if it exists.
+func (sc *FailoverSignerClient) loadLast() error {
+	jsonBytes, err := os.ReadFile(sc.stateFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var state failoverLastSignState
+	if err := tmjson.Unmarshal(jsonBytes, &state); err != nil {
+		return fmt.Errorf("error reading failover signer state from %v: %w", sc.stateFile, err)
+	}
+
+	sc.last = lastSignRequest{
+		hrs:    signHRS{Height: state.Height, Round: state.Round, Step: state.Step},
+		signer: -1,
+		signed: state.Signed,
+	}
+	for i, signer := range sc.signers {
+		if signer.Addr == state.Signer {
+			sc.last.signer = i
+			sc.active = i
+			break
+		}
+	}
+	sc.saved = sc.last
+	return nil
+}
+
+// saveLast saves the last sign request to the state file, unless it is
+// already saved.
+func (sc *FailoverSignerClient) saveLast() error {
+	if sc.last == sc.saved {
+		return nil
+	}
+
+	state := failoverLastSignState{
+		Height: sc.last.hrs.Height,
+		Round:  sc.last.hrs.Round,
+		Step:   sc.last.hrs.Step,
+		Signer: sc.signers[sc.last.signer].Addr,
+		Signed: sc.last.signed,
+	}
+	jsonBytes, err := tmjson.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := tempfile.WriteFileAtomic(sc.stateFile, jsonBytes, 0600); err != nil {
+		return err
+	}
+	sc.saved = sc.last
+	return nil
+}
@@ -0,0 +1,280 @@
+package privval
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+// testFailoverSigner is a signer that can be taken down, and can lose the
+// responses to its sign requests.
+type testFailoverSigner struct {
+	types.MockPV
+	addr string
+
+	mtx          sync.Mutex
+	down         bool
+	dropResponse bool
+	signs        int
+}
+
+func newTestFailoverSigner(addr string, privKey crypto.PrivKey) *testFailoverSigner {
+	return &testFailoverSigner{MockPV: types.NewMockPVWithParams(privKey, false, false), addr: addr}
+}
+
+func (s *testFailoverSigner) setDown(down bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.down = down
+}
+
+func (s *testFailoverSigner) setDropResponse(dropResponse bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.dropResponse = dropResponse
+}
+
+func (s *testFailoverSigner) signCount() int {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.signs
+}
+
+func (s *testFailoverSigner) GetPubKey(ctx context.Context) (crypto.PubKey, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.down {
+		return nil, ErrConnectionTimeout
+	}
+	return s.MockPV.GetPubKey(ctx)
+}
+
+func (s *testFailoverSigner) SignVote(ctx context.Context, chainID string, vote *tmproto.Vote) error {
+	return s.sign(func() error { return s.MockPV.SignVote(ctx, chainID, vote) })
+}
+
+func (s *testFailoverSigner) SignProposal(ctx context.Context, chainID string, proposal *tmproto.Proposal) error {
+	return s.sign(func() error { return s.MockPV.SignProposal(ctx, chainID, proposal) })
+}
+
+func (s *testFailoverSigner) sign(sign func() error) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.down {
+		return ErrConnectionTimeout
+	}
+	if err := sign(); err != nil {
+		return err
+	}
+	s.signs++
+	if s.dropResponse {
+		return ErrReadTimeout
+	}
+	return nil
+}
+
+func newTestFailoverSignerClient(
+	t *testing.T,
+	stateFile string,
+	deadTimeout time.Duration,
+	signers ...*testFailoverSigner,
+) *FailoverSignerClient {
+	fss := make([]FailoverSigner, len(signers))
+	for i, signer := range signers {
+		fss[i] = FailoverSigner{PrivValidator: signer, Addr: signer.addr}
+	}
+	sc, err := NewFailoverSignerClient(log.NewNopLogger(), fss, deadTimeout, stateFile)
+	require.NoError(t, err)
+	return sc
+}
+
+func signTestVote(ctx context.Context, sc *FailoverSignerClient, height int64, round int32,
+	typ tmproto.SignedMsgType, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	blockID := types.BlockID{Hash: tmrand.Bytes(tmhash.Size), PartSetHeader: types.PartSetHeader{}}
+	vote := newVote(tmrand.Bytes(crypto.AddressSize), 0, height, round, typ, blockID)
+	return sc.SignVote(ctx, "mychainid", vote.ToProto())
+}
+
+func TestFailoverSignerClientFailover(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	privKey := ed25519.GenPrivKey()
+	signer0, signer1 := newTestFailoverSigner("a", privKey), newTestFailoverSigner("b", privKey)
+	sc := newTestFailoverSignerClient(t, filepath.Join(t.TempDir(), "state.json"), time.Hour, signer0, signer1)
+
+	pubKey, err := sc.GetPubKey(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, privKey.PubKey(), pubKey)
+
+	require.NoError(t, signTestVote(ctx, sc, 1, 0, tmproto.PrevoteType, time.Second))
+	assert.Equal(t, 1, signer0.signCount())
+
+	// a request that could not be sent to the dead signer goes to the next one
+	signer0.setDown(true)
+	require.NoError(t, signTestVote(ctx, sc, 1, 0, tmproto.PrecommitType, time.Second))
+	assert.Equal(t, 1, signer0.signCount())
+	assert.Equal(t, 1, signer1.signCount())
+
+	// and the client doesn't fail back once the first signer is up again
+	signer0.setDown(false)
+	blockID := types.BlockID{Hash: tmrand.Bytes(tmhash.Size), PartSetHeader: types.PartSetHeader{}}
+	proposal := newProposal(2, 0, blockID).ToProto()
+	require.NoError(t, sc.SignProposal(ctx, "mychainid", proposal))
+	assert.Equal(t, 2, signer1.signCount())
+	assert.True(t, privKey.PubKey().VerifySignature(
+		types.ProposalSignBytes("mychainid", proposal), proposal.Signature))
+
+	// requests for an earlier height/round/step are refused
+	assert.Error(t, signTestVote(ctx, sc, 1, 0, tmproto.PrecommitType, time.Second))
+	assert.Equal(t, 1, signer0.signCount())
+	assert.Equal(t, 2, signer1.signCount())
+}
+
+func TestFailoverSignerClientSameHRS(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const deadTimeout = 500 * time.Millisecond
+
+	privKey := ed25519.GenPrivKey()
+	signer0, signer1 := newTestFailoverSigner("a", privKey), newTestFailoverSigner("b", privKey)
+	sc := newTestFailoverSignerClient(t, filepath.Join(t.TempDir(), "state.json"), deadTimeout, signer0, signer1)
+
+	// the first signer may have signed before it died
+	signer0.setDropResponse(true)
+	err := signTestVote(ctx, sc, 1, 0, tmproto.PrevoteType, 100*time.Millisecond)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrReadTimeout), err)
+	signer0.setDown(true)
+	signs := signer0.signCount()
+
+	// so the request isn't sent to another signer until it is confirmed dead
+	require.Error(t, signTestVote(ctx, sc, 1, 0, tmproto.PrevoteType, deadTimeout/2))
+	assert.Equal(t, 0, signer1.signCount())
+
+	// its signature was never received, so another signer may sign then
+	require.NoError(t, signTestVote(ctx, sc, 1, 0, tmproto.PrevoteType, 2*deadTimeout))
+	assert.Equal(t, 1, signer1.signCount())
+
+	// the second signer signed, and dies: it can't be signed again by the
+	// first one
+	signer0.setDown(false)
+	signer0.setDropResponse(false)
+	signer1.setDown(true)
+	err = signTestVote(ctx, sc, 1, 0, tmproto.PrevoteType, 4*deadTimeout)
+	assert.True(t, errors.Is(err, ErrSignedByDeadSigner), err)
+	assert.Equal(t, signs, signer0.signCount())
+
+	// but a later height/round/step may be
+	require.NoError(t, signTestVote(ctx, sc, 1, 0, tmproto.PrecommitType, time.Second))
+	assert.Equal(t, signs+1, signer0.signCount())
+}
+
+func TestFailoverSignerClientDifferentKey(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	signer0 := newTestFailoverSigner("a", ed25519.GenPrivKey())
+	signer1 := newTestFailoverSigner("b", ed25519.GenPrivKey())
+	sc := newTestFailoverSignerClient(t, filepath.Join(t.TempDir(), "state.json"), time.Hour, signer0, signer1)
+
+	pubKey, err := sc.GetPubKey(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, signer0.PrivKey.PubKey(), pubKey)
+
+	// a signer with another key is never used
+	signer0.setDown(true)
+	assert.Error(t, signTestVote(ctx, sc, 1, 0, tmproto.PrevoteType, 300*time.Millisecond))
+	assert.Equal(t, 0, signer1.signCount())
+}
+
+func TestFailoverSignerClientRestart(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const deadTimeout = 500 * time.Millisecond
+
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+	privKey := ed25519.GenPrivKey()
+	signer0, signer1 := newTestFailoverSigner("a", privKey), newTestFailoverSigner("b", privKey)
+	sc := newTestFailoverSignerClient(t, stateFile, deadTimeout, signer0, signer1)
+
+	// the first signer may have signed before it died
+	signer0.setDropResponse(true)
+	require.Error(t, signTestVote(ctx, sc, 1, 0, tmproto.PrevoteType, 100*time.Millisecond))
+	signer0.setDown(true)
+
+	// after a restart with the signers reordered, the request still isn't
+	// sent to the other signer until the first one is confirmed dead
+	sc = newTestFailoverSignerClient(t, stateFile, deadTimeout, signer1, signer0)
+	require.Error(t, signTestVote(ctx, sc, 1, 0, tmproto.PrevoteType, deadTimeout/2))
+	assert.Equal(t, 0, signer1.signCount())
+	require.NoError(t, signTestVote(ctx, sc, 1, 0, tmproto.PrevoteType, 2*deadTimeout))
+	assert.Equal(t, 1, signer1.signCount())
+
+	// after a restart without the signer that signed, the request is refused
+	signer0.setDown(false)
+	signer0.setDropResponse(false)
+	signs := signer0.signCount()
+	sc = newTestFailoverSignerClient(t, stateFile, deadTimeout, signer0)
+	err := signTestVote(ctx, sc, 1, 0, tmproto.PrevoteType, time.Second)
+	assert.True(t, errors.Is(err, ErrSignedByDeadSigner), err)
+	assert.Equal(t, signs, signer0.signCount())
+
+	// and so is a request for an earlier height/round/step
+	blockID := types.BlockID{Hash: tmrand.Bytes(tmhash.Size), PartSetHeader: types.PartSetHeader{}}
+	assert.Error(t, sc.SignProposal(ctx, "mychainid", newProposal(1, 0, blockID).ToProto()))
+	assert.Equal(t, signs, signer0.signCount())
+
+	// but a later height/round/step may be signed
+	require.NoError(t, signTestVote(ctx, sc, 1, 0, tmproto.PrecommitType, time.Second))
+	assert.Equal(t, signs+1, signer0.signCount())
+}
+
+func TestFailoverSignerClientRetryUnlocked(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	privKey := ed25519.GenPrivKey()
+	signer0, signer1 := newTestFailoverSigner("a", privKey), newTestFailoverSigner("b", privKey)
+	sc := newTestFailoverSignerClient(t, filepath.Join(t.TempDir(), "state.json"), time.Hour, signer0, signer1)
+
+	signer0.setDropResponse(true)
+	require.Error(t, signTestVote(ctx, sc, 1, 0, tmproto.PrevoteType, 100*time.Millisecond))
+	signer0.setDown(true)
+
+	// the request is retried until the first signer is confirmed dead
+	done := make(chan error, 1)
+	go func() {
+		done <- signTestVote(ctx, sc, 1, 0, tmproto.PrevoteType, 2*time.Second)
+	}()
+
+	// which doesn't block other callers
+	time.Sleep(300 * time.Millisecond)
+	start := time.Now()
+	pubKey, err := sc.GetPubKey(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, privKey.PubKey(), pubKey)
+	assert.Less(t, time.Since(start), time.Second)
+
+	require.Error(t, <-done)
+	assert.Equal(t, 0, signer1.signCount())
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/internal/libs/protoio"
@@ -51,19 +52,42 @@ type FilePVKey struct {
 	PubKey  crypto.PubKey  `json:"pub_key"`
 	PrivKey crypto.PrivKey `json:"priv_key"`
 
-	filePath string
+	filePath   string
+	passphrase []byte
+}
+
+// SetPassphrase sets the passphrase the FilePVKey is encrypted with when it
+// is saved. With an empty passphrase, it is saved in plaintext.
+func (pvKey *FilePVKey) SetPassphrase(passphrase []byte) {
+	pvKey.passphrase = passphrase
 }
 
-// Save persists the FilePVKey to its filePath.
+// Save persists the FilePVKey to its filePath, encrypted as an
+// EncryptedFilePVKey if it has a passphrase.
 func (pvKey FilePVKey) Save() error {
 	outFile := pvKey.filePath
 	if outFile == "" {
 		return errors.New("cannot save PrivValidator key: filePath not set")
 	}
 
-	jsonBytes, err := tmjson.MarshalIndent(pvKey, "", "  ")
-	if err != nil {
-		return err
+	var (
+		jsonBytes []byte
+		err       error
+	)
+	if len(pvKey.passphrase) > 0 {
+		epvKey, err := EncryptFilePVKey(pvKey, pvKey.passphrase)
+		if err != nil {
+			return fmt.Errorf("cannot encrypt PrivValidator key: %w", err)
+		}
+		jsonBytes, err = tmjson.MarshalIndent(epvKey, "", "  ")
+		if err != nil {
+			return err
+		}
+	} else {
+		jsonBytes, err = tmjson.MarshalIndent(pvKey, "", "  ")
+		if err != nil {
+			return err
+		}
 	}
 	return tempfile.WriteFileAtomic(outFile, jsonBytes, 0600)
 }
@@ -171,6 +195,8 @@ func GenFilePV(keyFilePath, stateFilePath, keyType string) (*FilePV, error) {
 	switch keyType {
 	case types.ABCIPubKeyTypeSecp256k1:
 		return NewFilePV(secp256k1.GenPrivKey(), keyFilePath, stateFilePath), nil
+	case types.ABCIPubKeyTypeBls12381:
+		return NewFilePV(bls12381.GenPrivKey(), keyFilePath, stateFilePath), nil
 	case "", types.ABCIPubKeyTypeEd25519:
 		return NewFilePV(ed25519.GenPrivKey(), keyFilePath, stateFilePath), nil
 	default:
@@ -180,27 +206,44 @@ func GenFilePV(keyFilePath, stateFilePath, keyType string) (*FilePV, error) {
 
 // LoadFilePV loads a FilePV from the filePaths.  The FilePV handles double
 // signing prevention by persisting data to the stateFilePath.  If either file path
-// does not exist, the program will exit.
-func LoadFilePV(keyFilePath, stateFilePath string) (*FilePV, error) {
-	return loadFilePV(keyFilePath, stateFilePath, true)
+// does not exist, the program will exit. The passphrase is needed if the key
+// file is encrypted, and is ignored otherwise.
+func LoadFilePV(keyFilePath, stateFilePath string, passphrase []byte) (*FilePV, error) {
+	return loadFilePV(keyFilePath, stateFilePath, passphrase, true)
 }
 
 // LoadFilePVEmptyState loads a FilePV from the given keyFilePath, with an empty LastSignState.
 // If the keyFilePath does not exist, the program will exit.
-func LoadFilePVEmptyState(keyFilePath, stateFilePath string) (*FilePV, error) {
-	return loadFilePV(keyFilePath, stateFilePath, false)
+func LoadFilePVEmptyState(keyFilePath, stateFilePath string, passphrase []byte) (*FilePV, error) {
+	return loadFilePV(keyFilePath, stateFilePath, passphrase, false)
 }
 
 // If loadState is true, we load from the stateFilePath. Otherwise, we use an empty LastSignState.
-func loadFilePV(keyFilePath, stateFilePath string, loadState bool) (*FilePV, error) {
+func loadFilePV(keyFilePath, stateFilePath string, passphrase []byte, loadState bool) (*FilePV, error) {
 	keyJSONBytes, err := os.ReadFile(keyFilePath)
 	if err != nil {
 		return nil, err
 	}
 	pvKey := FilePVKey{}
-	err = tmjson.Unmarshal(keyJSONBytes, &pvKey)
-	if err != nil {
-		return nil, fmt.Errorf("error reading PrivValidator key from %v: %w", keyFilePath, err)
+	if isEncryptedFilePVKey(keyJSONBytes) {
+		if len(passphrase) == 0 {
+			return nil, fmt.Errorf("error reading PrivValidator key from %v: %w", keyFilePath, ErrKeyEncrypted)
+		}
+		var epvKey EncryptedFilePVKey
+		if err := tmjson.Unmarshal(keyJSONBytes, &epvKey); err != nil {
+			return nil, fmt.Errorf("error reading PrivValidator key from %v: %w", keyFilePath, err)
+		}
+		pvKey.PrivKey, err = epvKey.Decrypt(passphrase)
+		if err != nil {
+			return nil, fmt.Errorf("error decrypting PrivValidator key from %v: %w", keyFilePath, err)
+		}
+		// keep the key encrypted when it is saved again
+		pvKey.passphrase = passphrase
+	} else {
+		err = tmjson.Unmarshal(keyJSONBytes, &pvKey)
+		if err != nil {
+			return nil, fmt.Errorf("error reading PrivValidator key from %v: %w", keyFilePath, err)
+		}
 	}
 
 	// overwrite pubkey and address for convenience
@@ -230,10 +273,11 @@ func loadFilePV(keyFilePath, stateFilePath string, loadState bool) (*FilePV, err
 }
 
 // LoadOrGenFilePV loads a FilePV from the given filePaths
-// or else generates a new one and saves it to the filePaths.
-func LoadOrGenFilePV(keyFilePath, stateFilePath string) (*FilePV, error) {
+// or else generates a new one and saves it to the filePaths. A generated key
+// is encrypted with the passphrase, unless it is empty.
+func LoadOrGenFilePV(keyFilePath, stateFilePath string, passphrase []byte) (*FilePV, error) {
 	if tmos.FileExists(keyFilePath) {
-		pv, err := LoadFilePV(keyFilePath, stateFilePath)
+		pv, err := LoadFilePV(keyFilePath, stateFilePath, passphrase)
 		if err != nil {
 			return nil, err
 		}
@@ -243,6 +287,7 @@ func LoadOrGenFilePV(keyFilePath, stateFilePath string) (*FilePV, error) {
 	if err != nil {
 		return nil, err
 	}
+	pv.Key.SetPassphrase(passphrase)
 
 	if err := pv.Save(); err != nil {
 		return nil, err
@@ -334,6 +379,20 @@ func (pv *FilePV) signVote(chainID string, vote *tmproto.Vote) error {
 
 	signBytes := types.VoteSignBytes(chainID, vote)
 
+	// Vote extensions are non-deterministic, so the application may produce a
+	// different extension when we re-sign after a crash. They are therefore not
+	// subject to the double-sign protection below, and the extension of a
+	// precommit is always (re-)signed.
+	var extSig []byte
+	if vote.Type == tmproto.PrecommitType {
+		extSig, err = pv.Key.PrivKey.Sign(types.VoteExtensionSignBytes(chainID, vote))
+		if err != nil {
+			return err
+		}
+	} else if len(vote.Extension) > 0 {
+		return errors.New("unexpected vote extension - vote extensions are only allowed in precommits")
+	}
+
 	// We might crash before writing to the wal,
 	// causing us to try to re-sign for the same HRS.
 	// If signbytes are the same, use the last signature.
@@ -353,6 +412,7 @@ func (pv *FilePV) signVote(chainID string, vote *tmproto.Vote) error {
 
 			vote.Timestamp = timestamp
 			vote.Signature = lss.Signature
+			vote.ExtensionSignature = extSig
 			return nil
 		}
 	}
@@ -366,6 +426,7 @@ func (pv *FilePV) signVote(chainID string, vote *tmproto.Vote) error {
 		return err
 	}
 	vote.Signature = sig
+	vote.ExtensionSignature = extSig
 	return nil
 }
 
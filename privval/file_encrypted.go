@@ -0,0 +1,201 @@
+package privval
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/xchacha20poly1305"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/types"
+)
+
+// PassphraseEnvVar is the environment variable the passphrase of an encrypted
+// private validator key file is read from, if no passphrase file is given.
+const PassphraseEnvVar = "TM_PRIV_VALIDATOR_KEY_PASSPHRASE"
+
+const (
+	keyKDFScrypt               = "scrypt"
+	keyCipherXChaCha20Poly1305 = "xchacha20poly1305"
+
+	// scrypt parameters of newly encrypted keys. Deriving a key takes about
+	// 32MB of memory.
+	scryptN        = 1 << 15
+	scryptR        = 8
+	scryptP        = 1
+	scryptSaltSize = 32
+
+	// maxScryptMemory bounds the memory, 128*N*r*p bytes, that decrypting a
+	// key file may take.
+	maxScryptMemory = 1 << 30
+)
+
+// EncryptedFilePVKey is the format of a private validator key file whose
+// private key is encrypted with a passphrase. The address and public key are
+// stored in the clear, and are authenticated by the encryption.
+type EncryptedFilePVKey struct {
+	Address types.Address `json:"address"`
+	PubKey  crypto.PubKey `json:"pub_key"`
+	Crypto  KeyCrypto     `json:"crypto"`
+}
+
+// KeyCrypto holds the encrypted private key, and the parameters needed to
+// derive the key it is encrypted with from the passphrase.
+type KeyCrypto struct {
+	KDF        string       `json:"kdf"`
+	KDFParams  ScryptParams `json:"kdf_params"`
+	Cipher     string       `json:"cipher"`
+	Nonce      []byte       `json:"nonce"`
+	Ciphertext []byte       `json:"ciphertext"`
+}
+
+// ScryptParams are the parameters of the scrypt key derivation function.
+type ScryptParams struct {
+	N    int    `json:"n"`
+	R    int    `json:"r"`
+	P    int    `json:"p"`
+	Salt []byte `json:"salt"`
+}
+
+// EncryptFilePVKey encrypts the private key of pvKey with a key derived from
+// the passphrase using scrypt, and XChaCha20-Poly1305.
+func EncryptFilePVKey(pvKey FilePVKey, passphrase []byte) (*EncryptedFilePVKey, error) {
+	if len(passphrase) == 0 {
+		return nil, errors.New("empty passphrase")
+	}
+
+	plaintext, err := tmjson.Marshal(pvKey.PrivKey)
+	if err != nil {
+		return nil, err
+	}
+
+	params := ScryptParams{
+		N:    scryptN,
+		R:    scryptR,
+		P:    scryptP,
+		Salt: make([]byte, scryptSaltSize),
+	}
+	if _, err := rand.Read(params.Salt); err != nil {
+		return nil, err
+	}
+	aead, err := params.newAEAD(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, xchacha20poly1305.NonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	address := pvKey.PrivKey.PubKey().Address()
+	return &EncryptedFilePVKey{
+		Address: address,
+		PubKey:  pvKey.PrivKey.PubKey(),
+		Crypto: KeyCrypto{
+			KDF:        keyKDFScrypt,
+			KDFParams:  params,
+			Cipher:     keyCipherXChaCha20Poly1305,
+			Nonce:      nonce,
+			Ciphertext: aead.Seal(nil, nonce, plaintext, address),
+		},
+	}, nil
+}
+
+// Decrypt decrypts the private key with the passphrase. It returns an error
+// if the passphrase is wrong, or if the key file was tampered with.
+func (epvKey *EncryptedFilePVKey) Decrypt(passphrase []byte) (crypto.PrivKey, error) {
+	c := epvKey.Crypto
+	if c.KDF != keyKDFScrypt {
+		return nil, fmt.Errorf("unsupported key derivation function %q", c.KDF)
+	}
+	if c.Cipher != keyCipherXChaCha20Poly1305 {
+		return nil, fmt.Errorf("unsupported cipher %q", c.Cipher)
+	}
+	if len(c.Nonce) != xchacha20poly1305.NonceSize {
+		return nil, fmt.Errorf("expected nonce size to be %d, got %d", xchacha20poly1305.NonceSize, len(c.Nonce))
+	}
+	if err := c.KDFParams.validate(); err != nil {
+		return nil, err
+	}
+
+	aead, err := c.KDFParams.newAEAD(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := aead.Open(nil, c.Nonce, c.Ciphertext, epvKey.Address)
+	if err != nil {
+		return nil, ErrKeyPassphrase
+	}
+
+	var privKey crypto.PrivKey
+	if err := tmjson.Unmarshal(plaintext, &privKey); err != nil {
+		return nil, fmt.Errorf("decoding private key: %w", err)
+	}
+	if !bytes.Equal(privKey.PubKey().Address(), epvKey.Address) {
+		return nil, errors.New("address does not match the private key")
+	}
+	return privKey, nil
+}
+
+func (sp ScryptParams) validate() error {
+	if sp.N <= 1 || sp.N&(sp.N-1) != 0 {
+		return fmt.Errorf("scrypt N must be a power of 2 greater than 1, got %d", sp.N)
+	}
+	if sp.R <= 0 || sp.P <= 0 {
+		return fmt.Errorf("scrypt r and p must be positive, got %d and %d", sp.R, sp.P)
+	}
+	if uint64(128)*uint64(sp.N)*uint64(sp.R)*uint64(sp.P) > maxScryptMemory {
+		return fmt.Errorf("scrypt parameters N=%d r=%d p=%d require too much memory", sp.N, sp.R, sp.P)
+	}
+	if len(sp.Salt) == 0 {
+		return errors.New("empty scrypt salt")
+	}
+	return nil
+}
+
+func (sp ScryptParams) newAEAD(passphrase []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key(passphrase, sp.Salt, sp.N, sp.R, sp.P, xchacha20poly1305.KeySize)
+	if err != nil {
+		return nil, err
+	}
+	return xchacha20poly1305.New(key)
+}
+
+// isEncryptedFilePVKey returns whether the contents of a key file are an
+// EncryptedFilePVKey.
+func isEncryptedFilePVKey(jsonBytes []byte) bool {
+	var probe struct {
+		Crypto json.RawMessage `json:"crypto"`
+	}
+	return json.Unmarshal(jsonBytes, &probe) == nil && len(probe.Crypto) > 0
+}
+
+// ReadPassphrase reads the passphrase of a private validator key file from
+// passphraseFile, ignoring a trailing newline. If passphraseFile is empty, it
+// reads it from the PassphraseEnvVar environment variable instead, and
+// returns nil if that is not set either.
+func ReadPassphrase(passphraseFile string) ([]byte, error) {
+	if passphraseFile == "" {
+		if passphrase := os.Getenv(PassphraseEnvVar); passphrase != "" {
+			return []byte(passphrase), nil
+		}
+		return nil, nil
+	}
+
+	bz, err := os.ReadFile(passphraseFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading passphrase file: %w", err)
+	}
+	passphrase := bytes.TrimSuffix(bytes.TrimSuffix(bz, []byte("\n")), []byte("\r"))
+	if len(passphrase) == 0 {
+		return nil, fmt.Errorf("passphrase file %s is empty", passphraseFile)
+	}
+	return passphrase, nil
+}
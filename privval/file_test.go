@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -34,7 +35,7 @@ func TestGenLoadValidator(t *testing.T) {
 	require.NoError(t, privVal.Save())
 	addr := privVal.GetAddress()
 
-	privVal, err = LoadFilePV(tempKeyFile.Name(), tempStateFile.Name())
+	privVal, err = LoadFilePV(tempKeyFile.Name(), tempStateFile.Name(), nil)
 	assert.NoError(t, err)
 	assert.Equal(t, addr, privVal.GetAddress(), "expected privval addr to be the same")
 	assert.Equal(t, height, privVal.LastSignState.Height, "expected privval.LastHeight to have been saved")
@@ -88,14 +89,139 @@ func TestLoadOrGenValidator(t *testing.T) {
 		t.Error(err)
 	}
 
-	privVal, err := LoadOrGenFilePV(tempKeyFilePath, tempStateFilePath)
+	privVal, err := LoadOrGenFilePV(tempKeyFilePath, tempStateFilePath, nil)
 	require.NoError(t, err)
 	addr := privVal.GetAddress()
-	privVal, err = LoadOrGenFilePV(tempKeyFilePath, tempStateFilePath)
+	privVal, err = LoadOrGenFilePV(tempKeyFilePath, tempStateFilePath, nil)
 	require.NoError(t, err)
 	assert.Equal(t, addr, privVal.GetAddress(), "expected privval addr to be the same")
 }
 
+func TestEncryptedValidatorKey(t *testing.T) {
+	dir := t.TempDir()
+	keyFilePath := filepath.Join(dir, "priv_validator_key.json")
+	stateFilePath := filepath.Join(dir, "priv_validator_state.json")
+	passphrase := []byte("correct horse battery staple")
+
+	privVal, err := LoadOrGenFilePV(keyFilePath, stateFilePath, passphrase)
+	require.NoError(t, err)
+	addr := privVal.GetAddress()
+
+	// the private key is not stored in the clear
+	keyJSONBytes, err := os.ReadFile(keyFilePath)
+	require.NoError(t, err)
+	assert.NotContains(t, string(keyJSONBytes), "priv_key")
+	privKeyJSON, err := tmjson.Marshal(privVal.Key.PrivKey)
+	require.NoError(t, err)
+	assert.NotContains(t, string(keyJSONBytes), string(privKeyJSON))
+
+	// loading needs the passphrase
+	_, err = LoadFilePV(keyFilePath, stateFilePath, nil)
+	assert.ErrorIs(t, err, ErrKeyEncrypted)
+	_, err = LoadFilePV(keyFilePath, stateFilePath, []byte("wrong passphrase"))
+	assert.ErrorIs(t, err, ErrKeyPassphrase)
+
+	privVal, err = LoadFilePV(keyFilePath, stateFilePath, passphrase)
+	require.NoError(t, err)
+	assert.Equal(t, addr, privVal.GetAddress(), "expected privval addr to be the same")
+
+	// the key stays encrypted when it is saved again
+	require.NoError(t, privVal.Reset())
+	privVal, err = LoadFilePVEmptyState(keyFilePath, stateFilePath, passphrase)
+	require.NoError(t, err)
+	assert.Equal(t, addr, privVal.GetAddress(), "expected privval addr to be the same")
+	_, err = LoadFilePV(keyFilePath, stateFilePath, nil)
+	assert.ErrorIs(t, err, ErrKeyEncrypted)
+
+	// a plaintext key can be encrypted in place
+	privVal.Key.SetPassphrase(nil)
+	require.NoError(t, privVal.Key.Save())
+	privVal, err = LoadFilePV(keyFilePath, stateFilePath, nil)
+	require.NoError(t, err)
+	privVal.Key.SetPassphrase([]byte("new passphrase"))
+	require.NoError(t, privVal.Key.Save())
+	_, err = LoadFilePV(keyFilePath, stateFilePath, passphrase)
+	assert.ErrorIs(t, err, ErrKeyPassphrase)
+	privVal, err = LoadFilePV(keyFilePath, stateFilePath, []byte("new passphrase"))
+	require.NoError(t, err)
+	assert.Equal(t, addr, privVal.GetAddress(), "expected privval addr to be the same")
+}
+
+func TestDecryptValidatorKey(t *testing.T) {
+	privKey := ed25519.GenPrivKey()
+	passphrase := []byte("passphrase")
+
+	testCases := []struct {
+		testName   string
+		malleate   func(*EncryptedFilePVKey)
+		errStr     string
+		passphrase []byte
+	}{
+		{"Good", func(epvKey *EncryptedFilePVKey) {}, "", passphrase},
+		{"Wrong passphrase", func(epvKey *EncryptedFilePVKey) {}, ErrKeyPassphrase.Error(), []byte("wrong")},
+		{"Unknown KDF", func(epvKey *EncryptedFilePVKey) { epvKey.Crypto.KDF = "pbkdf2" },
+			"unsupported key derivation function", passphrase},
+		{"Unknown cipher", func(epvKey *EncryptedFilePVKey) { epvKey.Crypto.Cipher = "aes-128-ctr" },
+			"unsupported cipher", passphrase},
+		{"Invalid nonce", func(epvKey *EncryptedFilePVKey) { epvKey.Crypto.Nonce = epvKey.Crypto.Nonce[1:] },
+			"expected nonce size to be 24, got 23", passphrase},
+		{"Invalid N", func(epvKey *EncryptedFilePVKey) { epvKey.Crypto.KDFParams.N = 1000 },
+			"scrypt N must be a power of 2", passphrase},
+		{"Expensive N", func(epvKey *EncryptedFilePVKey) { epvKey.Crypto.KDFParams.N = 1 << 30 },
+			"require too much memory", passphrase},
+		{"Empty salt", func(epvKey *EncryptedFilePVKey) { epvKey.Crypto.KDFParams.Salt = nil },
+			"empty scrypt salt", passphrase},
+		{"Modified ciphertext", func(epvKey *EncryptedFilePVKey) { epvKey.Crypto.Ciphertext[0] ^= 0x01 },
+			ErrKeyPassphrase.Error(), passphrase},
+		{"Modified address", func(epvKey *EncryptedFilePVKey) { epvKey.Address = ed25519.GenPrivKey().PubKey().Address() },
+			ErrKeyPassphrase.Error(), passphrase},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			epvKey, err := EncryptFilePVKey(FilePVKey{PrivKey: privKey}, passphrase)
+			require.NoError(t, err)
+			tc.malleate(epvKey)
+
+			decrypted, err := epvKey.Decrypt(tc.passphrase)
+			if tc.errStr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errStr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, privKey, decrypted)
+			}
+		})
+	}
+}
+
+func TestReadPassphrase(t *testing.T) {
+	passphraseFile := filepath.Join(t.TempDir(), "passphrase")
+	require.NoError(t, os.WriteFile(passphraseFile, []byte("from file\n"), 0600))
+
+	t.Setenv(PassphraseEnvVar, "")
+	passphrase, err := ReadPassphrase("")
+	require.NoError(t, err)
+	assert.Nil(t, passphrase)
+
+	t.Setenv(PassphraseEnvVar, "from env")
+	passphrase, err = ReadPassphrase("")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("from env"), passphrase)
+
+	// the passphrase file takes precedence
+	passphrase, err = ReadPassphrase(passphraseFile)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("from file"), passphrase)
+
+	require.NoError(t, os.WriteFile(passphraseFile, []byte("\n"), 0600))
+	_, err = ReadPassphrase(passphraseFile)
+	assert.Error(t, err)
+	_, err = ReadPassphrase(filepath.Join(t.TempDir(), "missing"))
+	assert.Error(t, err)
+}
+
 func TestUnmarshalValidatorState(t *testing.T) {
 	// create some fixed values
 	serialized := `{
@@ -211,6 +337,50 @@ func TestSignVote(t *testing.T) {
 	assert.Equal(t, sig, vote.Signature)
 }
 
+func TestSignVoteExtension(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tempKeyFile, err := os.CreateTemp("", "priv_validator_key_")
+	require.NoError(t, err)
+	tempStateFile, err := os.CreateTemp("", "priv_validator_state_")
+	require.NoError(t, err)
+
+	privVal, err := GenFilePV(tempKeyFile.Name(), tempStateFile.Name(), "")
+	require.NoError(t, err)
+	pubKey, err := privVal.GetPubKey(ctx)
+	require.NoError(t, err)
+
+	randbytes := tmrand.Bytes(tmhash.Size)
+	block := types.BlockID{Hash: randbytes,
+		PartSetHeader: types.PartSetHeader{Total: 5, Hash: randbytes}}
+	height, round := int64(10), int32(1)
+
+	// prevotes may not carry an extension
+	prevote := newVote(privVal.Key.Address, 0, height, round, tmproto.PrevoteType, block)
+	prevote.Extension = []byte("extension")
+	assert.Error(t, privVal.SignVote(ctx, "mychainid", prevote.ToProto()))
+
+	// sign a precommit with an extension
+	precommit := newVote(privVal.Key.Address, 0, height, round, tmproto.PrecommitType, block)
+	precommit.Extension = []byte("extension")
+	v := precommit.ToProto()
+	require.NoError(t, privVal.SignVote(ctx, "mychainid", v))
+	signed, err := types.VoteFromProto(v)
+	require.NoError(t, err)
+	assert.NoError(t, signed.VerifyVoteAndExtension("mychainid", pubKey))
+
+	// re-signing the same precommit with a different extension is allowed,
+	// since extensions are not covered by the double-sign protection
+	precommit.Extension = []byte("another extension")
+	v2 := precommit.ToProto()
+	require.NoError(t, privVal.SignVote(ctx, "mychainid", v2))
+	assert.Equal(t, v.Signature, v2.Signature)
+	signed, err = types.VoteFromProto(v2)
+	require.NoError(t, err)
+	assert.NoError(t, signed.VerifyVoteAndExtension("mychainid", pubKey))
+}
+
 func TestSignProposal(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
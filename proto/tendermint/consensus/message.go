@@ -36,6 +36,18 @@ func (m *Message) Wrap(pb proto.Message) error {
 	case *VoteSetBits:
 		m.Sum = &Message_VoteSetBits{VoteSetBits: msg}
 
+	case *CompactBlock:
+		m.Sum = &Message_CompactBlock{CompactBlock: msg}
+
+	case *BlockTxsRequest:
+		m.Sum = &Message_BlockTxsRequest{BlockTxsRequest: msg}
+
+	case *BlockTxs:
+		m.Sum = &Message_BlockTxs{BlockTxs: msg}
+
+	case *Commit:
+		m.Sum = &Message_Commit{Commit: msg}
+
 	default:
 		return fmt.Errorf("unknown message: %T", msg)
 	}
@@ -74,6 +86,18 @@ func (m *Message) Unwrap() (proto.Message, error) {
 	case *Message_VoteSetBits:
 		return m.GetVoteSetBits(), nil
 
+	case *Message_CompactBlock:
+		return m.GetCompactBlock(), nil
+
+	case *Message_BlockTxsRequest:
+		return m.GetBlockTxsRequest(), nil
+
+	case *Message_BlockTxs:
+		return m.GetBlockTxs(), nil
+
+	case *Message_Commit:
+		return m.GetCommit(), nil
+
 	default:
 		return nil, fmt.Errorf("unknown message: %T", msg)
 	}
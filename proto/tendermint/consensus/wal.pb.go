@@ -10,7 +10,7 @@ import (
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/golang/protobuf/ptypes/duration"
-	types "github.com/tendermint/tendermint/proto/tendermint/types"
+	types1 "github.com/tendermint/tendermint/proto/tendermint/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -31,8 +31,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // MsgInfo are msgs from the reactor which may update the state
 type MsgInfo struct {
-	Msg    Message `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg"`
-	PeerID string  `protobuf:"bytes,2,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Msg         Message   `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg"`
+	PeerID      string    `protobuf:"bytes,2,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	ReceiveTime time.Time `protobuf:"bytes,3,opt,name=receive_time,json=receiveTime,proto3,stdtime" json:"receive_time"`
 }
 
 func (m *MsgInfo) Reset()         { *m = MsgInfo{} }
@@ -82,6 +83,13 @@ func (m *MsgInfo) GetPeerID() string {
 	return ""
 }
 
+func (m *MsgInfo) GetReceiveTime() time.Time {
+	if m != nil {
+		return m.ReceiveTime
+	}
+	return time.Time{}
+}
+
 // TimeoutInfo internally generated messages which may update the state
 type TimeoutInfo struct {
 	Duration time.Duration `protobuf:"bytes,1,opt,name=duration,proto3,stdduration" json:"duration"`
@@ -246,7 +254,7 @@ type isWALMessage_Sum interface {
 }
 
 type WALMessage_EventDataRoundState struct {
-	EventDataRoundState *types.EventDataRoundState `protobuf:"bytes,1,opt,name=event_data_round_state,json=eventDataRoundState,proto3,oneof" json:"event_data_round_state,omitempty"`
+	EventDataRoundState *types1.EventDataRoundState `protobuf:"bytes,1,opt,name=event_data_round_state,json=eventDataRoundState,proto3,oneof" json:"event_data_round_state,omitempty"`
 }
 type WALMessage_MsgInfo struct {
 	MsgInfo *MsgInfo `protobuf:"bytes,2,opt,name=msg_info,json=msgInfo,proto3,oneof" json:"msg_info,omitempty"`
@@ -270,7 +278,7 @@ func (m *WALMessage) GetSum() isWALMessage_Sum {
 	return nil
 }
 
-func (m *WALMessage) GetEventDataRoundState() *types.EventDataRoundState {
+func (m *WALMessage) GetEventDataRoundState() *types1.EventDataRoundState {
 	if x, ok := m.GetSum().(*WALMessage_EventDataRoundState); ok {
 		return x.EventDataRoundState
 	}
@@ -372,41 +380,42 @@ func init() {
 func init() { proto.RegisterFile("tendermint/consensus/wal.proto", fileDescriptor_ed0b60c2d348ab09) }
 
 var fileDescriptor_ed0b60c2d348ab09 = []byte{
-	// 539 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0xdd, 0x8a, 0xd3, 0x40,
-	0x14, 0xce, 0x6c, 0xff, 0x4f, 0x15, 0x21, 0x96, 0xa5, 0x16, 0x36, 0x8d, 0x5d, 0x84, 0x5e, 0x25,
-	0xb0, 0x22, 0x88, 0x5e, 0xa8, 0xa5, 0x2b, 0x2d, 0xb8, 0x20, 0xe3, 0x8a, 0x20, 0x42, 0x48, 0x37,
-	0xa7, 0x69, 0x60, 0x33, 0x53, 0x32, 0x13, 0xc5, 0x2b, 0x5f, 0xa1, 0x97, 0xbe, 0x89, 0xaf, 0xb0,
-	0x97, 0x7b, 0xe9, 0xd5, 0x2a, 0xed, 0x8b, 0x48, 0x66, 0xd2, 0x36, 0xb8, 0xd9, 0xbb, 0x39, 0x73,
-	0xbe, 0x73, 0xbe, 0x73, 0xbe, 0x6f, 0x06, 0x2c, 0x89, 0x2c, 0xc0, 0x24, 0x8e, 0x98, 0x74, 0x2f,
-	0x38, 0x13, 0xc8, 0x44, 0x2a, 0xdc, 0x6f, 0xfe, 0xa5, 0xb3, 0x4c, 0xb8, 0xe4, 0x66, 0x67, 0x9f,
-	0x77, 0x76, 0xf9, 0x5e, 0x27, 0xe4, 0x21, 0x57, 0x00, 0x37, 0x3b, 0x69, 0x6c, 0xcf, 0x2e, 0xed,
-	0x25, 0xbf, 0x2f, 0x51, 0xe4, 0x88, 0xa3, 0x02, 0x42, 0xdd, 0xbb, 0xf8, 0x15, 0x99, 0xdc, 0xa6,
-	0xad, 0x90, 0xf3, 0xf0, 0x12, 0x5d, 0x15, 0xcd, 0xd2, 0xb9, 0x1b, 0xa4, 0x89, 0x2f, 0x23, 0xce,
-	0xf2, 0x7c, 0xff, 0xff, 0xbc, 0x8c, 0x62, 0x14, 0xd2, 0x8f, 0x97, 0x1a, 0x30, 0x40, 0x68, 0x9c,
-	0x89, 0x70, 0xca, 0xe6, 0xdc, 0x7c, 0x06, 0x95, 0x58, 0x84, 0x5d, 0x62, 0x93, 0x61, 0xfb, 0xe4,
-	0xc8, 0x29, 0x5b, 0xc3, 0x39, 0x43, 0x21, 0xfc, 0x10, 0x47, 0xd5, 0xab, 0x9b, 0xbe, 0x41, 0x33,
-	0xbc, 0x79, 0x0c, 0x8d, 0x25, 0x62, 0xe2, 0x45, 0x41, 0xf7, 0xc0, 0x26, 0xc3, 0xd6, 0x08, 0xd6,
-	0x37, 0xfd, 0xfa, 0x7b, 0xc4, 0x64, 0x3a, 0xa6, 0xf5, 0x2c, 0x35, 0x0d, 0x06, 0x2b, 0x02, 0xed,
-	0xf3, 0x28, 0x46, 0x9e, 0x4a, 0xc5, 0xf5, 0x0a, 0x9a, 0xdb, 0x49, 0x73, 0xc2, 0x47, 0x8e, 0x1e,
-	0xd5, 0xd9, 0x8e, 0xea, 0x8c, 0x73, 0xc0, 0xa8, 0x99, 0x91, 0xfd, 0xfc, 0xd3, 0x27, 0x74, 0x57,
-	0x64, 0x1e, 0x42, 0x7d, 0x81, 0x51, 0xb8, 0x90, 0x8a, 0xb4, 0x42, 0xf3, 0xc8, 0xec, 0x40, 0x2d,
-	0xe1, 0x29, 0x0b, 0xba, 0x15, 0x9b, 0x0c, 0x6b, 0x54, 0x07, 0xa6, 0x09, 0x55, 0x21, 0x71, 0xd9,
-	0xad, 0xda, 0x64, 0x78, 0x9f, 0xaa, 0xf3, 0xe0, 0x18, 0x5a, 0xa7, 0x2c, 0x98, 0xe8, 0xb2, 0x7d,
-	0x3b, 0x52, 0x6c, 0x37, 0xf8, 0x75, 0x00, 0xf0, 0xe9, 0xcd, 0xbb, 0x7c, 0x6d, 0xf3, 0x0b, 0x1c,
-	0x2a, 0xf9, 0xbd, 0xc0, 0x97, 0xbe, 0xa7, 0x7a, 0x7b, 0x42, 0xfa, 0x12, 0xf3, 0x25, 0x9e, 0x14,
-	0x55, 0xd3, 0x36, 0x9e, 0x66, 0xf8, 0xb1, 0x2f, 0x7d, 0x9a, 0xa1, 0x3f, 0x64, 0xe0, 0x89, 0x41,
-	0x1f, 0xe2, 0xed, 0x6b, 0xf3, 0x05, 0x34, 0x63, 0x11, 0x7a, 0x11, 0x9b, 0x73, 0xb5, 0xd5, 0xdd,
-	0x2e, 0x68, 0xc7, 0x26, 0x06, 0x6d, 0xc4, 0xb9, 0x79, 0x6f, 0xe1, 0x9e, 0xd4, 0xfa, 0xea, 0xfa,
-	0x8a, 0xaa, 0x7f, 0x5c, 0x5e, 0x5f, 0x70, 0x62, 0x62, 0xd0, 0xb6, 0x2c, 0x18, 0xf3, 0x1a, 0x00,
-	0x59, 0xe0, 0xe5, 0x62, 0x54, 0x55, 0x97, 0x7e, 0x79, 0x97, 0x9d, 0x7a, 0x13, 0x83, 0xb6, 0x70,
-	0x1b, 0x8c, 0x6a, 0x50, 0x11, 0x69, 0x3c, 0xf8, 0x01, 0x0f, 0x32, 0x9a, 0xa0, 0xa0, 0xde, 0x73,
-	0xa8, 0x66, 0x54, 0xb9, 0x56, 0xbd, 0x5b, 0x86, 0x9f, 0x6f, 0xdf, 0xa6, 0x76, 0x7c, 0x95, 0x39,
-	0xae, 0x2a, 0xcc, 0x13, 0xfd, 0x34, 0xb5, 0x28, 0x76, 0xf9, 0x38, 0x7b, 0x22, 0xf5, 0x2e, 0x47,
-	0x1f, 0xaf, 0xd6, 0x16, 0xb9, 0x5e, 0x5b, 0xe4, 0xef, 0xda, 0x22, 0xab, 0x8d, 0x65, 0x5c, 0x6f,
-	0x2c, 0xe3, 0xf7, 0xc6, 0x32, 0x3e, 0xbf, 0x0c, 0x23, 0xb9, 0x48, 0x67, 0xce, 0x05, 0x8f, 0xdd,
-	0xe2, 0xf7, 0xda, 0x1f, 0xf5, 0x47, 0x2d, 0xfb, 0x9c, 0xb3, 0xba, 0xca, 0x3d, 0xfd, 0x17, 0x00,
-	0x00, 0xff, 0xff, 0x0b, 0xad, 0x1c, 0x1b, 0x07, 0x04, 0x00, 0x00,
+	// 557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xdf, 0x8a, 0xd3, 0x4e,
+	0x14, 0xce, 0x6c, 0xff, 0x9f, 0xee, 0x8f, 0x1f, 0x8c, 0x65, 0xa9, 0x85, 0x4d, 0x6b, 0x17, 0xa1,
+	0x57, 0x09, 0xac, 0x08, 0xa2, 0x17, 0x6a, 0xe9, 0x6a, 0x0b, 0x2e, 0x48, 0x54, 0x04, 0x11, 0x42,
+	0xda, 0x9c, 0xa6, 0x81, 0x4d, 0xa6, 0x64, 0x26, 0x2b, 0x5e, 0xf9, 0x0a, 0xbd, 0xf4, 0x29, 0xbc,
+	0xf5, 0x15, 0xf6, 0x72, 0x2f, 0xbd, 0x5a, 0xa5, 0x7d, 0x11, 0x99, 0x99, 0xb4, 0x0d, 0x6e, 0x10,
+	0xbc, 0x3b, 0x67, 0xbe, 0xef, 0x7c, 0xf3, 0xcd, 0x39, 0x67, 0xc0, 0x14, 0x18, 0xfb, 0x98, 0x44,
+	0x61, 0x2c, 0xec, 0x19, 0x8b, 0x39, 0xc6, 0x3c, 0xe5, 0xf6, 0x27, 0xef, 0xc2, 0x5a, 0x26, 0x4c,
+	0x30, 0xda, 0xda, 0xe3, 0xd6, 0x0e, 0xef, 0xb4, 0x02, 0x16, 0x30, 0x45, 0xb0, 0x65, 0xa4, 0xb9,
+	0x9d, 0x5e, 0xa1, 0x96, 0xf8, 0xbc, 0x44, 0x9e, 0x31, 0x8e, 0x73, 0x0c, 0x75, 0x6e, 0xe3, 0x25,
+	0xc6, 0x62, 0x0b, 0x9b, 0x01, 0x63, 0xc1, 0x05, 0xda, 0x2a, 0x9b, 0xa6, 0x73, 0xdb, 0x4f, 0x13,
+	0x4f, 0x84, 0x2c, 0xce, 0xf0, 0xee, 0x9f, 0xb8, 0x08, 0x23, 0xe4, 0xc2, 0x8b, 0x96, 0x9a, 0xd0,
+	0xff, 0x46, 0xa0, 0x76, 0xce, 0x83, 0x49, 0x3c, 0x67, 0xf4, 0x21, 0x94, 0x22, 0x1e, 0xb4, 0x49,
+	0x8f, 0x0c, 0x9a, 0xa7, 0xc7, 0x56, 0xd1, 0x3b, 0xac, 0x73, 0xe4, 0xdc, 0x0b, 0x70, 0x58, 0xbe,
+	0xba, 0xe9, 0x1a, 0x8e, 0xe4, 0xd3, 0x13, 0xa8, 0x2d, 0x11, 0x13, 0x37, 0xf4, 0xdb, 0x07, 0x3d,
+	0x32, 0x68, 0x0c, 0x61, 0x7d, 0xd3, 0xad, 0xbe, 0x46, 0x4c, 0x26, 0x23, 0xa7, 0x2a, 0xa1, 0x89,
+	0x4f, 0x5f, 0xc2, 0x61, 0x82, 0x33, 0x0c, 0x2f, 0xd1, 0x95, 0x16, 0xda, 0x25, 0x75, 0x49, 0xc7,
+	0xd2, 0xfe, 0xac, 0xad, 0x3f, 0xeb, 0xed, 0xd6, 0xdf, 0xb0, 0x2e, 0x6f, 0x58, 0xfd, 0xec, 0x12,
+	0xa7, 0x99, 0x55, 0x4a, 0xac, 0xbf, 0x22, 0xd0, 0x94, 0x01, 0x4b, 0x85, 0x32, 0xfd, 0x14, 0xea,
+	0xdb, 0x37, 0x67, 0xce, 0xef, 0xde, 0x12, 0x1d, 0x65, 0x04, 0xad, 0xf9, 0x55, 0x6a, 0xee, 0x8a,
+	0xe8, 0x11, 0x54, 0x17, 0x18, 0x06, 0x0b, 0xa1, 0xdc, 0x97, 0x9c, 0x2c, 0xa3, 0x2d, 0xa8, 0x24,
+	0x2c, 0x8d, 0x7d, 0x65, 0xb5, 0xe2, 0xe8, 0x84, 0x52, 0x28, 0x73, 0x81, 0xcb, 0x76, 0xb9, 0x47,
+	0x06, 0xff, 0x39, 0x2a, 0xee, 0x9f, 0x40, 0xe3, 0x2c, 0xf6, 0xc7, 0xba, 0x6c, 0x2f, 0x47, 0xf2,
+	0x72, 0xfd, 0xef, 0x07, 0x00, 0xef, 0x9f, 0xbf, 0xca, 0xfa, 0x47, 0x3f, 0xc2, 0x91, 0x1a, 0xa4,
+	0xeb, 0x7b, 0xc2, 0x73, 0x95, 0xb6, 0xcb, 0x85, 0x27, 0x30, 0x7b, 0xc4, 0xfd, 0x7c, 0xfb, 0xf5,
+	0x42, 0x9c, 0x49, 0xfe, 0xc8, 0x13, 0x9e, 0x23, 0xd9, 0x6f, 0x24, 0x79, 0x6c, 0x38, 0x77, 0xf0,
+	0xf6, 0x31, 0x7d, 0x0c, 0xf5, 0x88, 0x07, 0x6e, 0x18, 0xcf, 0x59, 0xfb, 0xe0, 0xaf, 0xe3, 0xd4,
+	0xa3, 0x1f, 0x1b, 0x4e, 0x2d, 0xd2, 0x21, 0x7d, 0x01, 0x87, 0x42, 0xf7, 0x57, 0xd7, 0xeb, 0x49,
+	0xdd, 0x2b, 0xae, 0xcf, 0x4d, 0x62, 0x6c, 0x38, 0x4d, 0xb1, 0x4f, 0xe9, 0x33, 0x00, 0x8c, 0x7d,
+	0x37, 0x6b, 0x46, 0x59, 0xa9, 0x74, 0x8b, 0x55, 0x76, 0xdd, 0x1b, 0x1b, 0x4e, 0x03, 0xb7, 0xc9,
+	0xb0, 0x02, 0x25, 0x9e, 0x46, 0xfd, 0x2f, 0xf0, 0xbf, 0xbc, 0xc6, 0xcf, 0x75, 0xef, 0x11, 0x94,
+	0xd5, 0x16, 0x91, 0x7f, 0xd8, 0x22, 0x55, 0x41, 0x4f, 0xf5, 0x8e, 0xeb, 0xa6, 0xf4, 0x8a, 0xed,
+	0xec, 0x2f, 0x52, 0x0b, 0x3e, 0x7c, 0x77, 0xb5, 0x36, 0xc9, 0xf5, 0xda, 0x24, 0xbf, 0xd6, 0x26,
+	0x59, 0x6d, 0x4c, 0xe3, 0x7a, 0x63, 0x1a, 0x3f, 0x36, 0xa6, 0xf1, 0xe1, 0x49, 0x10, 0x8a, 0x45,
+	0x3a, 0xb5, 0x66, 0x2c, 0xb2, 0xf3, 0x1f, 0x75, 0x1f, 0xea, 0x2f, 0x5f, 0xf4, 0xcd, 0xa7, 0x55,
+	0x85, 0x3d, 0xf8, 0x3d, 0x00, 0x2c, 0x49, 0x1e, 0xda, 0x51, 0x04, 0x00, 0x00,
 }
 
 func (m *MsgInfo) Marshal() (dAtA []byte, err error) {
@@ -429,6 +438,14 @@ func (m *MsgInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReceiveTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReceiveTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintWal(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
 	if len(m.PeerID) > 0 {
 		i -= len(m.PeerID)
 		copy(dAtA[i:], m.PeerID)
@@ -484,12 +501,12 @@ func (m *TimeoutInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintWal(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintWal(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -671,12 +688,12 @@ func (m *TimedWALMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
-	if err8 != nil {
-		return 0, err8
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n8
-	i = encodeVarintWal(dAtA, i, uint64(n8))
+	i -= n9
+	i = encodeVarintWal(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -705,6 +722,8 @@ func (m *MsgInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWal(uint64(l))
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ReceiveTime)
+	n += 1 + l + sovWal(uint64(l))
 	return n
 }
 
@@ -915,6 +934,39 @@ func (m *MsgInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.PeerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ReceiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWal(dAtA[iNdEx:])
@@ -1203,7 +1255,7 @@ func (m *WALMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types.EventDataRoundState{}
+			v := &types1.EventDataRoundState{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -34,9 +34,7 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // of the various ABCI calls during block processing.
 // It is persisted to disk for each height before calling Commit.
 type ABCIResponses struct {
-	DeliverTxs []*types.ResponseDeliverTx `protobuf:"bytes,1,rep,name=deliver_txs,json=deliverTxs,proto3" json:"deliver_txs,omitempty"`
-	EndBlock   *types.ResponseEndBlock    `protobuf:"bytes,2,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
-	BeginBlock *types.ResponseBeginBlock  `protobuf:"bytes,3,opt,name=begin_block,json=beginBlock,proto3" json:"begin_block,omitempty"`
+	FinalizeBlock *types.ResponseFinalizeBlock `protobuf:"bytes,4,opt,name=finalize_block,json=finalizeBlock,proto3" json:"finalize_block,omitempty"`
 }
 
 func (m *ABCIResponses) Reset()         { *m = ABCIResponses{} }
@@ -72,23 +70,9 @@ func (m *ABCIResponses) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ABCIResponses proto.InternalMessageInfo
 
-func (m *ABCIResponses) GetDeliverTxs() []*types.ResponseDeliverTx {
+func (m *ABCIResponses) GetFinalizeBlock() *types.ResponseFinalizeBlock {
 	if m != nil {
-		return m.DeliverTxs
-	}
-	return nil
-}
-
-func (m *ABCIResponses) GetEndBlock() *types.ResponseEndBlock {
-	if m != nil {
-		return m.EndBlock
-	}
-	return nil
-}
-
-func (m *ABCIResponses) GetBeginBlock() *types.ResponseBeginBlock {
-	if m != nil {
-		return m.BeginBlock
+		return m.FinalizeBlock
 	}
 	return nil
 }
@@ -271,7 +255,7 @@ type State struct {
 	LastValidators              *types1.ValidatorSet `protobuf:"bytes,8,opt,name=last_validators,json=lastValidators,proto3" json:"last_validators,omitempty"`
 	LastHeightValidatorsChanged int64                `protobuf:"varint,9,opt,name=last_height_validators_changed,json=lastHeightValidatorsChanged,proto3" json:"last_height_validators_changed,omitempty"`
 	// Consensus parameters used for validating blocks.
-	// Changes returned by EndBlock and updated after Commit.
+	// Changes returned by FinalizeBlock and updated after Commit.
 	ConsensusParams                  types1.ConsensusParams `protobuf:"bytes,10,opt,name=consensus_params,json=consensusParams,proto3" json:"consensus_params"`
 	LastHeightConsensusParamsChanged int64                  `protobuf:"varint,11,opt,name=last_height_consensus_params_changed,json=lastHeightConsensusParamsChanged,proto3" json:"last_height_consensus_params_changed,omitempty"`
 	// Merkle root of the results from executing prev block
@@ -422,55 +406,53 @@ func init() {
 func init() { proto.RegisterFile("tendermint/state/types.proto", fileDescriptor_ccfacf933f22bf93) }
 
 var fileDescriptor_ccfacf933f22bf93 = []byte{
-	// 763 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0x6e, 0xe8, 0xb6, 0xb6, 0xce, 0xda, 0x0e, 0x8f, 0x43, 0xd6, 0xb1, 0xb4, 0x2b, 0x3f, 0x34,
-	0x71, 0x48, 0xa5, 0x71, 0x40, 0x5c, 0x26, 0x2d, 0x2d, 0x62, 0x95, 0x26, 0x04, 0xd9, 0xb4, 0x03,
-	0x97, 0xc8, 0x6d, 0xbc, 0x24, 0xa2, 0x4d, 0xa2, 0xd8, 0x2d, 0xe3, 0x0f, 0xe0, 0xbe, 0x2b, 0xff,
-	0xd1, 0x8e, 0x3b, 0x22, 0x0e, 0x03, 0xba, 0x7f, 0x04, 0xd9, 0xce, 0x0f, 0xb7, 0x65, 0xd2, 0x10,
-	0x37, 0xfb, 0x7d, 0xdf, 0xfb, 0xfc, 0xf9, 0xf9, 0x3d, 0x19, 0x3c, 0xa6, 0x38, 0x70, 0x70, 0x3c,
-	0xf6, 0x03, 0xda, 0x21, 0x14, 0x51, 0xdc, 0xa1, 0x5f, 0x22, 0x4c, 0x8c, 0x28, 0x0e, 0x69, 0x08,
-	0x37, 0x72, 0xd4, 0xe0, 0x68, 0xe3, 0x91, 0x1b, 0xba, 0x21, 0x07, 0x3b, 0x6c, 0x25, 0x78, 0x8d,
-	0x6d, 0x49, 0x05, 0x0d, 0x86, 0xbe, 0x2c, 0xd2, 0x90, 0x8f, 0xe0, 0xf1, 0x39, 0xb4, 0xb5, 0x84,
-	0x4e, 0xd1, 0xc8, 0x77, 0x10, 0x0d, 0xe3, 0x84, 0xb1, 0xb3, 0xc4, 0x88, 0x50, 0x8c, 0xc6, 0xa9,
-	0x80, 0x2e, 0xc1, 0x53, 0x1c, 0x13, 0x3f, 0x0c, 0xe6, 0x0e, 0x68, 0xba, 0x61, 0xe8, 0x8e, 0x70,
-	0x87, 0xef, 0x06, 0x93, 0xf3, 0x0e, 0xf5, 0xc7, 0x98, 0x50, 0x34, 0x8e, 0x04, 0xa1, 0xfd, 0x43,
-	0x01, 0xd5, 0x43, 0xb3, 0xdb, 0xb7, 0x30, 0x89, 0xc2, 0x80, 0x60, 0x02, 0xbb, 0x40, 0x75, 0xf0,
-	0xc8, 0x9f, 0xe2, 0xd8, 0xa6, 0x17, 0x44, 0x53, 0x5a, 0xc5, 0x3d, 0x75, 0xbf, 0x6d, 0x48, 0xc5,
-	0x60, 0x97, 0x34, 0xd2, 0x84, 0x9e, 0xe0, 0x9e, 0x5e, 0x58, 0xc0, 0x49, 0x97, 0x04, 0x1e, 0x80,
-	0x0a, 0x0e, 0x1c, 0x7b, 0x30, 0x0a, 0x87, 0x9f, 0xb4, 0x07, 0x2d, 0x65, 0x4f, 0xdd, 0xdf, 0xbd,
-	0x53, 0xe2, 0x4d, 0xe0, 0x98, 0x8c, 0x68, 0x95, 0x71, 0xb2, 0x82, 0x3d, 0xa0, 0x0e, 0xb0, 0xeb,
-	0x07, 0x89, 0x42, 0x91, 0x2b, 0x3c, 0xb9, 0x53, 0xc1, 0x64, 0x5c, 0xa1, 0x01, 0x06, 0xd9, 0xba,
-	0xfd, 0x55, 0x01, 0xb5, 0xb3, 0xb4, 0xa0, 0xa4, 0x1f, 0x9c, 0x87, 0xb0, 0x0b, 0xaa, 0x59, 0x89,
-	0x6d, 0x82, 0xa9, 0xa6, 0x70, 0x69, 0x5d, 0x96, 0x16, 0x05, 0xcc, 0x12, 0x4f, 0x30, 0xb5, 0xd6,
-	0xa7, 0xd2, 0x0e, 0x1a, 0x60, 0x73, 0x84, 0x08, 0xb5, 0x3d, 0xec, 0xbb, 0x1e, 0xb5, 0x87, 0x1e,
-	0x0a, 0x5c, 0xec, 0xf0, 0x7b, 0x16, 0xad, 0x87, 0x0c, 0x3a, 0xe2, 0x48, 0x57, 0x00, 0xed, 0x6f,
-	0x0a, 0xd8, 0xec, 0x32, 0x9f, 0x01, 0x99, 0x90, 0xf7, 0xfc, 0xfd, 0xb8, 0x19, 0x0b, 0x6c, 0x0c,
-	0xd3, 0xb0, 0x2d, 0xde, 0x35, 0xf1, 0xb3, 0xbb, 0xec, 0x67, 0x41, 0xc0, 0x5c, 0xb9, 0xba, 0x69,
-	0x16, 0xac, 0xfa, 0x70, 0x3e, 0xfc, 0xcf, 0xde, 0x3c, 0x50, 0x3a, 0x13, 0x8d, 0x03, 0x0f, 0x41,
-	0x25, 0x53, 0x4b, 0x7c, 0xec, 0xc8, 0x3e, 0x92, 0x06, 0xcb, 0x9d, 0x24, 0x1e, 0xf2, 0x2c, 0xd8,
-	0x00, 0x65, 0x12, 0x9e, 0xd3, 0xcf, 0x28, 0xc6, 0xfc, 0xc8, 0x8a, 0x95, 0xed, 0xdb, 0xbf, 0xd7,
-	0xc0, 0xea, 0x09, 0x9b, 0x23, 0xf8, 0x1a, 0x94, 0x12, 0xad, 0xe4, 0x98, 0x2d, 0x63, 0x71, 0xd6,
-	0x8c, 0xc4, 0x54, 0x72, 0x44, 0xca, 0x87, 0xcf, 0x41, 0x79, 0xe8, 0x21, 0x3f, 0xb0, 0x7d, 0x71,
-	0xa7, 0x8a, 0xa9, 0xce, 0x6e, 0x9a, 0xa5, 0x2e, 0x8b, 0xf5, 0x7b, 0x56, 0x89, 0x83, 0x7d, 0x07,
-	0x3e, 0x03, 0x35, 0x3f, 0xf0, 0xa9, 0x8f, 0x46, 0x49, 0x25, 0xb4, 0x1a, 0xaf, 0x40, 0x35, 0x89,
-	0x8a, 0x22, 0xc0, 0x17, 0x80, 0x97, 0x44, 0xb4, 0x59, 0xca, 0x2c, 0x72, 0x66, 0x9d, 0x01, 0xbc,
-	0x8f, 0x12, 0xae, 0x05, 0xaa, 0x12, 0xd7, 0x77, 0xb4, 0x95, 0x65, 0xef, 0xe2, 0xa9, 0x78, 0x56,
-	0xbf, 0x67, 0x6e, 0x32, 0xef, 0xb3, 0x9b, 0xa6, 0x7a, 0x9c, 0x4a, 0xf5, 0x7b, 0x96, 0x9a, 0xe9,
-	0xf6, 0x1d, 0x78, 0x0c, 0xea, 0x92, 0x26, 0x1b, 0x4e, 0x6d, 0x95, 0xab, 0x36, 0x0c, 0x31, 0xb9,
-	0x46, 0x3a, 0xb9, 0xc6, 0x69, 0x3a, 0xb9, 0x66, 0x99, 0xc9, 0x5e, 0xfe, 0x6c, 0x2a, 0x56, 0x35,
-	0xd3, 0x62, 0x28, 0x7c, 0x0b, 0xea, 0x01, 0xbe, 0xa0, 0x76, 0xd6, 0xac, 0x44, 0x5b, 0xbb, 0x57,
-	0x7b, 0xd7, 0x58, 0x5a, 0x3e, 0x29, 0xf0, 0x00, 0x00, 0x49, 0xa3, 0x74, 0x2f, 0x0d, 0x29, 0x83,
-	0x19, 0xe1, 0xd7, 0x92, 0x44, 0xca, 0xf7, 0x33, 0xc2, 0xd2, 0x24, 0x23, 0x5d, 0xa0, 0xcb, 0xdd,
-	0x9c, 0xeb, 0x65, 0x8d, 0x5d, 0xe1, 0x8f, 0xb5, 0x9d, 0x37, 0x76, 0x9e, 0x9d, 0xb4, 0xf8, 0x5f,
-	0xc7, 0x0c, 0xfc, 0xe7, 0x98, 0xbd, 0x03, 0x4f, 0xe7, 0xc6, 0x6c, 0x41, 0x3f, 0xb3, 0xa7, 0x72,
-	0x7b, 0x2d, 0x69, 0xee, 0xe6, 0x85, 0x52, 0x8f, 0x69, 0x23, 0xc6, 0x98, 0x4c, 0x46, 0x94, 0xd8,
-	0x1e, 0x22, 0x9e, 0xb6, 0xde, 0x52, 0xf6, 0xd6, 0x45, 0x23, 0x5a, 0x22, 0x7e, 0x84, 0x88, 0x07,
-	0xb7, 0x40, 0x19, 0x45, 0x91, 0xa0, 0x54, 0x39, 0xa5, 0x84, 0xa2, 0x88, 0x41, 0xe6, 0x87, 0xab,
-	0x99, 0xae, 0x5c, 0xcf, 0x74, 0xe5, 0xd7, 0x4c, 0x57, 0x2e, 0x6f, 0xf5, 0xc2, 0xf5, 0xad, 0x5e,
-	0xf8, 0x7e, 0xab, 0x17, 0x3e, 0xbe, 0x72, 0x7d, 0xea, 0x4d, 0x06, 0xc6, 0x30, 0x1c, 0x77, 0xe4,
-	0x3f, 0x25, 0x5f, 0x8a, 0x8f, 0x6d, 0xf1, 0x4b, 0x1c, 0xac, 0xf1, 0xf8, 0xcb, 0x3f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xa5, 0x17, 0xac, 0x23, 0x2d, 0x07, 0x00, 0x00,
+	// 732 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x6f, 0xda, 0x4a,
+	0x14, 0xc5, 0x81, 0x04, 0x33, 0x84, 0x8f, 0x37, 0x79, 0x0b, 0x87, 0xbc, 0x18, 0x1e, 0x7a, 0x2f,
+	0x8a, 0xba, 0x30, 0x52, 0xbb, 0xa8, 0xba, 0xa9, 0x14, 0x88, 0xda, 0x10, 0xa5, 0x55, 0xeb, 0x54,
+	0x59, 0x74, 0x63, 0x0d, 0x30, 0xe0, 0x51, 0xc1, 0xb6, 0x3c, 0x43, 0xfa, 0xb1, 0xef, 0x3e, 0xdb,
+	0xfe, 0xa3, 0x2c, 0xb3, 0xec, 0x2a, 0x6d, 0xc9, 0x1f, 0xa9, 0xe6, 0xc3, 0xf6, 0x00, 0x5d, 0xa4,
+	0xea, 0x8e, 0xb9, 0xe7, 0xdc, 0x73, 0xcf, 0xdc, 0xb9, 0x17, 0x83, 0x7f, 0x18, 0x0e, 0x46, 0x38,
+	0x9e, 0x91, 0x80, 0x75, 0x28, 0x43, 0x0c, 0x77, 0xd8, 0xc7, 0x08, 0x53, 0x27, 0x8a, 0x43, 0x16,
+	0xc2, 0x7a, 0x86, 0x3a, 0x02, 0x6d, 0xfc, 0x3d, 0x09, 0x27, 0xa1, 0x00, 0x3b, 0xfc, 0x97, 0xe4,
+	0x35, 0xf6, 0x34, 0x15, 0x34, 0x18, 0x12, 0x5d, 0xa4, 0xa1, 0x97, 0x10, 0xf1, 0x25, 0xb4, 0xb5,
+	0x86, 0x5e, 0xa2, 0x29, 0x19, 0x21, 0x16, 0xc6, 0x8a, 0xb1, 0xbf, 0xc6, 0x88, 0x50, 0x8c, 0x66,
+	0x89, 0x80, 0xad, 0xc1, 0x97, 0x38, 0xa6, 0x24, 0x0c, 0x96, 0x0a, 0x34, 0x27, 0x61, 0x38, 0x99,
+	0xe2, 0x8e, 0x38, 0x0d, 0xe6, 0xe3, 0x0e, 0x23, 0x33, 0x4c, 0x19, 0x9a, 0x45, 0x92, 0xd0, 0x8e,
+	0x40, 0xe5, 0xa8, 0xdb, 0xeb, 0xbb, 0x98, 0x46, 0x61, 0x40, 0x31, 0x85, 0x2f, 0x40, 0x75, 0x4c,
+	0x02, 0x34, 0x25, 0x9f, 0xb0, 0x37, 0x98, 0x86, 0xc3, 0x77, 0x56, 0xa1, 0x65, 0x1c, 0x96, 0x1f,
+	0x1e, 0x38, 0x5a, 0x3b, 0xf8, 0x35, 0x9d, 0x24, 0xe7, 0x99, 0xa2, 0x77, 0x39, 0xdb, 0xad, 0x8c,
+	0xf5, 0xe3, 0x69, 0xc1, 0x34, 0xea, 0x1b, 0xa7, 0x05, 0x73, 0xa3, 0x9e, 0x3f, 0x2d, 0x98, 0xf9,
+	0x7a, 0xa1, 0xfd, 0xd9, 0x00, 0xd5, 0x8b, 0xe4, 0x96, 0xb4, 0x1f, 0x8c, 0x43, 0xd8, 0x03, 0x95,
+	0xf4, 0xde, 0x1e, 0xc5, 0xcc, 0x32, 0x44, 0x49, 0x5b, 0x2f, 0x29, 0x6f, 0x95, 0x26, 0x9e, 0x63,
+	0xe6, 0x6e, 0x5f, 0x6a, 0x27, 0xe8, 0x80, 0x9d, 0x29, 0xa2, 0xcc, 0xf3, 0x31, 0x99, 0xf8, 0xcc,
+	0x1b, 0xfa, 0x28, 0x98, 0xe0, 0x91, 0xb5, 0xd1, 0x32, 0x0e, 0xf3, 0xee, 0x5f, 0x1c, 0x3a, 0x11,
+	0x48, 0x4f, 0x02, 0xed, 0x2f, 0x06, 0xd8, 0xe9, 0x71, 0xff, 0x01, 0x9d, 0xd3, 0x57, 0xa2, 0xa9,
+	0xc2, 0x8c, 0x0b, 0xea, 0xc3, 0x24, 0xec, 0xc9, 0x66, 0x2b, 0x3f, 0xff, 0xae, 0xfb, 0x59, 0x11,
+	0xe8, 0x16, 0xae, 0x6f, 0x9b, 0x39, 0xb7, 0x36, 0x5c, 0x0e, 0xff, 0xb6, 0x37, 0x1f, 0x14, 0x2f,
+	0xe4, 0x6b, 0xc2, 0x23, 0x50, 0x4a, 0xd5, 0x94, 0x8f, 0x7d, 0xdd, 0x87, 0x7a, 0xf5, 0xcc, 0x89,
+	0xf2, 0x90, 0x65, 0xc1, 0x06, 0x30, 0x69, 0x38, 0x66, 0xef, 0x51, 0x8c, 0x45, 0xc9, 0x92, 0x9b,
+	0x9e, 0xdb, 0x3f, 0xb6, 0xc0, 0xe6, 0x39, 0x1f, 0x6e, 0xf8, 0x04, 0x14, 0x95, 0x96, 0x2a, 0xb3,
+	0xeb, 0xac, 0x2e, 0x80, 0xa3, 0x4c, 0xa9, 0x12, 0x09, 0x1f, 0x1e, 0x00, 0x73, 0xe8, 0x23, 0x12,
+	0x78, 0x44, 0xde, 0xa9, 0xd4, 0x2d, 0x2f, 0x6e, 0x9b, 0xc5, 0x1e, 0x8f, 0xf5, 0x8f, 0xdd, 0xa2,
+	0x00, 0xfb, 0x23, 0xf8, 0x3f, 0xa8, 0x92, 0x80, 0x30, 0x82, 0xa6, 0xaa, 0x13, 0x56, 0x55, 0x74,
+	0xa0, 0xa2, 0xa2, 0xb2, 0x09, 0xf0, 0x01, 0x10, 0x2d, 0x91, 0xe3, 0x97, 0x30, 0xf3, 0x82, 0x59,
+	0xe3, 0x80, 0x98, 0x2c, 0xc5, 0x75, 0x41, 0x45, 0xe3, 0x92, 0x91, 0x55, 0x58, 0xf7, 0x2e, 0x9f,
+	0x4a, 0x64, 0xf5, 0x8f, 0xbb, 0x3b, 0xdc, 0xfb, 0xe2, 0xb6, 0x59, 0x3e, 0x4b, 0xa4, 0xfa, 0xc7,
+	0x6e, 0x39, 0xd5, 0xed, 0x8f, 0xe0, 0x19, 0xa8, 0x69, 0x9a, 0x7c, 0x63, 0xac, 0x4d, 0xa1, 0xda,
+	0x70, 0xe4, 0x3a, 0x39, 0xc9, 0x3a, 0x39, 0x6f, 0x92, 0x75, 0xea, 0x9a, 0x5c, 0xf6, 0xea, 0x5b,
+	0xd3, 0x70, 0x2b, 0xa9, 0x16, 0x47, 0xe1, 0x73, 0x50, 0x0b, 0xf0, 0x07, 0xe6, 0xa5, 0xc3, 0x4a,
+	0xad, 0xad, 0x7b, 0x8d, 0x77, 0x95, 0xa7, 0xa5, 0x11, 0x0a, 0x9f, 0x02, 0xa0, 0x69, 0x14, 0xef,
+	0xa5, 0xa1, 0x65, 0x70, 0x23, 0xe2, 0x5a, 0x9a, 0x88, 0x79, 0x3f, 0x23, 0x3c, 0x4d, 0x33, 0xd2,
+	0x03, 0xb6, 0x3e, 0xcd, 0x99, 0x5e, 0x3a, 0xd8, 0x25, 0xf1, 0x58, 0x7b, 0xd9, 0x60, 0x67, 0xd9,
+	0x6a, 0xc4, 0x7f, 0xb9, 0x66, 0xe0, 0x0f, 0xd7, 0xec, 0x25, 0xf8, 0x6f, 0x69, 0xcd, 0x56, 0xf4,
+	0x53, 0x7b, 0x65, 0x61, 0xaf, 0xa5, 0xed, 0xdd, 0xb2, 0x50, 0xe2, 0x31, 0x19, 0xc4, 0x18, 0xd3,
+	0xf9, 0x94, 0x51, 0xcf, 0x47, 0xd4, 0xb7, 0xb6, 0x5b, 0xc6, 0xe1, 0xb6, 0x1c, 0x44, 0x57, 0xc6,
+	0x4f, 0x10, 0xf5, 0xe1, 0x2e, 0x30, 0x51, 0x14, 0x49, 0x4a, 0x45, 0x50, 0x8a, 0x28, 0x8a, 0x38,
+	0xd4, 0x7d, 0x7d, 0xbd, 0xb0, 0x8d, 0x9b, 0x85, 0x6d, 0x7c, 0x5f, 0xd8, 0xc6, 0xd5, 0x9d, 0x9d,
+	0xbb, 0xb9, 0xb3, 0x73, 0x5f, 0xef, 0xec, 0xdc, 0xdb, 0xc7, 0x13, 0xc2, 0xfc, 0xf9, 0xc0, 0x19,
+	0x86, 0xb3, 0x8e, 0xfe, 0x47, 0x9f, 0xfd, 0x94, 0x5f, 0x9b, 0xd5, 0xef, 0xd4, 0x60, 0x4b, 0xc4,
+	0x1f, 0xfd, 0x1c, 0x00, 0x45, 0xc4, 0x73, 0xf5, 0xc2, 0x06, 0x00, 0x00,
 }
 
 func (m *ABCIResponses) Marshal() (dAtA []byte, err error) {
@@ -493,21 +475,9 @@ func (m *ABCIResponses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.BeginBlock != nil {
-		{
-			size, err := m.BeginBlock.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.EndBlock != nil {
+	if m.FinalizeBlock != nil {
 		{
-			size, err := m.EndBlock.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.FinalizeBlock.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -515,21 +485,7 @@ func (m *ABCIResponses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintTypes(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DeliverTxs) > 0 {
-		for iNdEx := len(m.DeliverTxs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DeliverTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0x22
 	}
 	return len(dAtA) - i, nil
 }
@@ -747,12 +703,12 @@ func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x32
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastBlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastBlockTime):])
-	if err10 != nil {
-		return 0, err10
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastBlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastBlockTime):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i -= n9
+	i = encodeVarintTypes(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x2a
 	{
@@ -807,18 +763,8 @@ func (m *ABCIResponses) Size() (n int) {
 	}
 	var l int
 	_ = l
-	if len(m.DeliverTxs) > 0 {
-		for _, e := range m.DeliverTxs {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
-	}
-	if m.EndBlock != nil {
-		l = m.EndBlock.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.BeginBlock != nil {
-		l = m.BeginBlock.Size()
+	if m.FinalizeBlock != nil {
+		l = m.FinalizeBlock.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
 	return n
@@ -957,79 +903,9 @@ func (m *ABCIResponses) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: ABCIResponses: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeliverTxs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DeliverTxs = append(m.DeliverTxs, &types.ResponseDeliverTx{})
-			if err := m.DeliverTxs[len(m.DeliverTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.EndBlock == nil {
-				m.EndBlock = &types.ResponseEndBlock{}
-			}
-			if err := m.EndBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizeBlock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1056,10 +932,10 @@ func (m *ABCIResponses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BeginBlock == nil {
-				m.BeginBlock = &types.ResponseBeginBlock{}
+			if m.FinalizeBlock == nil {
+				m.FinalizeBlock = &types.ResponseFinalizeBlock{}
 			}
-			if err := m.BeginBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FinalizeBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
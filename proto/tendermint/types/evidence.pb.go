@@ -31,6 +31,7 @@ type Evidence struct {
 	// Types that are valid to be assigned to Sum:
 	//	*Evidence_DuplicateVoteEvidence
 	//	*Evidence_LightClientAttackEvidence
+	//	*Evidence_DuplicateProposalEvidence
 	Sum isEvidence_Sum `protobuf_oneof:"sum"`
 }
 
@@ -79,9 +80,13 @@ type Evidence_DuplicateVoteEvidence struct {
 type Evidence_LightClientAttackEvidence struct {
 	LightClientAttackEvidence *LightClientAttackEvidence `protobuf:"bytes,2,opt,name=light_client_attack_evidence,json=lightClientAttackEvidence,proto3,oneof" json:"light_client_attack_evidence,omitempty"`
 }
+type Evidence_DuplicateProposalEvidence struct {
+	DuplicateProposalEvidence *DuplicateProposalEvidence `protobuf:"bytes,3,opt,name=duplicate_proposal_evidence,json=duplicateProposalEvidence,proto3,oneof" json:"duplicate_proposal_evidence,omitempty"`
+}
 
 func (*Evidence_DuplicateVoteEvidence) isEvidence_Sum()     {}
 func (*Evidence_LightClientAttackEvidence) isEvidence_Sum() {}
+func (*Evidence_DuplicateProposalEvidence) isEvidence_Sum() {}
 
 func (m *Evidence) GetSum() isEvidence_Sum {
 	if m != nil {
@@ -104,11 +109,19 @@ func (m *Evidence) GetLightClientAttackEvidence() *LightClientAttackEvidence {
 	return nil
 }
 
+func (m *Evidence) GetDuplicateProposalEvidence() *DuplicateProposalEvidence {
+	if x, ok := m.GetSum().(*Evidence_DuplicateProposalEvidence); ok {
+		return x.DuplicateProposalEvidence
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Evidence) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Evidence_DuplicateVoteEvidence)(nil),
 		(*Evidence_LightClientAttackEvidence)(nil),
+		(*Evidence_DuplicateProposalEvidence)(nil),
 	}
 }
 
@@ -268,6 +281,92 @@ func (m *LightClientAttackEvidence) GetTimestamp() time.Time {
 	return time.Time{}
 }
 
+// DuplicateProposalEvidence contains evidence of a validator signed two
+// conflicting proposals for the same height and round.
+type DuplicateProposalEvidence struct {
+	ProposalA        *Proposal `protobuf:"bytes,1,opt,name=proposal_a,json=proposalA,proto3" json:"proposal_a,omitempty"`
+	ProposalB        *Proposal `protobuf:"bytes,2,opt,name=proposal_b,json=proposalB,proto3" json:"proposal_b,omitempty"`
+	ValidatorAddress []byte    `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	TotalVotingPower int64     `protobuf:"varint,4,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+	ValidatorPower   int64     `protobuf:"varint,5,opt,name=validator_power,json=validatorPower,proto3" json:"validator_power,omitempty"`
+	Timestamp        time.Time `protobuf:"bytes,6,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+}
+
+func (m *DuplicateProposalEvidence) Reset()         { *m = DuplicateProposalEvidence{} }
+func (m *DuplicateProposalEvidence) String() string { return proto.CompactTextString(m) }
+func (*DuplicateProposalEvidence) ProtoMessage()    {}
+func (*DuplicateProposalEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6825fabc78e0a168, []int{3}
+}
+func (m *DuplicateProposalEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DuplicateProposalEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DuplicateProposalEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DuplicateProposalEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DuplicateProposalEvidence.Merge(m, src)
+}
+func (m *DuplicateProposalEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *DuplicateProposalEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_DuplicateProposalEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DuplicateProposalEvidence proto.InternalMessageInfo
+
+func (m *DuplicateProposalEvidence) GetProposalA() *Proposal {
+	if m != nil {
+		return m.ProposalA
+	}
+	return nil
+}
+
+func (m *DuplicateProposalEvidence) GetProposalB() *Proposal {
+	if m != nil {
+		return m.ProposalB
+	}
+	return nil
+}
+
+func (m *DuplicateProposalEvidence) GetValidatorAddress() []byte {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *DuplicateProposalEvidence) GetTotalVotingPower() int64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
+
+func (m *DuplicateProposalEvidence) GetValidatorPower() int64 {
+	if m != nil {
+		return m.ValidatorPower
+	}
+	return 0
+}
+
+func (m *DuplicateProposalEvidence) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
 type EvidenceList struct {
 	Evidence []Evidence `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence"`
 }
@@ -276,7 +375,7 @@ func (m *EvidenceList) Reset()         { *m = EvidenceList{} }
 func (m *EvidenceList) String() string { return proto.CompactTextString(m) }
 func (*EvidenceList) ProtoMessage()    {}
 func (*EvidenceList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6825fabc78e0a168, []int{3}
+	return fileDescriptor_6825fabc78e0a168, []int{4}
 }
 func (m *EvidenceList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -316,47 +415,53 @@ func init() {
 	proto.RegisterType((*Evidence)(nil), "tendermint.types.Evidence")
 	proto.RegisterType((*DuplicateVoteEvidence)(nil), "tendermint.types.DuplicateVoteEvidence")
 	proto.RegisterType((*LightClientAttackEvidence)(nil), "tendermint.types.LightClientAttackEvidence")
+	proto.RegisterType((*DuplicateProposalEvidence)(nil), "tendermint.types.DuplicateProposalEvidence")
 	proto.RegisterType((*EvidenceList)(nil), "tendermint.types.EvidenceList")
 }
 
 func init() { proto.RegisterFile("tendermint/types/evidence.proto", fileDescriptor_6825fabc78e0a168) }
 
 var fileDescriptor_6825fabc78e0a168 = []byte{
-	// 532 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xed, 0x3a, 0xa9, 0xc2, 0xb6, 0x40, 0x58, 0x5a, 0x48, 0x43, 0xe4, 0x44, 0xe1, 0xd0,
-	0x48, 0x80, 0x2d, 0x95, 0x03, 0x17, 0x2e, 0x35, 0x20, 0x15, 0x29, 0x42, 0x60, 0xa1, 0x1e, 0xb8,
-	0x58, 0x6b, 0x7b, 0xeb, 0xac, 0x6a, 0xef, 0x5a, 0xf1, 0x24, 0xa8, 0x3c, 0x45, 0x1e, 0xab, 0x17,
-	0xa4, 0x1e, 0x39, 0x01, 0x4a, 0x78, 0x10, 0xe4, 0xf5, 0x9f, 0x44, 0x75, 0xcc, 0x89, 0x4b, 0xe4,
-	0xcc, 0xfc, 0xbe, 0x9d, 0x99, 0xcf, 0xb3, 0x46, 0x7d, 0xa0, 0xdc, 0xa7, 0xd3, 0x88, 0x71, 0x30,
-	0xe1, 0x2a, 0xa6, 0x89, 0x49, 0xe7, 0xcc, 0xa7, 0xdc, 0xa3, 0x46, 0x3c, 0x15, 0x20, 0x70, 0x7b,
-	0x0d, 0x18, 0x12, 0xe8, 0x1e, 0x04, 0x22, 0x10, 0x32, 0x69, 0xa6, 0x4f, 0x19, 0xd7, 0xed, 0x07,
-	0x42, 0x04, 0x21, 0x35, 0xe5, 0x3f, 0x77, 0x76, 0x61, 0x02, 0x8b, 0x68, 0x02, 0x24, 0x8a, 0x73,
-	0xa0, 0x57, 0xa9, 0x24, 0x7f, 0xf3, 0xec, 0xa0, 0x92, 0x9d, 0x93, 0x90, 0xf9, 0x04, 0xc4, 0x34,
-	0x23, 0x86, 0x7f, 0x54, 0xd4, 0x7a, 0x97, 0xf7, 0x86, 0x09, 0x7a, 0xec, 0xcf, 0xe2, 0x90, 0x79,
-	0x04, 0xa8, 0x33, 0x17, 0x40, 0x9d, 0xa2, 0xed, 0x8e, 0x3a, 0x50, 0x47, 0x7b, 0x27, 0xc7, 0xc6,
-	0xed, 0xbe, 0x8d, 0xb7, 0x85, 0xe0, 0x5c, 0x00, 0x2d, 0x4e, 0x3a, 0x53, 0xec, 0x43, 0x7f, 0x5b,
-	0x02, 0x73, 0xd4, 0x0b, 0x59, 0x30, 0x01, 0xc7, 0x0b, 0x19, 0xe5, 0xe0, 0x10, 0x00, 0xe2, 0x5d,
-	0xae, 0xeb, 0xec, 0xc8, 0x3a, 0xcf, 0xaa, 0x75, 0xc6, 0xa9, 0xea, 0x8d, 0x14, 0x9d, 0x4a, 0xcd,
-	0x46, 0xad, 0xa3, 0xb0, 0x2e, 0x69, 0x35, 0x91, 0x96, 0xcc, 0xa2, 0xe1, 0x62, 0x07, 0x1d, 0x6e,
-	0xed, 0x14, 0xbf, 0x40, 0xbb, 0x72, 0x52, 0x92, 0x8f, 0xf8, 0xa8, 0x5a, 0x3a, 0xe5, 0xed, 0x66,
-	0x4a, 0x9d, 0x96, 0xb8, 0x9b, 0x77, 0xfa, 0x4f, 0xdc, 0xc2, 0xcf, 0x11, 0x06, 0x01, 0x24, 0x4c,
-	0xdd, 0x64, 0x3c, 0x70, 0x62, 0xf1, 0x95, 0x4e, 0x3b, 0xda, 0x40, 0x1d, 0x69, 0x76, 0x5b, 0x66,
-	0xce, 0x65, 0xe2, 0x63, 0x1a, 0xc7, 0xc7, 0xe8, 0x7e, 0xf9, 0x7e, 0x72, 0xb4, 0x21, 0xd1, 0x7b,
-	0x65, 0x38, 0x03, 0x2d, 0x74, 0xa7, 0x5c, 0x84, 0x4e, 0x53, 0x36, 0xd2, 0x35, 0xb2, 0x55, 0x31,
-	0x8a, 0x55, 0x31, 0x3e, 0x17, 0x84, 0xd5, 0xba, 0xfe, 0xd9, 0x57, 0x16, 0xbf, 0xfa, 0xaa, 0xbd,
-	0x96, 0x0d, 0xbf, 0xef, 0xa0, 0xa3, 0x5a, 0x53, 0xf1, 0x7b, 0xf4, 0xc0, 0x13, 0xfc, 0x22, 0x64,
-	0x9e, 0xec, 0xdb, 0x0d, 0x85, 0x77, 0x99, 0x3b, 0xd4, 0xab, 0x79, 0x39, 0x56, 0xca, 0xd8, 0xed,
-	0x0d, 0x99, 0x8c, 0xe0, 0xa7, 0xe8, 0xae, 0x27, 0xa2, 0x48, 0x70, 0x67, 0x42, 0x53, 0x4e, 0x3a,
-	0xa7, 0xd9, 0xfb, 0x59, 0xf0, 0x4c, 0xc6, 0xf0, 0x07, 0x74, 0xe0, 0x5e, 0x7d, 0x23, 0x1c, 0x18,
-	0xa7, 0x4e, 0x39, 0x6d, 0xd2, 0xd1, 0x06, 0xda, 0x68, 0xef, 0xe4, 0xc9, 0x16, 0x97, 0x0b, 0xc6,
-	0x7e, 0x58, 0x0a, 0xcb, 0x58, 0x52, 0x63, 0x7c, 0xa3, 0xc6, 0xf8, 0xff, 0xe1, 0xe7, 0x18, 0xed,
-	0x17, 0xee, 0x8d, 0x59, 0x02, 0xf8, 0x35, 0x6a, 0x6d, 0xdc, 0x1e, 0x4d, 0x1e, 0x59, 0x99, 0xa2,
-	0xdc, 0xd3, 0x46, 0x7a, 0xa4, 0x5d, 0x2a, 0xac, 0x4f, 0xd7, 0x4b, 0x5d, 0xbd, 0x59, 0xea, 0xea,
-	0xef, 0xa5, 0xae, 0x2e, 0x56, 0xba, 0x72, 0xb3, 0xd2, 0x95, 0x1f, 0x2b, 0x5d, 0xf9, 0xf2, 0x2a,
-	0x60, 0x30, 0x99, 0xb9, 0x86, 0x27, 0x22, 0x73, 0xf3, 0x7a, 0xaf, 0x1f, 0xb3, 0xaf, 0xc8, 0xed,
-	0xab, 0xef, 0xee, 0xca, 0xf8, 0xcb, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x21, 0x16, 0x68,
-	0x9d, 0x04, 0x00, 0x00,
+	// 615 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x51, 0x6b, 0xd3, 0x50,
+	0x18, 0x6d, 0x92, 0x75, 0x6c, 0x77, 0x53, 0xbb, 0xeb, 0xa6, 0x6d, 0x57, 0xd2, 0x52, 0x1f, 0x36,
+	0x98, 0x26, 0x30, 0x1f, 0x44, 0xf0, 0xa5, 0x51, 0x61, 0x42, 0x91, 0x19, 0x64, 0x0f, 0xbe, 0x84,
+	0x9b, 0xe4, 0x2e, 0xbd, 0x2c, 0xc9, 0x0d, 0xc9, 0x6d, 0x65, 0xfe, 0x8a, 0xfe, 0xac, 0xbd, 0x08,
+	0x03, 0x5f, 0xf4, 0x45, 0xa5, 0xfd, 0x23, 0x92, 0x9b, 0xe4, 0xa6, 0xae, 0x0d, 0x53, 0xf1, 0xa5,
+	0xa4, 0xdf, 0x77, 0x4e, 0xce, 0xf7, 0x9d, 0x7b, 0xb8, 0x01, 0x5d, 0x86, 0x43, 0x17, 0xc7, 0x01,
+	0x09, 0x99, 0xce, 0x2e, 0x23, 0x9c, 0xe8, 0x78, 0x42, 0x5c, 0x1c, 0x3a, 0x58, 0x8b, 0x62, 0xca,
+	0x28, 0x6c, 0x94, 0x00, 0x8d, 0x03, 0xda, 0xbb, 0x1e, 0xf5, 0x28, 0x6f, 0xea, 0xe9, 0x53, 0x86,
+	0x6b, 0x77, 0x3d, 0x4a, 0x3d, 0x1f, 0xeb, 0xfc, 0x9f, 0x3d, 0x3e, 0xd7, 0x19, 0x09, 0x70, 0xc2,
+	0x50, 0x10, 0xe5, 0x80, 0xce, 0x92, 0x12, 0xff, 0xcd, 0xbb, 0xbd, 0xa5, 0xee, 0x04, 0xf9, 0xc4,
+	0x45, 0x8c, 0xc6, 0x19, 0xa2, 0xff, 0x45, 0x06, 0x1b, 0xaf, 0xf3, 0xd9, 0x20, 0x02, 0x0f, 0xdd,
+	0x71, 0xe4, 0x13, 0x07, 0x31, 0x6c, 0x4d, 0x28, 0xc3, 0x56, 0x31, 0x76, 0x53, 0xea, 0x49, 0x87,
+	0x5b, 0xc7, 0x07, 0xda, 0xcd, 0xb9, 0xb5, 0x57, 0x05, 0xe1, 0x8c, 0x32, 0x5c, 0xbc, 0xe9, 0xa4,
+	0x66, 0xee, 0xb9, 0xab, 0x1a, 0x30, 0x04, 0x1d, 0x9f, 0x78, 0x23, 0x66, 0x39, 0x3e, 0xc1, 0x21,
+	0xb3, 0x10, 0x63, 0xc8, 0xb9, 0x28, 0x75, 0x64, 0xae, 0x73, 0xb4, 0xac, 0x33, 0x4c, 0x59, 0x2f,
+	0x39, 0x69, 0xc0, 0x39, 0x0b, 0x5a, 0x2d, 0xbf, 0xaa, 0x09, 0x03, 0xb0, 0x5f, 0xae, 0x14, 0xc5,
+	0x34, 0xa2, 0x09, 0xf2, 0x4b, 0x39, 0xa5, 0x4a, 0x4e, 0xac, 0x75, 0x9a, 0x73, 0x16, 0xe5, 0xdc,
+	0xaa, 0xa6, 0x51, 0x07, 0x4a, 0x32, 0x0e, 0xfa, 0x53, 0x19, 0xec, 0xad, 0x34, 0x06, 0x3e, 0x01,
+	0xeb, 0xdc, 0x58, 0x94, 0x3b, 0xfa, 0x60, 0x59, 0x3a, 0xc5, 0x9b, 0xf5, 0x14, 0x35, 0x10, 0x70,
+	0xbb, 0x29, 0xdf, 0x0e, 0x37, 0xe0, 0x63, 0x00, 0x19, 0x65, 0xc8, 0x4f, 0x0f, 0x8f, 0x84, 0x9e,
+	0x15, 0xd1, 0x8f, 0x38, 0xe6, 0x4b, 0x2a, 0x66, 0x83, 0x77, 0xce, 0x78, 0xe3, 0x34, 0xad, 0xc3,
+	0x03, 0x70, 0x4f, 0xc4, 0x21, 0x87, 0xae, 0x71, 0xe8, 0x5d, 0x51, 0xce, 0x80, 0x06, 0xd8, 0x14,
+	0xb9, 0x6b, 0xd6, 0xf9, 0x20, 0x6d, 0x2d, 0x4b, 0xa6, 0x56, 0x24, 0x53, 0x7b, 0x5f, 0x20, 0x8c,
+	0x8d, 0xab, 0xef, 0xdd, 0xda, 0xf4, 0x47, 0x57, 0x32, 0x4b, 0x5a, 0xff, 0xb3, 0x0c, 0x5a, 0x95,
+	0x67, 0x08, 0xdf, 0x80, 0x1d, 0x87, 0x86, 0xe7, 0x3e, 0x71, 0xf8, 0xdc, 0xb6, 0x4f, 0x9d, 0x8b,
+	0xdc, 0xa1, 0x4e, 0x45, 0x16, 0x8c, 0x14, 0x63, 0x36, 0x16, 0x68, 0xbc, 0x02, 0x1f, 0x81, 0x3b,
+	0x0e, 0x0d, 0x02, 0x1a, 0x5a, 0x23, 0x9c, 0xe2, 0xb8, 0x73, 0x8a, 0xb9, 0x9d, 0x15, 0x4f, 0x78,
+	0x0d, 0xbe, 0x05, 0xbb, 0xf6, 0xe5, 0x27, 0x14, 0x32, 0x12, 0x62, 0x4b, 0x6c, 0x9b, 0x34, 0x95,
+	0x9e, 0x72, 0xb8, 0x75, 0xbc, 0xbf, 0xc2, 0xe5, 0x02, 0x63, 0xde, 0x17, 0x44, 0x51, 0x4b, 0x2a,
+	0x8c, 0x5f, 0xab, 0x30, 0xfe, 0x7f, 0xf8, 0xf9, 0x4d, 0x06, 0xad, 0xca, 0x90, 0xc2, 0xe7, 0x00,
+	0x88, 0xb0, 0x17, 0x51, 0x6b, 0x2f, 0x6f, 0x55, 0xf0, 0xcc, 0xcd, 0x02, 0x3d, 0xf8, 0x8d, 0x5a,
+	0xc4, 0xee, 0x8f, 0xa8, 0x06, 0x3c, 0x02, 0x3b, 0x65, 0xa0, 0x90, 0xeb, 0xc6, 0x38, 0x49, 0x78,
+	0xfa, 0xb6, 0xcd, 0x86, 0x68, 0x0c, 0xb2, 0xfa, 0x5f, 0x5a, 0xb6, 0x22, 0xab, 0xf5, 0xdb, 0xb3,
+	0xba, 0xfe, 0x6f, 0xde, 0x0e, 0xc1, 0x76, 0xe1, 0xe4, 0x90, 0x24, 0x0c, 0xbe, 0x00, 0x1b, 0x0b,
+	0x17, 0xa1, 0xb2, 0xda, 0x10, 0x71, 0x07, 0xac, 0xa5, 0xaf, 0x34, 0x05, 0xc3, 0x78, 0x77, 0x35,
+	0x53, 0xa5, 0xeb, 0x99, 0x2a, 0xfd, 0x9c, 0xa9, 0xd2, 0x74, 0xae, 0xd6, 0xae, 0xe7, 0x6a, 0xed,
+	0xeb, 0x5c, 0xad, 0x7d, 0x78, 0xe6, 0x11, 0x36, 0x1a, 0xdb, 0x9a, 0x43, 0x03, 0x7d, 0xf1, 0xa6,
+	0x2e, 0x1f, 0xb3, 0x0f, 0xc2, 0xcd, 0x5b, 0xdc, 0x5e, 0xe7, 0xf5, 0xa7, 0xbf, 0x06, 0x00, 0x6c,
+	0xe7, 0x12, 0xe9, 0x68, 0x06, 0x00, 0x00,
 }
 
 func (m *Evidence) Marshal() (dAtA []byte, err error) {
@@ -433,6 +538,27 @@ func (m *Evidence_LightClientAttackEvidence) MarshalToSizedBuffer(dAtA []byte) (
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Evidence_DuplicateProposalEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Evidence_DuplicateProposalEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DuplicateProposalEvidence != nil {
+		{
+			size, err := m.DuplicateProposalEvidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *DuplicateVoteEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -453,12 +579,12 @@ func (m *DuplicateVoteEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err3 != nil {
-		return 0, err3
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n3
-	i = encodeVarintEvidence(dAtA, i, uint64(n3))
+	i -= n4
+	i = encodeVarintEvidence(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x2a
 	if m.ValidatorPower != 0 {
@@ -518,12 +644,12 @@ func (m *LightClientAttackEvidence) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err6 != nil {
-		return 0, err6
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n6
-	i = encodeVarintEvidence(dAtA, i, uint64(n6))
+	i -= n7
+	i = encodeVarintEvidence(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x2a
 	if m.TotalVotingPower != 0 {
@@ -565,6 +691,78 @@ func (m *LightClientAttackEvidence) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *DuplicateProposalEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DuplicateProposalEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DuplicateProposalEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintEvidence(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x32
+	if m.ValidatorPower != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.ValidatorPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalB != nil {
+		{
+			size, err := m.ProposalB.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalA != nil {
+		{
+			size, err := m.ProposalA.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EvidenceList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -649,6 +847,18 @@ func (m *Evidence_LightClientAttackEvidence) Size() (n int) {
 	}
 	return n
 }
+func (m *Evidence_DuplicateProposalEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DuplicateProposalEvidence != nil {
+		l = m.DuplicateProposalEvidence.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
 func (m *DuplicateVoteEvidence) Size() (n int) {
 	if m == nil {
 		return 0
@@ -701,6 +911,35 @@ func (m *LightClientAttackEvidence) Size() (n int) {
 	return n
 }
 
+func (m *DuplicateProposalEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalA != nil {
+		l = m.ProposalA.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.ProposalB != nil {
+		l = m.ProposalB.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovEvidence(uint64(m.TotalVotingPower))
+	}
+	if m.ValidatorPower != 0 {
+		n += 1 + sovEvidence(uint64(m.ValidatorPower))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovEvidence(uint64(l))
+	return n
+}
+
 func (m *EvidenceList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -821,6 +1060,41 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Evidence_LightClientAttackEvidence{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DuplicateProposalEvidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DuplicateProposalEvidence{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Evidence_DuplicateProposalEvidence{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvidence(dAtA[iNdEx:])
@@ -1226,6 +1500,233 @@ func (m *LightClientAttackEvidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DuplicateProposalEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DuplicateProposalEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DuplicateProposalEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProposalA == nil {
+				m.ProposalA = &Proposal{}
+			}
+			if err := m.ProposalA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProposalB == nil {
+				m.ProposalB = &Proposal{}
+			}
+			if err := m.ProposalB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPower", wireType)
+			}
+			m.ValidatorPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EvidenceList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -97,8 +97,9 @@ func (SignedMsgType) EnumDescriptor() ([]byte, []int) {
 
 // PartsetHeader
 type PartSetHeader struct {
-	Total uint32 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Hash  []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Total  uint32 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Hash   []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Parity uint32 `protobuf:"varint,3,opt,name=parity,proto3" json:"parity,omitempty"`
 }
 
 func (m *PartSetHeader) Reset()         { *m = PartSetHeader{} }
@@ -148,6 +149,13 @@ func (m *PartSetHeader) GetHash() []byte {
 	return nil
 }
 
+func (m *PartSetHeader) GetParity() uint32 {
+	if m != nil {
+		return m.Parity
+	}
+	return 0
+}
+
 type Part struct {
 	Index uint32       `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
 	Bytes []byte       `protobuf:"bytes,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
@@ -474,6 +482,12 @@ type Vote struct {
 	ValidatorAddress []byte        `protobuf:"bytes,6,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	ValidatorIndex   int32         `protobuf:"varint,7,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
 	Signature        []byte        `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Vote extension provided by the application. Only valid for precommit
+	// messages.
+	Extension []byte `protobuf:"bytes,9,opt,name=extension,proto3" json:"extension,omitempty"`
+	// Vote extension signature by the validator. Only valid for precommit
+	// messages.
+	ExtensionSignature []byte `protobuf:"bytes,10,opt,name=extension_signature,json=extensionSignature,proto3" json:"extension_signature,omitempty"`
 }
 
 func (m *Vote) Reset()         { *m = Vote{} }
@@ -565,13 +579,32 @@ func (m *Vote) GetSignature() []byte {
 	return nil
 }
 
-// Commit contains the evidence that a block was committed by a set of
-// validators.
+func (m *Vote) GetExtension() []byte {
+	if m != nil {
+		return m.Extension
+	}
+	return nil
+}
+
+func (m *Vote) GetExtensionSignature() []byte {
+	if m != nil {
+		return m.ExtensionSignature
+	}
+	return nil
+}
+
+// Commit contains the evidence that a block was committed by a set of validators.
 type Commit struct {
 	Height     int64       `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	Round      int32       `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
 	BlockID    BlockID     `protobuf:"bytes,3,opt,name=block_id,json=blockId,proto3" json:"block_id"`
 	Signatures []CommitSig `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures"`
+	// aggregated_signature is the aggregated BLS signature of all the
+	// signers, set instead of the individual signatures.
+	AggregatedSignature []byte `protobuf:"bytes,5,opt,name=aggregated_signature,json=aggregatedSignature,proto3" json:"aggregated_signature,omitempty"`
+	// aggregated_signers is the bitmap of the signers of the aggregated
+	// signature.
+	AggregatedSigners []byte `protobuf:"bytes,6,opt,name=aggregated_signers,json=aggregatedSigners,proto3" json:"aggregated_signers,omitempty"`
 }
 
 func (m *Commit) Reset()         { *m = Commit{} }
@@ -635,6 +668,20 @@ func (m *Commit) GetSignatures() []CommitSig {
 	return nil
 }
 
+func (m *Commit) GetAggregatedSignature() []byte {
+	if m != nil {
+		return m.AggregatedSignature
+	}
+	return nil
+}
+
+func (m *Commit) GetAggregatedSigners() []byte {
+	if m != nil {
+		return m.AggregatedSigners
+	}
+	return nil
+}
+
 // CommitSig is a part of the Vote included in a Commit.
 type CommitSig struct {
 	BlockIdFlag      BlockIDFlag `protobuf:"varint,1,opt,name=block_id_flag,json=blockIdFlag,proto3,enum=tendermint.types.BlockIDFlag" json:"block_id_flag,omitempty"`
@@ -968,8 +1015,7 @@ func (m *BlockMeta) GetNumTxs() int64 {
 	return 0
 }
 
-// TxProof represents a Merkle proof of the presence of a transaction in the
-// Merkle tree.
+// TxProof represents a Merkle proof of the presence of a transaction in the Merkle tree.
 type TxProof struct {
 	RootHash []byte        `protobuf:"bytes,1,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty"`
 	Data     []byte        `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
@@ -1051,90 +1097,94 @@ func init() {
 func init() { proto.RegisterFile("tendermint/types/types.proto", fileDescriptor_d3a6e55e2345de56) }
 
 var fileDescriptor_d3a6e55e2345de56 = []byte{
-	// 1314 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xda, 0x9b, 0xd8, 0x7e, 0xb6, 0x13, 0x67, 0x95, 0xb6, 0xae, 0xdb, 0x38, 0x2b, 0x23,
-	0x20, 0x2d, 0x68, 0x53, 0x52, 0xc4, 0x9f, 0x03, 0x07, 0xdb, 0x49, 0x5b, 0xab, 0x89, 0x63, 0xd6,
-	0x6e, 0x11, 0x5c, 0x56, 0x6b, 0xef, 0xd4, 0x5e, 0xba, 0xde, 0x59, 0xed, 0x8c, 0x43, 0xd2, 0x4f,
-	0x80, 0x72, 0xea, 0x89, 0x5b, 0x4e, 0x70, 0xe0, 0xce, 0x17, 0x40, 0x9c, 0x7a, 0xec, 0x0d, 0x2e,
-	0x14, 0x94, 0x4a, 0x88, 0x8f, 0x81, 0xe6, 0x8f, 0xd7, 0xeb, 0x38, 0x86, 0xaa, 0xaa, 0xb8, 0x58,
-	0x3b, 0xef, 0xfd, 0xde, 0xcc, 0x7b, 0xbf, 0xf7, 0x9b, 0x3f, 0x86, 0xeb, 0x14, 0xf9, 0x0e, 0x0a,
-	0x87, 0xae, 0x4f, 0xb7, 0xe8, 0x71, 0x80, 0x88, 0xf8, 0x35, 0x82, 0x10, 0x53, 0xac, 0x15, 0x26,
-	0x5e, 0x83, 0xdb, 0x4b, 0x6b, 0x7d, 0xdc, 0xc7, 0xdc, 0xb9, 0xc5, 0xbe, 0x04, 0xae, 0xb4, 0xd1,
-	0xc7, 0xb8, 0xef, 0xa1, 0x2d, 0x3e, 0xea, 0x8e, 0x1e, 0x6d, 0x51, 0x77, 0x88, 0x08, 0xb5, 0x87,
-	0x81, 0x04, 0xac, 0xc7, 0x96, 0xe9, 0x85, 0xc7, 0x01, 0xc5, 0x0c, 0x8b, 0x1f, 0x49, 0x77, 0x39,
-	0xe6, 0x3e, 0x44, 0x21, 0x71, 0xb1, 0x1f, 0xcf, 0xa3, 0xa4, 0xcf, 0x64, 0x79, 0x68, 0x7b, 0xae,
-	0x63, 0x53, 0x1c, 0x0a, 0x44, 0xe5, 0x53, 0xc8, 0xb7, 0xec, 0x90, 0xb6, 0x11, 0xbd, 0x87, 0x6c,
-	0x07, 0x85, 0xda, 0x1a, 0x2c, 0x52, 0x4c, 0x6d, 0xaf, 0xa8, 0xe8, 0xca, 0x66, 0xde, 0x14, 0x03,
-	0x4d, 0x03, 0x75, 0x60, 0x93, 0x41, 0x31, 0xa1, 0x2b, 0x9b, 0x39, 0x93, 0x7f, 0x57, 0x06, 0xa0,
-	0xb2, 0x50, 0x16, 0xe1, 0xfa, 0x0e, 0x3a, 0x1a, 0x47, 0xf0, 0x01, 0xb3, 0x76, 0x8f, 0x29, 0x22,
-	0x32, 0x44, 0x0c, 0xb4, 0x0f, 0x61, 0x91, 0xe7, 0x5f, 0x4c, 0xea, 0xca, 0x66, 0x76, 0xbb, 0x68,
-	0xc4, 0x88, 0x12, 0xf5, 0x19, 0x2d, 0xe6, 0xaf, 0xa9, 0xcf, 0x5e, 0x6c, 0x2c, 0x98, 0x02, 0x5c,
-	0xf1, 0x20, 0x55, 0xf3, 0x70, 0xef, 0x71, 0x63, 0x27, 0x4a, 0x44, 0x99, 0x24, 0xa2, 0xed, 0xc3,
-	0x4a, 0x60, 0x87, 0xd4, 0x22, 0x88, 0x5a, 0x03, 0x5e, 0x05, 0x5f, 0x34, 0xbb, 0xbd, 0x61, 0x9c,
-	0xef, 0x83, 0x31, 0x55, 0xac, 0x5c, 0x25, 0x1f, 0xc4, 0x8d, 0x95, 0xbf, 0x54, 0x58, 0x92, 0x64,
-	0x7c, 0x06, 0x29, 0x49, 0x2b, 0x5f, 0x30, 0xbb, 0xbd, 0x1e, 0x9f, 0x51, 0xba, 0x8c, 0x3a, 0xf6,
-	0x09, 0xf2, 0xc9, 0x88, 0xc8, 0xf9, 0xc6, 0x31, 0xda, 0x3b, 0x90, 0xee, 0x0d, 0x6c, 0xd7, 0xb7,
-	0x5c, 0x87, 0x67, 0x94, 0xa9, 0x65, 0xcf, 0x5e, 0x6c, 0xa4, 0xea, 0xcc, 0xd6, 0xd8, 0x31, 0x53,
-	0xdc, 0xd9, 0x70, 0xb4, 0xcb, 0xb0, 0x34, 0x40, 0x6e, 0x7f, 0x40, 0x39, 0x2d, 0x49, 0x53, 0x8e,
-	0xb4, 0x4f, 0x40, 0x65, 0x82, 0x28, 0xaa, 0x7c, 0xed, 0x92, 0x21, 0xd4, 0x62, 0x8c, 0xd5, 0x62,
-	0x74, 0xc6, 0x6a, 0xa9, 0xa5, 0xd9, 0xc2, 0x4f, 0xff, 0xd8, 0x50, 0x4c, 0x1e, 0xa1, 0xd5, 0x21,
-	0xef, 0xd9, 0x84, 0x5a, 0x5d, 0x46, 0x1b, 0x5b, 0x7e, 0x91, 0x4f, 0x71, 0x75, 0x96, 0x10, 0x49,
-	0xac, 0x4c, 0x3d, 0xcb, 0xa2, 0x84, 0xc9, 0xd1, 0x36, 0xa1, 0xc0, 0x27, 0xe9, 0xe1, 0xe1, 0xd0,
-	0xa5, 0x16, 0xe7, 0x7d, 0x89, 0xf3, 0xbe, 0xcc, 0xec, 0x75, 0x6e, 0xbe, 0xc7, 0x3a, 0x70, 0x0d,
-	0x32, 0x8e, 0x4d, 0x6d, 0x01, 0x49, 0x71, 0x48, 0x9a, 0x19, 0xb8, 0xf3, 0x5d, 0x58, 0x89, 0x54,
-	0x47, 0x04, 0x24, 0x2d, 0x66, 0x99, 0x98, 0x39, 0xf0, 0x16, 0xac, 0xf9, 0xe8, 0x88, 0x5a, 0xe7,
-	0xd1, 0x19, 0x8e, 0xd6, 0x98, 0xef, 0xe1, 0x74, 0xc4, 0xdb, 0xb0, 0xdc, 0x1b, 0x93, 0x2f, 0xb0,
-	0xc0, 0xb1, 0xf9, 0xc8, 0xca, 0x61, 0x57, 0x21, 0x6d, 0x07, 0x81, 0x00, 0x64, 0x39, 0x20, 0x65,
-	0x07, 0x01, 0x77, 0xdd, 0x84, 0x55, 0x5e, 0x63, 0x88, 0xc8, 0xc8, 0xa3, 0x72, 0x92, 0x1c, 0xc7,
-	0xac, 0x30, 0x87, 0x29, 0xec, 0x1c, 0xfb, 0x16, 0xe4, 0xd1, 0xa1, 0xeb, 0x20, 0xbf, 0x87, 0x04,
-	0x2e, 0xcf, 0x71, 0xb9, 0xb1, 0x91, 0x83, 0x6e, 0x40, 0x21, 0x08, 0x71, 0x80, 0x09, 0x0a, 0x2d,
-	0xdb, 0x71, 0x42, 0x44, 0x48, 0x71, 0x59, 0xcc, 0x37, 0xb6, 0x57, 0x85, 0xb9, 0x52, 0x04, 0x75,
-	0xc7, 0xa6, 0xb6, 0x56, 0x80, 0x24, 0x3d, 0x22, 0x45, 0x45, 0x4f, 0x6e, 0xe6, 0x4c, 0xf6, 0x59,
-	0xf9, 0x3b, 0x01, 0xea, 0x43, 0x4c, 0x91, 0x76, 0x1b, 0x54, 0xd6, 0x26, 0xae, 0xbe, 0xe5, 0x8b,
-	0xf4, 0xdc, 0x76, 0xfb, 0x3e, 0x72, 0xf6, 0x49, 0xbf, 0x73, 0x1c, 0x20, 0x93, 0x83, 0x63, 0x72,
-	0x4a, 0x4c, 0xc9, 0x69, 0x0d, 0x16, 0x43, 0x3c, 0xf2, 0x1d, 0xae, 0xb2, 0x45, 0x53, 0x0c, 0xb4,
-	0x5d, 0x48, 0x47, 0x2a, 0x51, 0xff, 0x4b, 0x25, 0x2b, 0x4c, 0x25, 0x4c, 0xc3, 0xd2, 0x60, 0xa6,
-	0xba, 0x52, 0x2c, 0x35, 0xc8, 0x44, 0x87, 0x97, 0x54, 0xdb, 0xab, 0x09, 0x76, 0x12, 0xa6, 0xbd,
-	0x07, 0xab, 0x51, 0xef, 0x23, 0xf2, 0x84, 0xe2, 0x0a, 0x91, 0x43, 0xb2, 0x37, 0x25, 0x2b, 0x4b,
-	0x1c, 0x40, 0x29, 0x5e, 0xd7, 0x44, 0x56, 0x0d, 0x7e, 0x12, 0x5d, 0x87, 0x0c, 0x71, 0xfb, 0xbe,
-	0x4d, 0x47, 0x21, 0x92, 0xca, 0x9b, 0x18, 0x2a, 0x3f, 0x2b, 0xb0, 0x24, 0x94, 0x1c, 0xe3, 0x4d,
-	0xb9, 0x98, 0xb7, 0xc4, 0x3c, 0xde, 0x92, 0xaf, 0xcf, 0x5b, 0x15, 0x20, 0x4a, 0x86, 0x14, 0x55,
-	0x3d, 0xb9, 0x99, 0xdd, 0xbe, 0x36, 0x3b, 0x91, 0x48, 0xb1, 0xed, 0xf6, 0xe5, 0x46, 0x8d, 0x05,
-	0x55, 0x7e, 0x57, 0x20, 0x13, 0xf9, 0xb5, 0x2a, 0xe4, 0xc7, 0x79, 0x59, 0x8f, 0x3c, 0xbb, 0x2f,
-	0xb5, 0xb3, 0x3e, 0x37, 0xb9, 0x3b, 0x9e, 0xdd, 0x37, 0xb3, 0x32, 0x1f, 0x36, 0xb8, 0xb8, 0x0f,
-	0x89, 0x39, 0x7d, 0x98, 0x6a, 0x7c, 0xf2, 0xf5, 0x1a, 0x3f, 0xd5, 0x22, 0xf5, 0x7c, 0x8b, 0x7e,
-	0x4a, 0x40, 0xba, 0xc5, 0xf7, 0x8e, 0xed, 0xfd, 0x1f, 0x3b, 0xe2, 0x1a, 0x64, 0x02, 0xec, 0x59,
-	0xc2, 0xa3, 0x72, 0x4f, 0x3a, 0xc0, 0x9e, 0x39, 0xd3, 0xf6, 0xc5, 0x37, 0xb4, 0x5d, 0x96, 0xde,
-	0x00, 0x6b, 0xa9, 0xf3, 0xac, 0x85, 0x90, 0x13, 0x54, 0xc8, 0xbb, 0xec, 0x16, 0xe3, 0x80, 0x5f,
-	0x8e, 0xca, 0xec, 0xdd, 0x2b, 0xd2, 0x16, 0x48, 0x53, 0xe2, 0x58, 0x84, 0x38, 0xfa, 0xe5, 0x75,
-	0x5a, 0x9c, 0x27, 0x4b, 0x53, 0xe2, 0x2a, 0xdf, 0x29, 0x00, 0x7b, 0x8c, 0x59, 0x5e, 0x2f, 0xbb,
-	0x85, 0x08, 0x4f, 0xc1, 0x9a, 0x5a, 0xb9, 0x3c, 0xaf, 0x69, 0x72, 0xfd, 0x1c, 0x89, 0xe7, 0x5d,
-	0x87, 0xfc, 0x44, 0x8c, 0x04, 0x8d, 0x93, 0xb9, 0x60, 0x92, 0xe8, 0x72, 0x68, 0x23, 0x6a, 0xe6,
-	0x0e, 0x63, 0xa3, 0xca, 0x2f, 0x0a, 0x64, 0x78, 0x4e, 0xfb, 0x88, 0xda, 0x53, 0x3d, 0x54, 0x5e,
-	0xbf, 0x87, 0xeb, 0x00, 0x62, 0x1a, 0xe2, 0x3e, 0x41, 0x52, 0x59, 0x19, 0x6e, 0x69, 0xbb, 0x4f,
-	0x90, 0xf6, 0x51, 0x44, 0x78, 0xf2, 0xdf, 0x09, 0x97, 0x5b, 0x7a, 0x4c, 0xfb, 0x15, 0x48, 0xf9,
-	0xa3, 0xa1, 0xc5, 0xae, 0x04, 0x55, 0xa8, 0xd5, 0x1f, 0x0d, 0x3b, 0x47, 0xa4, 0xf2, 0x35, 0xa4,
-	0x3a, 0x47, 0xfc, 0x79, 0xc4, 0x24, 0x1a, 0x62, 0x2c, 0xef, 0x64, 0xf1, 0x16, 0x4a, 0x33, 0x03,
-	0xbf, 0x82, 0x34, 0x50, 0xd9, 0xe5, 0x3b, 0x7e, 0xac, 0xb1, 0x6f, 0xcd, 0x78, 0xc5, 0x87, 0x97,
-	0x7c, 0x72, 0xdd, 0xfc, 0x55, 0x81, 0x6c, 0xec, 0x7c, 0xd0, 0x3e, 0x80, 0x4b, 0xb5, 0xbd, 0x83,
-	0xfa, 0x7d, 0xab, 0xb1, 0x63, 0xdd, 0xd9, 0xab, 0xde, 0xb5, 0x1e, 0x34, 0xef, 0x37, 0x0f, 0xbe,
-	0x68, 0x16, 0x16, 0x4a, 0x97, 0x4f, 0x4e, 0x75, 0x2d, 0x86, 0x7d, 0xe0, 0x3f, 0xf6, 0xf1, 0x37,
-	0xbe, 0xb6, 0x05, 0x6b, 0xd3, 0x21, 0xd5, 0x5a, 0x7b, 0xb7, 0xd9, 0x29, 0x28, 0xa5, 0x4b, 0x27,
-	0xa7, 0xfa, 0x6a, 0x2c, 0xa2, 0xda, 0x25, 0xc8, 0xa7, 0xb3, 0x01, 0xf5, 0x83, 0xfd, 0xfd, 0x46,
-	0xa7, 0x90, 0x98, 0x09, 0x90, 0x07, 0xf6, 0x0d, 0x58, 0x9d, 0x0e, 0x68, 0x36, 0xf6, 0x0a, 0xc9,
-	0x92, 0x76, 0x72, 0xaa, 0x2f, 0xc7, 0xd0, 0x4d, 0xd7, 0x2b, 0xa5, 0xbf, 0xfd, 0xbe, 0xbc, 0xf0,
-	0xe3, 0x0f, 0x65, 0x85, 0x55, 0x96, 0x9f, 0x3a, 0x23, 0xb4, 0xf7, 0xe1, 0x4a, 0xbb, 0x71, 0xb7,
-	0xb9, 0xbb, 0x63, 0xed, 0xb7, 0xef, 0x5a, 0x9d, 0x2f, 0x5b, 0xbb, 0xb1, 0xea, 0x56, 0x4e, 0x4e,
-	0xf5, 0xac, 0x2c, 0x69, 0x1e, 0xba, 0x65, 0xee, 0x3e, 0x3c, 0xe8, 0xec, 0x16, 0x14, 0x81, 0x6e,
-	0x85, 0xe8, 0x10, 0x53, 0xc4, 0xd1, 0xb7, 0xe0, 0xea, 0x05, 0xe8, 0xa8, 0xb0, 0xd5, 0x93, 0x53,
-	0x3d, 0xdf, 0x0a, 0x91, 0xd8, 0x3f, 0x3c, 0xc2, 0x80, 0xe2, 0x6c, 0xc4, 0x41, 0xeb, 0xa0, 0x5d,
-	0xdd, 0x2b, 0xe8, 0xa5, 0xc2, 0xc9, 0xa9, 0x9e, 0x1b, 0x1f, 0x86, 0x0c, 0x3f, 0xa9, 0xac, 0xf6,
-	0xf9, 0xb3, 0xb3, 0xb2, 0xf2, 0xfc, 0xac, 0xac, 0xfc, 0x79, 0x56, 0x56, 0x9e, 0xbe, 0x2c, 0x2f,
-	0x3c, 0x7f, 0x59, 0x5e, 0xf8, 0xed, 0x65, 0x79, 0xe1, 0xab, 0x8f, 0xfb, 0x2e, 0x1d, 0x8c, 0xba,
-	0x46, 0x0f, 0x0f, 0xb7, 0xe2, 0x7f, 0x09, 0x26, 0x9f, 0xe2, 0xaf, 0xc9, 0xf9, 0xbf, 0x0b, 0xdd,
-	0x25, 0x6e, 0xbf, 0xfd, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4c, 0x78, 0x43, 0xdf, 0xef, 0x0c,
-	0x00, 0x00,
+	// 1379 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4b, 0x73, 0xdb, 0x54,
+	0x14, 0x8e, 0x6c, 0xf9, 0x75, 0x6c, 0x27, 0xce, 0x6d, 0xda, 0xba, 0x6e, 0xe3, 0x78, 0xcc, 0x00,
+	0x69, 0x01, 0xa5, 0x4d, 0x19, 0x60, 0xc3, 0xc2, 0x76, 0xd2, 0xd6, 0xd3, 0xc4, 0x71, 0x65, 0xb7,
+	0x0c, 0x6c, 0x34, 0xb2, 0x75, 0x2b, 0x8b, 0xca, 0x92, 0x46, 0xba, 0x0e, 0x49, 0x7f, 0x01, 0x93,
+	0x55, 0x57, 0xec, 0xb2, 0x01, 0x16, 0xec, 0x59, 0xb0, 0x67, 0xd5, 0x65, 0x77, 0xb0, 0xa1, 0x30,
+	0xe9, 0x0c, 0xbf, 0x83, 0xb9, 0x0f, 0xc9, 0x72, 0x9c, 0xf0, 0xe8, 0x74, 0xd8, 0x68, 0xee, 0x3d,
+	0xe7, 0x3b, 0xe7, 0x9e, 0xc7, 0x77, 0x1f, 0x82, 0x6b, 0x04, 0x3b, 0x06, 0xf6, 0xc7, 0x96, 0x43,
+	0x36, 0xc8, 0xa1, 0x87, 0x03, 0xfe, 0x55, 0x3c, 0xdf, 0x25, 0x2e, 0x2a, 0x4d, 0xb5, 0x0a, 0x93,
+	0x57, 0x56, 0x4c, 0xd7, 0x74, 0x99, 0x72, 0x83, 0x8e, 0x38, 0xae, 0xb2, 0x66, 0xba, 0xae, 0x69,
+	0xe3, 0x0d, 0x36, 0x1b, 0x4c, 0x1e, 0x6f, 0x10, 0x6b, 0x8c, 0x03, 0xa2, 0x8f, 0x3d, 0x01, 0x58,
+	0x8d, 0x2d, 0x33, 0xf4, 0x0f, 0x3d, 0xe2, 0x52, 0xac, 0xfb, 0x58, 0xa8, 0xab, 0x31, 0xf5, 0x3e,
+	0xf6, 0x03, 0xcb, 0x75, 0xe2, 0x71, 0x54, 0x6a, 0x73, 0x51, 0xee, 0xeb, 0xb6, 0x65, 0xe8, 0xc4,
+	0xf5, 0x39, 0xa2, 0xfe, 0x00, 0x8a, 0x5d, 0xdd, 0x27, 0x3d, 0x4c, 0xee, 0x61, 0xdd, 0xc0, 0x3e,
+	0x5a, 0x81, 0x14, 0x71, 0x89, 0x6e, 0x97, 0xa5, 0x9a, 0xb4, 0x5e, 0x54, 0xf9, 0x04, 0x21, 0x90,
+	0x47, 0x7a, 0x30, 0x2a, 0x27, 0x6a, 0xd2, 0x7a, 0x41, 0x65, 0x63, 0x74, 0x09, 0xd2, 0x9e, 0xee,
+	0x5b, 0xe4, 0xb0, 0x9c, 0x64, 0x50, 0x31, 0xab, 0x8f, 0x40, 0xa6, 0x2e, 0xa9, 0x27, 0xcb, 0x31,
+	0xf0, 0x41, 0xe8, 0x89, 0x4d, 0xa8, 0x74, 0x70, 0x48, 0x70, 0x20, 0x5c, 0xf1, 0x09, 0xfa, 0x10,
+	0x52, 0x2c, 0x2f, 0xe6, 0x2a, 0xbf, 0x59, 0x56, 0x62, 0x05, 0xe4, 0x79, 0x2b, 0x5d, 0xaa, 0x6f,
+	0xca, 0xcf, 0x5f, 0xae, 0x2d, 0xa8, 0x1c, 0x5c, 0xb7, 0x21, 0xd3, 0xb4, 0xdd, 0xe1, 0x93, 0xf6,
+	0x56, 0x14, 0xa0, 0x14, 0x0b, 0x70, 0x17, 0x96, 0x3c, 0xdd, 0x27, 0x5a, 0x80, 0x89, 0x36, 0x62,
+	0xd9, 0xb1, 0x45, 0xf3, 0x9b, 0x6b, 0xca, 0xe9, 0xfe, 0x28, 0x33, 0x45, 0x10, 0xab, 0x14, 0xbd,
+	0xb8, 0xb0, 0xfe, 0xa7, 0x0c, 0x69, 0x3e, 0x44, 0x9f, 0x42, 0x46, 0x94, 0x9b, 0x2d, 0x98, 0xdf,
+	0x5c, 0x8d, 0x7b, 0x14, 0x2a, 0xa5, 0xe5, 0x3a, 0x01, 0x76, 0x82, 0x49, 0x20, 0xfc, 0x85, 0x36,
+	0xe8, 0x1d, 0xc8, 0x0e, 0x47, 0xba, 0xe5, 0x68, 0x96, 0xc1, 0x22, 0xca, 0x35, 0xf3, 0x27, 0x2f,
+	0xd7, 0x32, 0x2d, 0x2a, 0x6b, 0x6f, 0xa9, 0x19, 0xa6, 0x6c, 0x1b, 0xb4, 0xc2, 0x23, 0x6c, 0x99,
+	0x23, 0xc2, 0xca, 0x92, 0x54, 0xc5, 0x0c, 0x7d, 0x02, 0x32, 0x25, 0x4a, 0x59, 0x66, 0x6b, 0x57,
+	0x14, 0xce, 0x22, 0x25, 0x64, 0x91, 0xd2, 0x0f, 0x59, 0xd4, 0xcc, 0xd2, 0x85, 0x9f, 0xfd, 0xbe,
+	0x26, 0xa9, 0xcc, 0x02, 0xb5, 0xa0, 0x68, 0xeb, 0x01, 0xd1, 0x06, 0xb4, 0x6c, 0x74, 0xf9, 0x14,
+	0x73, 0x71, 0x65, 0xbe, 0x20, 0xa2, 0xb0, 0x22, 0xf4, 0x3c, 0xb5, 0xe2, 0x22, 0x03, 0xad, 0x43,
+	0x89, 0x39, 0x19, 0xba, 0xe3, 0xb1, 0x45, 0x34, 0x56, 0xf7, 0x34, 0xab, 0xfb, 0x22, 0x95, 0xb7,
+	0x98, 0xf8, 0x1e, 0xed, 0xc0, 0x55, 0xc8, 0x19, 0x3a, 0xd1, 0x39, 0x24, 0xc3, 0x20, 0x59, 0x2a,
+	0x60, 0xca, 0x77, 0x61, 0x29, 0x62, 0x63, 0xc0, 0x21, 0x59, 0xee, 0x65, 0x2a, 0x66, 0xc0, 0x9b,
+	0xb0, 0xe2, 0xe0, 0x03, 0xa2, 0x9d, 0x46, 0xe7, 0x18, 0x1a, 0x51, 0xdd, 0xa3, 0x59, 0x8b, 0xb7,
+	0x61, 0x71, 0x18, 0x16, 0x9f, 0x63, 0x81, 0x61, 0x8b, 0x91, 0x94, 0xc1, 0xae, 0x40, 0x56, 0xf7,
+	0x3c, 0x0e, 0xc8, 0x33, 0x40, 0x46, 0xf7, 0x3c, 0xa6, 0xba, 0x01, 0xcb, 0x2c, 0x47, 0x1f, 0x07,
+	0x13, 0x9b, 0x08, 0x27, 0x05, 0x86, 0x59, 0xa2, 0x0a, 0x95, 0xcb, 0x19, 0xf6, 0x2d, 0x28, 0xe2,
+	0x7d, 0xcb, 0xc0, 0xce, 0x10, 0x73, 0x5c, 0x91, 0xe1, 0x0a, 0xa1, 0x90, 0x81, 0xae, 0x43, 0xc9,
+	0xf3, 0x5d, 0xcf, 0x0d, 0xb0, 0xaf, 0xe9, 0x86, 0xe1, 0xe3, 0x20, 0x28, 0x2f, 0x72, 0x7f, 0xa1,
+	0xbc, 0xc1, 0xc5, 0xf5, 0x32, 0xc8, 0x5b, 0x3a, 0xd1, 0x51, 0x09, 0x92, 0xe4, 0x20, 0x28, 0x4b,
+	0xb5, 0xe4, 0x7a, 0x41, 0xa5, 0xc3, 0xfa, 0x4f, 0x49, 0x90, 0x1f, 0xb9, 0x04, 0xa3, 0xdb, 0x20,
+	0xd3, 0x36, 0x31, 0xf6, 0x2d, 0x9e, 0xc5, 0xe7, 0x9e, 0x65, 0x3a, 0xd8, 0xd8, 0x0d, 0xcc, 0xfe,
+	0xa1, 0x87, 0x55, 0x06, 0x8e, 0xd1, 0x29, 0x31, 0x43, 0xa7, 0x15, 0x48, 0xf9, 0xee, 0xc4, 0x31,
+	0x18, 0xcb, 0x52, 0x2a, 0x9f, 0xa0, 0x6d, 0xc8, 0x46, 0x2c, 0x91, 0xff, 0x89, 0x25, 0x4b, 0x94,
+	0x25, 0x94, 0xc3, 0x42, 0xa0, 0x66, 0x06, 0x82, 0x2c, 0x4d, 0xc8, 0x45, 0x87, 0x5a, 0x39, 0xf5,
+	0x1f, 0x08, 0x3b, 0x35, 0x43, 0xef, 0xc1, 0x72, 0xd4, 0xfb, 0xa8, 0x78, 0x9c, 0x71, 0xa5, 0x48,
+	0x21, 0xaa, 0x37, 0x43, 0x2b, 0x8d, 0x1f, 0x40, 0x19, 0x96, 0xd7, 0x94, 0x56, 0x6d, 0x2a, 0x45,
+	0xd7, 0x20, 0x17, 0x58, 0xa6, 0xa3, 0x93, 0x89, 0x8f, 0x05, 0xf3, 0xa6, 0x02, 0xaa, 0xc5, 0x07,
+	0x04, 0x3b, 0x6c, 0x93, 0x73, 0xa6, 0x4d, 0x05, 0x68, 0x03, 0x2e, 0x44, 0x13, 0x6d, 0xea, 0x85,
+	0xb3, 0x0c, 0x45, 0xaa, 0x5e, 0xa8, 0xa9, 0x7f, 0x9b, 0x80, 0x34, 0xdf, 0x18, 0xb1, 0x36, 0x48,
+	0x67, 0xb7, 0x21, 0x71, 0x5e, 0x1b, 0x92, 0xaf, 0xdf, 0x86, 0x06, 0x40, 0x14, 0x66, 0x50, 0x96,
+	0x6b, 0xc9, 0xf5, 0xfc, 0xe6, 0xd5, 0x79, 0x47, 0x3c, 0xc4, 0x9e, 0x65, 0x8a, 0x7d, 0x1f, 0x33,
+	0x42, 0xb7, 0x60, 0x45, 0x37, 0x4d, 0x1f, 0x9b, 0x3a, 0xc1, 0x46, 0x2c, 0xe9, 0x14, 0x4b, 0xfa,
+	0xc2, 0x54, 0x17, 0x65, 0x8d, 0x3e, 0x00, 0x74, 0xca, 0x04, 0xfb, 0x61, 0xe7, 0x96, 0x67, 0x0d,
+	0xb0, 0x1f, 0xd4, 0x7f, 0x93, 0x20, 0x17, 0x45, 0x80, 0x1a, 0x50, 0x0c, 0x33, 0xd7, 0x1e, 0xdb,
+	0xba, 0x29, 0xc8, 0xbe, 0x7a, 0x6e, 0xfa, 0x77, 0x6c, 0xdd, 0x54, 0xf3, 0x22, 0x63, 0x3a, 0x39,
+	0x9b, 0x38, 0x89, 0x73, 0x88, 0x33, 0xc3, 0xd4, 0xe4, 0xeb, 0x31, 0x75, 0x86, 0x53, 0xf2, 0x29,
+	0x4e, 0xd5, 0x7f, 0x4c, 0x40, 0xb6, 0xcb, 0x36, 0xbb, 0x6e, 0xff, 0x1f, 0x5b, 0xf8, 0x2a, 0xe4,
+	0x3c, 0xd7, 0xd6, 0xb8, 0x46, 0x66, 0x9a, 0xac, 0xe7, 0xda, 0xea, 0x1c, 0xb1, 0x52, 0x6f, 0x68,
+	0x7f, 0xa7, 0xdf, 0x40, 0xd5, 0x32, 0xa7, 0xab, 0xe6, 0x43, 0x81, 0x97, 0x42, 0x5c, 0xbe, 0x37,
+	0x69, 0x0d, 0xe8, 0xa8, 0x2c, 0xcd, 0x3f, 0x16, 0x78, 0xd8, 0x1c, 0xa9, 0xa6, 0x47, 0x91, 0x05,
+	0xbf, 0xab, 0xca, 0x89, 0xf3, 0x2c, 0x38, 0xed, 0x54, 0x81, 0xab, 0x7f, 0x23, 0x01, 0xec, 0xd0,
+	0xca, 0xb2, 0x7c, 0xe9, 0xb5, 0xc9, 0xc8, 0x6b, 0x68, 0x33, 0x2b, 0x57, 0xcf, 0x6b, 0x9a, 0x58,
+	0xbf, 0x10, 0xc4, 0xe3, 0x6e, 0x41, 0x71, 0x4a, 0xc6, 0x00, 0x87, 0xc1, 0x9c, 0xe1, 0x24, 0xba,
+	0xcd, 0x7a, 0x98, 0xa8, 0x85, 0xfd, 0xd8, 0xac, 0xfe, 0xb3, 0x04, 0x39, 0x16, 0xd3, 0x2e, 0x26,
+	0xfa, 0x4c, 0x0f, 0xa5, 0xd7, 0xef, 0xe1, 0x2a, 0x00, 0x77, 0x13, 0x58, 0x4f, 0xb1, 0x60, 0x56,
+	0x8e, 0x49, 0x7a, 0xd6, 0x53, 0x8c, 0x3e, 0x8a, 0x0a, 0x9e, 0xfc, 0xfb, 0x82, 0x8b, 0x43, 0x23,
+	0x2c, 0xfb, 0x65, 0xc8, 0x38, 0x93, 0xb1, 0x46, 0xef, 0x30, 0x99, 0xb3, 0xd5, 0x99, 0x8c, 0xfb,
+	0x07, 0x41, 0xfd, 0x4b, 0xc8, 0xf4, 0x0f, 0xd8, 0x7b, 0x8e, 0x52, 0xd4, 0x77, 0x5d, 0xf1, 0x88,
+	0xe0, 0x8f, 0xb7, 0x2c, 0x15, 0xb0, 0x3b, 0x13, 0x81, 0x4c, 0x5f, 0x0b, 0xe1, 0xab, 0x93, 0x8e,
+	0x91, 0xf2, 0x2f, 0x5f, 0x8a, 0xe2, 0x8d, 0x78, 0xe3, 0x17, 0x09, 0xf2, 0xb1, 0xf3, 0x01, 0xdd,
+	0x82, 0x8b, 0xcd, 0x9d, 0xbd, 0xd6, 0x7d, 0xad, 0xbd, 0xa5, 0xdd, 0xd9, 0x69, 0xdc, 0xd5, 0x1e,
+	0x76, 0xee, 0x77, 0xf6, 0x3e, 0xeb, 0x94, 0x16, 0x2a, 0x97, 0x8e, 0x8e, 0x6b, 0x28, 0x86, 0x7d,
+	0xe8, 0x3c, 0x71, 0xdc, 0xaf, 0xe8, 0x61, 0xbf, 0x32, 0x6b, 0xd2, 0x68, 0xf6, 0xb6, 0x3b, 0xfd,
+	0x92, 0x54, 0xb9, 0x78, 0x74, 0x5c, 0x5b, 0x8e, 0x59, 0x34, 0x06, 0x01, 0x76, 0xc8, 0xbc, 0x41,
+	0x6b, 0x6f, 0x77, 0xb7, 0xdd, 0x2f, 0x25, 0xe6, 0x0c, 0xc4, 0x95, 0x70, 0x1d, 0x96, 0x67, 0x0d,
+	0x3a, 0xed, 0x9d, 0x52, 0xb2, 0x82, 0x8e, 0x8e, 0x6b, 0x8b, 0x31, 0x74, 0xc7, 0xb2, 0x2b, 0xd9,
+	0xaf, 0xbf, 0xab, 0x2e, 0xfc, 0xf0, 0x7d, 0x55, 0xa2, 0x99, 0x15, 0x67, 0xce, 0x08, 0xf4, 0x3e,
+	0x5c, 0xee, 0xb5, 0xef, 0x76, 0xb6, 0xb7, 0xb4, 0xdd, 0xde, 0x5d, 0xad, 0xff, 0x79, 0x77, 0x3b,
+	0x96, 0xdd, 0xd2, 0xd1, 0x71, 0x2d, 0x2f, 0x52, 0x3a, 0x0f, 0xdd, 0x55, 0xb7, 0x1f, 0xed, 0xf5,
+	0xb7, 0x4b, 0x12, 0x47, 0x77, 0x7d, 0xbc, 0xef, 0x12, 0xcc, 0xd0, 0x37, 0xe1, 0xca, 0x19, 0xe8,
+	0x28, 0xb1, 0xe5, 0xa3, 0xe3, 0x5a, 0xb1, 0xeb, 0x63, 0xbe, 0x7f, 0x98, 0x85, 0x02, 0xe5, 0x79,
+	0x8b, 0xbd, 0xee, 0x5e, 0xaf, 0xb1, 0x53, 0xaa, 0x55, 0x4a, 0x47, 0xc7, 0xb5, 0x42, 0x78, 0x18,
+	0x52, 0xfc, 0x34, 0xb3, 0xe6, 0x83, 0xe7, 0x27, 0x55, 0xe9, 0xc5, 0x49, 0x55, 0xfa, 0xe3, 0xa4,
+	0x2a, 0x3d, 0x7b, 0x55, 0x5d, 0x78, 0xf1, 0xaa, 0xba, 0xf0, 0xeb, 0xab, 0xea, 0xc2, 0x17, 0x1f,
+	0x9b, 0x16, 0x19, 0x4d, 0x06, 0xca, 0xd0, 0x1d, 0x6f, 0xc4, 0xff, 0x6d, 0xa6, 0x43, 0xfe, 0x8f,
+	0x75, 0xfa, 0xbf, 0x67, 0x90, 0x66, 0xf2, 0xdb, 0x7f, 0x0d, 0x00, 0x72, 0xf6, 0x13, 0x94, 0xb8,
+	0x0d, 0x00, 0x00,
 }
 
 func (m *PartSetHeader) Marshal() (dAtA []byte, err error) {
@@ -1157,6 +1207,11 @@ func (m *PartSetHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Parity != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Parity))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Hash) > 0 {
 		i -= len(m.Hash)
 		copy(dAtA[i:], m.Hash)
@@ -1435,6 +1490,20 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExtensionSignature) > 0 {
+		i -= len(m.ExtensionSignature)
+		copy(dAtA[i:], m.ExtensionSignature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ExtensionSignature)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Extension) > 0 {
+		i -= len(m.Extension)
+		copy(dAtA[i:], m.Extension)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Extension)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Signature) > 0 {
 		i -= len(m.Signature)
 		copy(dAtA[i:], m.Signature)
@@ -1510,6 +1579,20 @@ func (m *Commit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AggregatedSigners) > 0 {
+		i -= len(m.AggregatedSigners)
+		copy(dAtA[i:], m.AggregatedSigners)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AggregatedSigners)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AggregatedSignature) > 0 {
+		i -= len(m.AggregatedSignature)
+		copy(dAtA[i:], m.AggregatedSignature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AggregatedSignature)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Signatures) > 0 {
 		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1885,6 +1968,9 @@ func (m *PartSetHeader) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Parity != 0 {
+		n += 1 + sovTypes(uint64(m.Parity))
+	}
 	return n
 }
 
@@ -2024,6 +2110,14 @@ func (m *Vote) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.Extension)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ExtensionSignature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -2047,6 +2141,14 @@ func (m *Commit) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	l = len(m.AggregatedSignature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.AggregatedSigners)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -2263,6 +2365,25 @@ func (m *PartSetHeader) Unmarshal(dAtA []byte) error {
 				m.Hash = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parity", wireType)
+			}
+			m.Parity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parity |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3364,6 +3485,74 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extension = append(m.Extension[:0], dAtA[iNdEx:postIndex]...)
+			if m.Extension == nil {
+				m.Extension = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionSignature = append(m.ExtensionSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtensionSignature == nil {
+				m.ExtensionSignature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3519,6 +3708,74 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatedSignature = append(m.AggregatedSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.AggregatedSignature == nil {
+				m.AggregatedSignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedSigners", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatedSigners = append(m.AggregatedSigners[:0], dAtA[iNdEx:postIndex]...)
+			if m.AggregatedSigners == nil {
+				m.AggregatedSigners = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -309,6 +309,45 @@ func (c *baseRPCClient) NumUnconfirmedTxs(ctx context.Context) (*coretypes.Resul
 	return result, nil
 }
 
+func (c *baseRPCClient) MempoolTxs(
+	ctx context.Context,
+	filter coretypes.MempoolTxFilter,
+	page,
+	perPage *int,
+) (*coretypes.ResultMempoolTxs, error) {
+	result := new(coretypes.ResultMempoolTxs)
+	params := map[string]interface{}{
+		"sender": filter.Sender,
+	}
+
+	optional := map[string]*int64{
+		"min_priority":   filter.MinPriority,
+		"max_priority":   filter.MaxPriority,
+		"min_gas_wanted": filter.MinGasWanted,
+		"max_gas_wanted": filter.MaxGasWanted,
+		"min_age":        filter.MinAgeSeconds(),
+		"max_age":        filter.MaxAgeSeconds(),
+	}
+	for name, value := range optional {
+		if value != nil {
+			params[name] = value
+		}
+	}
+
+	if page != nil {
+		params["page"] = page
+	}
+	if perPage != nil {
+		params["per_page"] = perPage
+	}
+
+	_, err := c.caller.Call(ctx, "mempool_txs", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) CheckTx(ctx context.Context, tx types.Tx) (*coretypes.ResultCheckTx, error) {
 	result := new(coretypes.ResultCheckTx)
 	_, err := c.caller.Call(ctx, "check_tx", map[string]interface{}{"tx": tx}, result)
@@ -353,6 +392,22 @@ func (c *baseRPCClient) ConsensusState(ctx context.Context) (*coretypes.ResultCo
 	return result, nil
 }
 
+func (c *baseRPCClient) ConsensusTimeline(
+	ctx context.Context,
+	height *int64,
+) (*coretypes.ResultConsensusTimeline, error) {
+	result := new(coretypes.ResultConsensusTimeline)
+	params := make(map[string]interface{})
+	if height != nil {
+		params["height"] = height
+	}
+	_, err := c.caller.Call(ctx, "consensus_timeline", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) ConsensusParams(
 	ctx context.Context,
 	height *int64,
@@ -586,6 +641,25 @@ func (c *baseRPCClient) Validators(
 	return result, nil
 }
 
+func (c *baseRPCClient) SigningInfo(
+	ctx context.Context,
+	address bytes.HexBytes,
+	window *int64,
+) (*coretypes.ResultSigningInfo, error) {
+	result := new(coretypes.ResultSigningInfo)
+	params := map[string]interface{}{
+		"address": address,
+	}
+	if window != nil {
+		params["window"] = window
+	}
+	_, err := c.caller.Call(ctx, "signing_info", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) BroadcastEvidence(
 	ctx context.Context,
 	ev types.Evidence,
@@ -597,3 +671,57 @@ func (c *baseRPCClient) BroadcastEvidence(
 	}
 	return result, nil
 }
+
+func (c *baseRPCClient) PendingEvidence(
+	ctx context.Context,
+	page,
+	perPage *int,
+) (*coretypes.ResultEvidenceList, error) {
+	result := new(coretypes.ResultEvidenceList)
+	params := make(map[string]interface{})
+	if page != nil {
+		params["page"] = page
+	}
+	if perPage != nil {
+		params["per_page"] = perPage
+	}
+	_, err := c.caller.Call(ctx, "pending_evidence", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) Evidence(ctx context.Context, hash bytes.HexBytes) (*coretypes.ResultEvidence, error) {
+	result := new(coretypes.ResultEvidence)
+	_, err := c.caller.Call(ctx, "evidence", map[string]interface{}{"hash": hash}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) CommittedEvidence(
+	ctx context.Context,
+	minHeight,
+	maxHeight int64,
+	page,
+	perPage *int,
+) (*coretypes.ResultEvidenceList, error) {
+	result := new(coretypes.ResultEvidenceList)
+	params := map[string]interface{}{
+		"min_height": minHeight,
+		"max_height": maxHeight,
+	}
+	if page != nil {
+		params["page"] = page
+	}
+	if perPage != nil {
+		params["per_page"] = perPage
+	}
+	_, err := c.caller.Call(ctx, "committed_evidence", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
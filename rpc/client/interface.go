@@ -92,7 +92,7 @@ type SignClient interface {
 	) (*coretypes.ResultTxSearch, error)
 
 	// BlockSearch defines a method to search for a paginated set of blocks by
-	// BeginBlock and EndBlock event search criteria.
+	// FinalizeBlock event search criteria.
 	BlockSearch(
 		ctx context.Context,
 		query string,
@@ -106,6 +106,7 @@ type HistoryClient interface {
 	Genesis(context.Context) (*coretypes.ResultGenesis, error)
 	GenesisChunked(context.Context, uint) (*coretypes.ResultGenesisChunk, error)
 	BlockchainInfo(ctx context.Context, minHeight, maxHeight int64) (*coretypes.ResultBlockchainInfo, error)
+	SigningInfo(ctx context.Context, address bytes.HexBytes, window *int64) (*coretypes.ResultSigningInfo, error)
 }
 
 // StatusClient provides access to general chain info.
@@ -119,6 +120,7 @@ type NetworkClient interface {
 	NetInfo(context.Context) (*coretypes.ResultNetInfo, error)
 	DumpConsensusState(context.Context) (*coretypes.ResultDumpConsensusState, error)
 	ConsensusState(context.Context) (*coretypes.ResultConsensusState, error)
+	ConsensusTimeline(ctx context.Context, height *int64) (*coretypes.ResultConsensusTimeline, error)
 	ConsensusParams(ctx context.Context, height *int64) (*coretypes.ResultConsensusParams, error)
 	Health(context.Context) (*coretypes.ResultHealth, error)
 }
@@ -144,14 +146,18 @@ type EventsClient interface {
 type MempoolClient interface {
 	UnconfirmedTxs(ctx context.Context, limit *int) (*coretypes.ResultUnconfirmedTxs, error)
 	NumUnconfirmedTxs(context.Context) (*coretypes.ResultUnconfirmedTxs, error)
+	MempoolTxs(ctx context.Context, filter coretypes.MempoolTxFilter, page, perPage *int) (*coretypes.ResultMempoolTxs, error)
 	CheckTx(context.Context, types.Tx) (*coretypes.ResultCheckTx, error)
 	RemoveTx(context.Context, types.TxKey) error
 }
 
 // EvidenceClient is used for submitting an evidence of the malicious
-// behavior.
+// behavior and querying pending and committed evidence.
 type EvidenceClient interface {
 	BroadcastEvidence(context.Context, types.Evidence) (*coretypes.ResultBroadcastEvidence, error)
+	PendingEvidence(ctx context.Context, page, perPage *int) (*coretypes.ResultEvidenceList, error)
+	Evidence(ctx context.Context, hash bytes.HexBytes) (*coretypes.ResultEvidence, error)
+	CommittedEvidence(ctx context.Context, minHeight, maxHeight int64, page, perPage *int) (*coretypes.ResultEvidenceList, error)
 }
 
 // RemoteClient is a Client, which can also return the remote network address.
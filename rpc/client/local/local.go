@@ -113,6 +113,25 @@ func (c *Local) NumUnconfirmedTxs(ctx context.Context) (*coretypes.ResultUnconfi
 	return c.env.NumUnconfirmedTxs(c.ctx)
 }
 
+func (c *Local) MempoolTxs(
+	ctx context.Context,
+	filter coretypes.MempoolTxFilter,
+	page, perPage *int,
+) (*coretypes.ResultMempoolTxs, error) {
+	return c.env.MempoolTxs(
+		c.ctx,
+		filter.Sender,
+		filter.MinPriority,
+		filter.MaxPriority,
+		filter.MinGasWanted,
+		filter.MaxGasWanted,
+		filter.MinAgeSeconds(),
+		filter.MaxAgeSeconds(),
+		page,
+		perPage,
+	)
+}
+
 func (c *Local) CheckTx(ctx context.Context, tx types.Tx) (*coretypes.ResultCheckTx, error) {
 	return c.env.CheckTx(c.ctx, tx)
 }
@@ -133,6 +152,10 @@ func (c *Local) ConsensusState(ctx context.Context) (*coretypes.ResultConsensusS
 	return c.env.GetConsensusState(c.ctx)
 }
 
+func (c *Local) ConsensusTimeline(ctx context.Context, height *int64) (*coretypes.ResultConsensusTimeline, error) {
+	return c.env.ConsensusTimeline(c.ctx, height)
+}
+
 func (c *Local) ConsensusParams(ctx context.Context, height *int64) (*coretypes.ResultConsensusParams, error) {
 	return c.env.ConsensusParams(c.ctx, height)
 }
@@ -181,6 +204,14 @@ func (c *Local) Validators(ctx context.Context, height *int64, page, perPage *in
 	return c.env.Validators(c.ctx, height, page, perPage)
 }
 
+func (c *Local) SigningInfo(
+	ctx context.Context,
+	address bytes.HexBytes,
+	window *int64,
+) (*coretypes.ResultSigningInfo, error) {
+	return c.env.SigningInfo(c.ctx, address, window)
+}
+
 func (c *Local) Tx(ctx context.Context, hash bytes.HexBytes, prove bool) (*coretypes.ResultTx, error) {
 	return c.env.Tx(c.ctx, hash, prove)
 }
@@ -209,6 +240,22 @@ func (c *Local) BroadcastEvidence(ctx context.Context, ev types.Evidence) (*core
 	return c.env.BroadcastEvidence(c.ctx, ev)
 }
 
+func (c *Local) PendingEvidence(ctx context.Context, page, perPage *int) (*coretypes.ResultEvidenceList, error) {
+	return c.env.PendingEvidence(c.ctx, page, perPage)
+}
+
+func (c *Local) Evidence(ctx context.Context, hash bytes.HexBytes) (*coretypes.ResultEvidence, error) {
+	return c.env.Evidence(c.ctx, hash)
+}
+
+func (c *Local) CommittedEvidence(
+	ctx context.Context,
+	minHeight, maxHeight int64,
+	page, perPage *int,
+) (*coretypes.ResultEvidenceList, error) {
+	return c.env.CommittedEvidence(c.ctx, minHeight, maxHeight, page, perPage)
+}
+
 func (c *Local) Subscribe(
 	ctx context.Context,
 	subscriber,
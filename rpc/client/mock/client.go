@@ -123,6 +123,10 @@ func (c Client) DumpConsensusState(ctx context.Context) (*coretypes.ResultDumpCo
 	return c.env.DumpConsensusState(&rpctypes.Context{})
 }
 
+func (c Client) ConsensusTimeline(ctx context.Context, height *int64) (*coretypes.ResultConsensusTimeline, error) {
+	return c.env.ConsensusTimeline(&rpctypes.Context{}, height)
+}
+
 func (c Client) ConsensusParams(ctx context.Context, height *int64) (*coretypes.ResultConsensusParams, error) {
 	return c.env.ConsensusParams(&rpctypes.Context{}, height)
 }
@@ -342,6 +342,29 @@ func (_m *Client) Commit(ctx context.Context, height *int64) (*coretypes.ResultC
 	return r0, r1
 }
 
+// CommittedEvidence provides a mock function with given fields: ctx, minHeight, maxHeight, page, perPage
+func (_m *Client) CommittedEvidence(ctx context.Context, minHeight int64, maxHeight int64, page *int, perPage *int) (*coretypes.ResultEvidenceList, error) {
+	ret := _m.Called(ctx, minHeight, maxHeight, page, perPage)
+
+	var r0 *coretypes.ResultEvidenceList
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, *int, *int) *coretypes.ResultEvidenceList); ok {
+		r0 = rf(ctx, minHeight, maxHeight, page, perPage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultEvidenceList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, *int, *int) error); ok {
+		r1 = rf(ctx, minHeight, maxHeight, page, perPage)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ConsensusParams provides a mock function with given fields: ctx, height
 func (_m *Client) ConsensusParams(ctx context.Context, height *int64) (*coretypes.ResultConsensusParams, error) {
 	ret := _m.Called(ctx, height)
@@ -388,6 +411,29 @@ func (_m *Client) ConsensusState(_a0 context.Context) (*coretypes.ResultConsensu
 	return r0, r1
 }
 
+// ConsensusTimeline provides a mock function with given fields: ctx, height
+func (_m *Client) ConsensusTimeline(ctx context.Context, height *int64) (*coretypes.ResultConsensusTimeline, error) {
+	ret := _m.Called(ctx, height)
+
+	var r0 *coretypes.ResultConsensusTimeline
+	if rf, ok := ret.Get(0).(func(context.Context, *int64) *coretypes.ResultConsensusTimeline); ok {
+		r0 = rf(ctx, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultConsensusTimeline)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *int64) error); ok {
+		r1 = rf(ctx, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DumpConsensusState provides a mock function with given fields: _a0
 func (_m *Client) DumpConsensusState(_a0 context.Context) (*coretypes.ResultDumpConsensusState, error) {
 	ret := _m.Called(_a0)
@@ -411,6 +457,29 @@ func (_m *Client) DumpConsensusState(_a0 context.Context) (*coretypes.ResultDump
 	return r0, r1
 }
 
+// Evidence provides a mock function with given fields: ctx, hash
+func (_m *Client) Evidence(ctx context.Context, hash bytes.HexBytes) (*coretypes.ResultEvidence, error) {
+	ret := _m.Called(ctx, hash)
+
+	var r0 *coretypes.ResultEvidence
+	if rf, ok := ret.Get(0).(func(context.Context, bytes.HexBytes) *coretypes.ResultEvidence); ok {
+		r0 = rf(ctx, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultEvidence)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, bytes.HexBytes) error); ok {
+		r1 = rf(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Genesis provides a mock function with given fields: _a0
 func (_m *Client) Genesis(_a0 context.Context) (*coretypes.ResultGenesis, error) {
 	ret := _m.Called(_a0)
@@ -540,6 +609,29 @@ func (_m *Client) IsRunning() bool {
 	return r0
 }
 
+// MempoolTxs provides a mock function with given fields: ctx, filter, page, perPage
+func (_m *Client) MempoolTxs(ctx context.Context, filter coretypes.MempoolTxFilter, page *int, perPage *int) (*coretypes.ResultMempoolTxs, error) {
+	ret := _m.Called(ctx, filter, page, perPage)
+
+	var r0 *coretypes.ResultMempoolTxs
+	if rf, ok := ret.Get(0).(func(context.Context, coretypes.MempoolTxFilter, *int, *int) *coretypes.ResultMempoolTxs); ok {
+		r0 = rf(ctx, filter, page, perPage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultMempoolTxs)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, coretypes.MempoolTxFilter, *int, *int) error); ok {
+		r1 = rf(ctx, filter, page, perPage)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NetInfo provides a mock function with given fields: _a0
 func (_m *Client) NetInfo(_a0 context.Context) (*coretypes.ResultNetInfo, error) {
 	ret := _m.Called(_a0)
@@ -586,6 +678,29 @@ func (_m *Client) NumUnconfirmedTxs(_a0 context.Context) (*coretypes.ResultUncon
 	return r0, r1
 }
 
+// PendingEvidence provides a mock function with given fields: ctx, page, perPage
+func (_m *Client) PendingEvidence(ctx context.Context, page *int, perPage *int) (*coretypes.ResultEvidenceList, error) {
+	ret := _m.Called(ctx, page, perPage)
+
+	var r0 *coretypes.ResultEvidenceList
+	if rf, ok := ret.Get(0).(func(context.Context, *int, *int) *coretypes.ResultEvidenceList); ok {
+		r0 = rf(ctx, page, perPage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultEvidenceList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *int, *int) error); ok {
+		r1 = rf(ctx, page, perPage)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveTx provides a mock function with given fields: _a0, _a1
 func (_m *Client) RemoveTx(_a0 context.Context, _a1 types.TxKey) error {
 	ret := _m.Called(_a0, _a1)
@@ -600,6 +715,29 @@ func (_m *Client) RemoveTx(_a0 context.Context, _a1 types.TxKey) error {
 	return r0
 }
 
+// SigningInfo provides a mock function with given fields: ctx, address, window
+func (_m *Client) SigningInfo(ctx context.Context, address bytes.HexBytes, window *int64) (*coretypes.ResultSigningInfo, error) {
+	ret := _m.Called(ctx, address, window)
+
+	var r0 *coretypes.ResultSigningInfo
+	if rf, ok := ret.Get(0).(func(context.Context, bytes.HexBytes, *int64) *coretypes.ResultSigningInfo); ok {
+		r0 = rf(ctx, address, window)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultSigningInfo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, bytes.HexBytes, *int64) error); ok {
+		r1 = rf(ctx, address, window)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Client) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -20,6 +20,8 @@ import (
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/encoding"
 	"github.com/tendermint/tendermint/internal/mempool"
+	rpccore "github.com/tendermint/tendermint/internal/rpc/core"
+	sm "github.com/tendermint/tendermint/internal/state"
 	tmjson "github.com/tendermint/tendermint/libs/json"
 	"github.com/tendermint/tendermint/libs/log"
 	tmmath "github.com/tendermint/tendermint/libs/math"
@@ -201,7 +203,7 @@ func TestClientMethodCalls(t *testing.T) {
 	pool := getMempool(t, n)
 
 	// for evidence tests
-	pv, err := privval.LoadOrGenFilePV(conf.PrivValidator.KeyFile(), conf.PrivValidator.StateFile())
+	pv, err := privval.LoadOrGenFilePV(conf.PrivValidator.KeyFile(), conf.PrivValidator.StateFile(), nil)
 	require.NoError(t, err)
 
 	for i, c := range GetClients(t, n, conf) {
@@ -246,6 +248,28 @@ func TestClientMethodCalls(t *testing.T) {
 				require.NoError(t, err, "%d: %+v", i, err)
 				assert.NotEmpty(t, cons.RoundState)
 			})
+			t.Run("ConsensusTimeline", func(t *testing.T) {
+				nc, ok := c.(client.NetworkClient)
+				require.True(t, ok, "%d", i)
+
+				err := client.WaitForHeight(ctx, c, 2, nil)
+				require.NoError(t, err)
+
+				res, err := nc.ConsensusTimeline(ctx, nil)
+				require.NoError(t, err, "%d: %+v", i, err)
+				assert.NotEmpty(t, res.Timeline)
+
+				status, err := c.Status(ctx)
+				require.NoError(t, err)
+				height := status.SyncInfo.LatestBlockHeight
+				res, err = nc.ConsensusTimeline(ctx, &height)
+				require.NoError(t, err, "%d: %+v", i, err)
+				assert.Contains(t, string(res.Timeline), `"precommit"`)
+
+				height = 0
+				_, err = nc.ConsensusTimeline(ctx, &height)
+				assert.Error(t, err)
+			})
 			t.Run("Health", func(t *testing.T) {
 				nc, ok := c.(client.NetworkClient)
 				require.True(t, ok, "%d", i)
@@ -440,6 +464,33 @@ func TestClientMethodCalls(t *testing.T) {
 				assert.Nil(t, res)
 				assert.Contains(t, err.Error(), "can't be greater than max")
 			})
+			t.Run("SigningInfo", func(t *testing.T) {
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+
+				err := client.WaitForHeight(ctx, c, 3, nil)
+				require.NoError(t, err)
+
+				// the only validator signs every commit
+				address := pv.Key.PubKey.Address()
+				res, err := c.SigningInfo(ctx, address, nil)
+				require.NoError(t, err)
+				assert.EqualValues(t, address, res.Address)
+				assert.NotEmpty(t, res.Commits)
+				assert.EqualValues(t, len(res.Commits), res.SignedCount)
+				assert.Zero(t, res.MissedCount)
+				assert.Zero(t, res.FirstMissedHeight)
+				assert.Equal(t, float64(100), res.Uptime)
+
+				window := int64(1)
+				res, err = c.SigningInfo(ctx, address, &window)
+				require.NoError(t, err)
+				assert.Len(t, res.Commits, 1)
+				assert.Equal(t, res.ToHeight, res.Commits[0].Height)
+
+				_, err = c.SigningInfo(ctx, bytes.Repeat([]byte{0xab}, 20), nil)
+				assert.Error(t, err)
+			})
 			t.Run("BroadcastTxCommit", func(t *testing.T) {
 				_, _, tx := MakeTxKV()
 				bres, err := c.BroadcastTxCommit(ctx, tx)
@@ -528,11 +579,14 @@ func TestClientMethodCalls(t *testing.T) {
 
 					chainID := conf.ChainID()
 
-					correct, fakes := makeEvidences(t, pv, chainID)
-
 					// make sure that the node has produced enough blocks
 					waitForBlock(ctx, t, c, 2)
 
+					blockMeta := getBlockStore(t, n).LoadBlockMeta(1)
+					require.NotNil(t, blockMeta)
+
+					correct, fakes := makeEvidences(t, pv, chainID, blockMeta.Header.Time)
+
 					result, err := c.BroadcastEvidence(ctx, correct)
 					require.NoError(t, err, "BroadcastEvidence(%s) failed", correct)
 					assert.Equal(t, correct.Hash(), result.Hash, "expected result hash to match evidence hash")
@@ -568,6 +622,21 @@ func TestClientMethodCalls(t *testing.T) {
 					_, err := c.BroadcastEvidence(ctx, nil)
 					assert.Error(t, err)
 				})
+				t.Run("Query", func(t *testing.T) {
+					pending, err := c.PendingEvidence(ctx, nil, nil)
+					require.NoError(t, err)
+					assert.Equal(t, len(pending.Evidence), pending.TotalCount)
+
+					committed, err := c.CommittedEvidence(ctx, 0, 0, nil, nil)
+					require.NoError(t, err)
+					assert.Equal(t, len(committed.Evidence), committed.TotalCount)
+
+					_, err = c.Evidence(ctx, bytes.Repeat([]byte{0xab}, 32))
+					assert.Error(t, err)
+
+					_, err = c.CommittedEvidence(ctx, 5, 2, nil, nil)
+					assert.Error(t, err)
+				})
 			})
 		})
 	}
@@ -582,6 +651,15 @@ func getMempool(t *testing.T, srv service.Service) mempool.Mempool {
 	return n.Mempool()
 }
 
+func getBlockStore(t *testing.T, srv service.Service) sm.BlockStore {
+	t.Helper()
+	n, ok := srv.(interface {
+		RPCEnvironment() *rpccore.Environment
+	})
+	require.True(t, ok)
+	return n.RPCEnvironment().BlockStore
+}
+
 // these cases are roughly the same as the TestClientMethodCalls, but
 // they have to loop over their clients in the individual test cases,
 // so making a separate suite makes more sense, though isn't strictly
@@ -621,6 +699,44 @@ func TestClientMethodCallsAdvanced(t *testing.T) {
 
 		pool.Flush()
 	})
+	t.Run("MempoolTxs", func(t *testing.T) {
+		_, _, tx := MakeTxKV()
+		ch := make(chan struct{})
+
+		err := pool.CheckTx(ctx, tx, func(_ *abci.Response) { close(ch) }, mempool.TxInfo{})
+		require.NoError(t, err)
+
+		// wait for tx to arrive in mempoool.
+		select {
+		case <-ch:
+		case <-time.After(5 * time.Second):
+			t.Error("Timed out waiting for CheckTx callback")
+		}
+
+		maxPriority := int64(-1)
+		minAge := time.Hour
+		for _, c := range GetClients(t, n, conf) {
+			mc := c.(client.MempoolClient)
+			res, err := mc.MempoolTxs(ctx, coretypes.MempoolTxFilter{}, nil, nil)
+			require.NoError(t, err)
+
+			require.Equal(t, 1, res.TotalCount)
+			require.Len(t, res.Txs, 1)
+			assert.Exactly(t, types.Tx(tx), res.Txs[0].Tx)
+			assert.EqualValues(t, types.Tx(tx).Hash(), res.Txs[0].Hash)
+			assert.False(t, res.Txs[0].Timestamp.IsZero())
+
+			res, err = mc.MempoolTxs(ctx, coretypes.MempoolTxFilter{MaxPriority: &maxPriority}, nil, nil)
+			require.NoError(t, err)
+			assert.Zero(t, res.TotalCount)
+
+			res, err = mc.MempoolTxs(ctx, coretypes.MempoolTxFilter{MinAge: &minAge}, nil, nil)
+			require.NoError(t, err)
+			assert.Zero(t, res.TotalCount)
+		}
+
+		pool.Flush()
+	})
 	t.Run("NumUnconfirmedTxs", func(t *testing.T) {
 		ch := make(chan struct{})
 
@@ -757,8 +873,11 @@ func TestClientMethodCallsAdvanced(t *testing.T) {
 				assert.EqualValues(t, find.Hash, ptx.Hash)
 
 				// time to verify the proof
-				if assert.EqualValues(t, find.Tx, ptx.Proof.Data) {
-					assert.NoError(t, ptx.Proof.Proof.Verify(ptx.Proof.RootHash, find.Hash))
+				require.Len(t, result.Proofs, 1)
+				proof := result.Proofs[0]
+				assert.EqualValues(t, find.Height, proof.Height)
+				if assert.Equal(t, types.Txs{find.Tx}, proof.Proof.Data) {
+					assert.NoError(t, proof.Proof.Proof.Verify(proof.Proof.RootHash, [][]byte{find.Hash}))
 				}
 
 				// query by height
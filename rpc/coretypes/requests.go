@@ -0,0 +1,37 @@
+package coretypes
+
+import "time"
+
+// MempoolTxFilter selects the pending transactions listed by the mempool_txs
+// RPC. An empty Sender matches all senders and a nil bound is not checked.
+// Ages are rounded down to whole seconds.
+type MempoolTxFilter struct {
+	Sender       string
+	MinPriority  *int64
+	MaxPriority  *int64
+	MinGasWanted *int64
+	MaxGasWanted *int64
+	MinAge       *time.Duration
+	MaxAge       *time.Duration
+}
+
+// MinAgeSeconds returns the minimum age of the filter in seconds, or nil if it
+// is not set.
+func (f MempoolTxFilter) MinAgeSeconds() *int64 {
+	return durationSeconds(f.MinAge)
+}
+
+// MaxAgeSeconds returns the maximum age of the filter in seconds, or nil if it
+// is not set.
+func (f MempoolTxFilter) MaxAgeSeconds() *int64 {
+	return durationSeconds(f.MaxAge)
+}
+
+func durationSeconds(d *time.Duration) *int64 {
+	if d == nil {
+		return nil
+	}
+
+	seconds := int64(*d / time.Second)
+	return &seconds
+}
@@ -67,8 +67,7 @@ type ResultBlockResults struct {
 	Height                int64                     `json:"height"`
 	TxsResults            []*abci.ResponseDeliverTx `json:"txs_results"`
 	TotalGasUsed          int64                     `json:"total_gas_used"`
-	BeginBlockEvents      []abci.Event              `json:"begin_block_events"`
-	EndBlockEvents        []abci.Event              `json:"end_block_events"`
+	FinalizeBlockEvents   []abci.Event              `json:"finalize_block_events"`
 	ValidatorUpdates      []abci.ValidatorUpdate    `json:"validator_updates"`
 	ConsensusParamUpdates *tmproto.ConsensusParams  `json:"consensus_param_updates"`
 }
@@ -171,6 +170,26 @@ type ResultValidators struct {
 	Total int `json:"total"`
 }
 
+// SignedCommit reports whether a validator signed the commit of a height.
+type SignedCommit struct {
+	Height int64 `json:"height"`
+	Signed bool  `json:"signed"`
+}
+
+// Signing history of a validator over the commits from FromHeight to
+// ToHeight. Commits lists the heights at which the validator was in the
+// validator set, and Uptime is the percentage of them it signed.
+type ResultSigningInfo struct {
+	Address           bytes.HexBytes  `json:"address"`
+	FromHeight        int64           `json:"from_height"`
+	ToHeight          int64           `json:"to_height"`
+	Commits           []*SignedCommit `json:"commits"`
+	SignedCount       int64           `json:"signed_count"`
+	MissedCount       int64           `json:"missed_count"`
+	FirstMissedHeight int64           `json:"first_missed_height"`
+	Uptime            float64         `json:"uptime"`
+}
+
 // ConsensusParams for given height
 type ResultConsensusParams struct {
 	BlockHeight     int64                 `json:"block_height"`
@@ -195,6 +214,11 @@ type ResultConsensusState struct {
 	RoundState json.RawMessage `json:"round_state"`
 }
 
+// UNSTABLE
+type ResultConsensusTimeline struct {
+	Timeline json.RawMessage `json:"timeline"`
+}
+
 // CheckTx result
 type ResultBroadcastTx struct {
 	Code         uint32         `json:"code"`
@@ -231,8 +255,16 @@ type ResultTx struct {
 
 // Result of searching for txs
 type ResultTxSearch struct {
-	Txs        []*ResultTx `json:"txs"`
-	TotalCount int         `json:"total_count"`
+	Txs        []*ResultTx     `json:"txs"`
+	TotalCount int             `json:"total_count"`
+	Proofs     []ResultTxProof `json:"proofs,omitempty"`
+}
+
+// ResultTxProof proves the inclusion of the txs of a search result at one
+// height in the block.
+type ResultTxProof struct {
+	Height int64              `json:"height"`
+	Proof  types.TxMultiProof `json:"proof"`
 }
 
 // ResultBlockSearch defines the RPC response type for a block search by events.
@@ -249,6 +281,26 @@ type ResultUnconfirmedTxs struct {
 	Txs        []types.Tx `json:"txs"`
 }
 
+// ResultMempoolTx is a pending transaction along with the metadata the
+// mempool keeps for it.
+type ResultMempoolTx struct {
+	Hash      bytes.HexBytes `json:"hash"`
+	Tx        types.Tx       `json:"tx"`
+	Priority  int64          `json:"priority"`
+	Sender    string         `json:"sender"`
+	Nonce     uint64         `json:"nonce"`
+	GasWanted int64          `json:"gas_wanted"`
+	Height    int64          `json:"height"`
+	Timestamp time.Time      `json:"timestamp"`
+	Gapped    bool           `json:"gapped"`
+}
+
+// Result of listing pending txs
+type ResultMempoolTxs struct {
+	Txs        []*ResultMempoolTx `json:"txs"`
+	TotalCount int                `json:"total_count"`
+}
+
 // Info abci msg
 type ResultABCIInfo struct {
 	Response abci.ResponseInfo `json:"response"`
@@ -264,6 +316,30 @@ type ResultBroadcastEvidence struct {
 	Hash []byte `json:"hash"`
 }
 
+// ByzantineValidator is a validator punished by a piece of evidence along
+// with its voting power at the height of the misbehavior.
+type ByzantineValidator struct {
+	Address bytes.HexBytes `json:"address"`
+	Power   int64          `json:"power"`
+}
+
+// ResultEvidence is a piece of evidence along with the validators it
+// punishes. Height is the height of the block the evidence was committed in,
+// and 0 for pending evidence.
+type ResultEvidence struct {
+	Hash                bytes.HexBytes        `json:"hash"`
+	Evidence            types.Evidence        `json:"evidence"`
+	Height              int64                 `json:"height"`
+	ByzantineValidators []*ByzantineValidator `json:"byzantine_validators"`
+	TotalVotingPower    int64                 `json:"total_voting_power"`
+}
+
+// Result of listing pending or committed evidence
+type ResultEvidenceList struct {
+	Evidence   []*ResultEvidence `json:"evidence"`
+	TotalCount int               `json:"total_count"`
+}
+
 // empty results
 type (
 	ResultUnsafeFlushMempool struct{}
@@ -1,6 +1,9 @@
 /*
 	json2wal converts JSON file to binary WAL file.
 
+	Like the consensus WAL, every #ENDHEIGHT message starts a new segment, so
+	the WAL is written to <path-to-wal>.NNN files and <path-to-wal> itself.
+
 	Usage:
 			json2wal <path-to-JSON>  <path-to-wal>
 */
@@ -15,7 +18,9 @@ import (
 	"strings"
 
 	"github.com/tendermint/tendermint/internal/consensus"
+	auto "github.com/tendermint/tendermint/internal/libs/autofile"
 	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/types"
 )
 
@@ -31,18 +36,23 @@ func main() {
 	}
 	defer f.Close()
 
-	walFile, err := os.OpenFile(os.Args[2], os.O_EXCL|os.O_WRONLY|os.O_CREATE, 0666)
+	if _, err := os.Stat(os.Args[2]); !os.IsNotExist(err) {
+		panic(fmt.Errorf("failed to open WAL file: %s already exists", os.Args[2]))
+	}
+
+	group, err := auto.OpenGroup(log.NewNopLogger(), os.Args[2], auto.GroupHeadSizeLimit(0))
 	if err != nil {
 		panic(fmt.Errorf("failed to open WAL file: %w", err))
 	}
-	defer walFile.Close()
+	defer group.Close()
 
 	// the length of tendermint/wal/MsgInfo in the wal.json may exceed the defaultBufSize(4096) of bufio
 	// because of the byte array in BlockPart
 	// leading to unmarshal error: unexpected end of JSON input
 	br := bufio.NewReaderSize(f, int(2*types.BlockPartSizeBytes))
-	dec := consensus.NewWALEncoder(walFile)
+	dec := consensus.NewWALEncoder(group)
 
+	written := false
 	for {
 		msgJSON, _, err := br.ReadLine()
 		if err == io.EOF {
@@ -61,9 +71,18 @@ func main() {
 			panic(fmt.Errorf("failed to unmarshal json: %w", err))
 		}
 
+		if _, ok := msg.Msg.(consensus.EndHeightMessage); ok && written {
+			group.RotateFile()
+		}
+
 		err = dec.Encode(&msg)
 		if err != nil {
 			panic(fmt.Errorf("failed to encode msg: %w", err))
 		}
+		written = true
+	}
+
+	if err := group.FlushAndSync(); err != nil {
+		panic(fmt.Errorf("failed to write WAL file: %w", err))
 	}
 }
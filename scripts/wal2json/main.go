@@ -1,6 +1,9 @@
 /*
 	wal2json converts binary WAL file to JSON.
 
+	The WAL is read from all of its segments, the files <path-to-wal>.NNN
+	followed by <path-to-wal> itself, oldest first.
+
 	Usage:
 			wal2json <path-to-wal>
 */
@@ -13,7 +16,9 @@ import (
 	"os"
 
 	"github.com/tendermint/tendermint/internal/consensus"
+	auto "github.com/tendermint/tendermint/internal/libs/autofile"
 	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/log"
 )
 
 func main() {
@@ -22,13 +27,23 @@ func main() {
 		os.Exit(1)
 	}
 
-	f, err := os.Open(os.Args[1])
+	if _, err := os.Stat(os.Args[1]); err != nil {
+		panic(fmt.Errorf("failed to open WAL file: %w", err))
+	}
+
+	group, err := auto.OpenGroup(log.NewNopLogger(), os.Args[1])
 	if err != nil {
 		panic(fmt.Errorf("failed to open WAL file: %w", err))
 	}
-	defer f.Close()
+	defer group.Close()
+
+	gr, err := group.NewReader(group.MinIndex())
+	if err != nil {
+		panic(fmt.Errorf("failed to open WAL segment: %w", err))
+	}
+	defer gr.Close()
 
-	dec := consensus.NewWALDecoder(f)
+	dec := consensus.NewWALDecoder(gr)
 	for {
 		msg, err := dec.Decode()
 		if err == io.EOF {
@@ -219,7 +219,7 @@ func startLightNode(ctx context.Context, cfg *Config) error {
 
 // startSigner starts a signer server connecting to the given endpoint.
 func startSigner(ctx context.Context, cfg *Config) error {
-	filePV, err := privval.LoadFilePV(cfg.PrivValKey, cfg.PrivValState)
+	filePV, err := privval.LoadFilePV(cfg.PrivValKey, cfg.PrivValState, nil)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	gogotypes "github.com/gogo/protobuf/types"
 
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/crypto/merkle"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/libs/bits"
@@ -127,6 +128,14 @@ func (b *Block) Hash() tmbytes.HexBytes {
 // This is the form in which the block is gossipped to peers.
 // CONTRACT: partSize is greater than zero.
 func (b *Block) MakePartSet(partSize uint32) (*PartSet, error) {
+	return b.MakeErasureCodedPartSet(partSize, 0)
+}
+
+// MakeErasureCodedPartSet returns a PartSet containing parts of a serialized
+// block and parity parity parts, any Total - parity of which rebuild the
+// block. See NewErasureCodedPartSetFromData.
+// CONTRACT: partSize is greater than zero.
+func (b *Block) MakeErasureCodedPartSet(partSize, parity uint32) (*PartSet, error) {
 	if b == nil {
 		return nil, errors.New("nil block")
 	}
@@ -141,7 +150,7 @@ func (b *Block) MakePartSet(partSize uint32) (*PartSet, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewPartSetFromData(bz, partSize), nil
+	return NewErasureCodedPartSetFromData(bz, partSize, parity)
 }
 
 // HashesTo is a convenience function that checks if a block hashes to the given argument.
@@ -595,9 +604,9 @@ const (
 const (
 	// Max size of commit without any commitSigs -> 82 for BlockID, 8 for Height, 4 for Round.
 	MaxCommitOverheadBytes int64 = 94
-	// Commit sig size is made up of 64 bytes for the signature, 20 bytes for the address,
+	// Commit sig size is made up of 96 bytes for the signature, 20 bytes for the address,
 	// 1 byte for the flag and 14 bytes for the timestamp
-	MaxCommitSigBytes int64 = 109
+	MaxCommitSigBytes int64 = 141
 )
 
 // CommitSig is a part of the Vote included in a Commit.
@@ -618,9 +627,13 @@ func NewCommitSigForBlock(signature []byte, valAddr Address, ts time.Time) Commi
 	}
 }
 
+// MaxCommitBytes returns the maximum size of a commit of valCount signatures.
+// An aggregated commit is always smaller than that, as its aggregated
+// signature and signer bitmap take less space than the signatures they
+// replace.
 func MaxCommitBytes(valCount int) int64 {
 	// From the repeated commit sig field
-	var protoEncodingOverhead int64 = 2
+	var protoEncodingOverhead int64 = 3
 	return MaxCommitOverheadBytes + ((MaxCommitSigBytes + protoEncodingOverhead) * int64(valCount))
 }
 
@@ -675,6 +688,12 @@ func (cs CommitSig) BlockID(commitBlockID BlockID) BlockID {
 
 // ValidateBasic performs basic validation.
 func (cs CommitSig) ValidateBasic() error {
+	return cs.validateBasic(false)
+}
+
+// validateBasic performs basic validation. The CommitSig of an aggregated
+// commit carries no signature of its own.
+func (cs CommitSig) validateBasic(aggregated bool) error {
 	switch cs.BlockIDFlag {
 	case BlockIDFlagAbsent:
 	case BlockIDFlagCommit:
@@ -702,6 +721,12 @@ func (cs CommitSig) ValidateBasic() error {
 			)
 		}
 		// NOTE: Timestamp validation is subtle and handled elsewhere.
+		if aggregated {
+			if len(cs.Signature) != 0 {
+				return errors.New("signature is present in aggregated commit")
+			}
+			break
+		}
 		if len(cs.Signature) == 0 {
 			return errors.New("signature is missing")
 		}
@@ -730,13 +755,15 @@ func (cs *CommitSig) ToProto() *tmproto.CommitSig {
 // FromProto sets a protobuf CommitSig to the given pointer.
 // It returns an error if the CommitSig is invalid.
 func (cs *CommitSig) FromProto(csp tmproto.CommitSig) error {
+	cs.fromProto(csp)
+	return cs.ValidateBasic()
+}
 
+func (cs *CommitSig) fromProto(csp tmproto.CommitSig) {
 	cs.BlockIDFlag = BlockIDFlag(csp.BlockIdFlag)
 	cs.ValidatorAddress = csp.ValidatorAddress
 	cs.Timestamp = csp.Timestamp
 	cs.Signature = csp.Signature
-
-	return cs.ValidateBasic()
 }
 
 //-------------------------------------
@@ -753,6 +780,12 @@ type Commit struct {
 	BlockID    BlockID     `json:"block_id"`
 	Signatures []CommitSig `json:"signatures"`
 
+	// AggregatedSignature, if set, is the aggregated BLS12-381 signature of
+	// the validators in AggregatedSigners, whose CommitSigs then carry no
+	// signature of their own. See AggregateCommit.
+	AggregatedSignature []byte         `json:"aggregated_signature,omitempty"`
+	AggregatedSigners   *bits.BitArray `json:"aggregated_signers,omitempty"`
+
 	// Memoized in first call to corresponding method.
 	// NOTE: can't memoize in constructor because constructor isn't used for
 	// unmarshaling.
@@ -775,6 +808,12 @@ func NewCommit(height int64, round int32, blockID BlockID, commitSigs []CommitSi
 // Inverse of VoteSet.MakeCommit().
 func CommitToVoteSet(chainID string, commit *Commit, vals *ValidatorSet) *VoteSet {
 	voteSet := NewVoteSet(chainID, commit.Height, commit.Round, tmproto.PrecommitType, vals)
+	if commit.IsAggregated() {
+		if _, err := voteSet.AddCommit(commit); err != nil {
+			panic(fmt.Errorf("failed to reconstruct LastCommit: %w", err))
+		}
+		return voteSet
+	}
 	for idx, commitSig := range commit.Signatures {
 		if commitSig.Absent() {
 			continue // OK, some precommits can be missing.
@@ -787,6 +826,52 @@ func CommitToVoteSet(chainID string, commit *Commit, vals *ValidatorSet) *VoteSe
 	return voteSet
 }
 
+// AggregateCommit returns a copy of the commit with the signatures of its
+// CommitSigs aggregated into a single signature, if every validator of vals,
+// the validator set that signed the commit, has a BLS12-381 key. Otherwise, or
+// if the commit is already aggregated or empty, the commit is returned as is.
+func AggregateCommit(commit *Commit, vals *ValidatorSet) (*Commit, error) {
+	if commit.IsAggregated() || len(commit.Signatures) == 0 || vals.IsNilOrEmpty() {
+		return commit, nil
+	}
+	for _, val := range vals.Validators {
+		if val.PubKey.Type() != bls12381.KeyType {
+			return commit, nil
+		}
+	}
+	if vals.Size() != len(commit.Signatures) {
+		return nil, NewErrInvalidCommitSignatures(vals.Size(), len(commit.Signatures))
+	}
+
+	var (
+		sigs       = make([][]byte, 0, len(commit.Signatures))
+		commitSigs = make([]CommitSig, len(commit.Signatures))
+		signers    = bits.NewBitArray(len(commit.Signatures))
+	)
+	for i, commitSig := range commit.Signatures {
+		if !commitSig.Absent() {
+			sigs = append(sigs, commitSig.Signature)
+			signers.SetIndex(i, true)
+			commitSig.Signature = nil
+		}
+		commitSigs[i] = commitSig
+	}
+	aggSig, err := bls12381.AggregateSignatures(sigs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate commit signatures: %w", err)
+	}
+
+	aggCommit := NewCommit(commit.Height, commit.Round, commit.BlockID, commitSigs)
+	aggCommit.AggregatedSignature = aggSig
+	aggCommit.AggregatedSigners = signers
+	return aggCommit, nil
+}
+
+// IsAggregated returns true if the signatures of the commit are aggregated.
+func (commit *Commit) IsAggregated() bool {
+	return commit != nil && (len(commit.AggregatedSignature) != 0 || commit.AggregatedSigners != nil)
+}
+
 // GetVote converts the CommitSig for the given valIdx to a Vote.
 // Returns nil if the precommit at valIdx is nil.
 // Panics if valIdx >= commit.Size().
@@ -890,11 +975,45 @@ func (commit *Commit) ValidateBasic() error {
 		if len(commit.Signatures) == 0 {
 			return errors.New("no signatures in commit")
 		}
+		aggregated := commit.IsAggregated()
+		if aggregated {
+			if err := commit.validateAggregated(); err != nil {
+				return err
+			}
+		}
 		for i, commitSig := range commit.Signatures {
-			if err := commitSig.ValidateBasic(); err != nil {
+			if err := commitSig.validateBasic(aggregated); err != nil {
 				return fmt.Errorf("wrong CommitSig #%d: %v", i, err)
 			}
 		}
+	} else if commit.IsAggregated() {
+		return errors.New("aggregated signature in empty commit")
+	}
+	return nil
+}
+
+// validateAggregated checks that the aggregated signature is well sized and
+// that its signers are exactly the validators whose CommitSig isn't absent.
+func (commit *Commit) validateAggregated() error {
+	if len(commit.AggregatedSignature) != bls12381.SignatureSize {
+		return fmt.Errorf("expected aggregated signature size to be %d bytes, got %d bytes",
+			bls12381.SignatureSize,
+			len(commit.AggregatedSignature),
+		)
+	}
+	if commit.AggregatedSigners == nil {
+		return errors.New("aggregated signers are missing")
+	}
+	if commit.AggregatedSigners.Size() != len(commit.Signatures) {
+		return fmt.Errorf("expected %d aggregated signers, got %d",
+			len(commit.Signatures),
+			commit.AggregatedSigners.Size(),
+		)
+	}
+	for i, commitSig := range commit.Signatures {
+		if commit.AggregatedSigners.GetIndex(i) == commitSig.Absent() {
+			return fmt.Errorf("aggregated signers don't match CommitSig #%d", i)
+		}
 	}
 	return nil
 }
@@ -915,6 +1034,11 @@ func (commit *Commit) Hash() tmbytes.HexBytes {
 
 			bs[i] = bz
 		}
+		// the aggregated signature is hashed as one more leaf, so that the hash
+		// of commits that aren't aggregated is left unchanged.
+		if commit.IsAggregated() {
+			bs = append(bs, commit.AggregatedSignature)
+		}
 		commit.hash = merkle.HashFromByteSlices(bs)
 	}
 	return commit.hash
@@ -929,18 +1053,23 @@ func (commit *Commit) StringIndented(indent string) string {
 	for i, commitSig := range commit.Signatures {
 		commitSigStrings[i] = commitSig.String()
 	}
+	var aggregated string
+	if commit.IsAggregated() {
+		aggregated = fmt.Sprintf("\n%s  Aggregated: %X by %v", indent,
+			tmbytes.Fingerprint(commit.AggregatedSignature), commit.AggregatedSigners)
+	}
 	return fmt.Sprintf(`Commit{
 %s  Height:     %d
 %s  Round:      %d
 %s  BlockID:    %v
 %s  Signatures:
-%s    %v
+%s    %v%s
 %s}#%v`,
 		indent, commit.Height,
 		indent, commit.Round,
 		indent, commit.BlockID,
 		indent,
-		indent, strings.Join(commitSigStrings, "\n"+indent+"    "),
+		indent, strings.Join(commitSigStrings, "\n"+indent+"    "), aggregated,
 		indent, commit.hash)
 }
 
@@ -960,6 +1089,10 @@ func (commit *Commit) ToProto() *tmproto.Commit {
 	c.Height = commit.Height
 	c.Round = commit.Round
 	c.BlockID = commit.BlockID.ToProto()
+	c.AggregatedSignature = commit.AggregatedSignature
+	if commit.AggregatedSigners != nil {
+		c.AggregatedSigners = commit.AggregatedSigners.Bytes()
+	}
 
 	return c
 }
@@ -980,8 +1113,15 @@ func CommitFromProto(cp *tmproto.Commit) (*Commit, error) {
 		return nil, err
 	}
 
+	// the CommitSigs of an aggregated commit carry no signature, so they are
+	// validated along with the commit instead.
+	aggregated := len(cp.AggregatedSignature) != 0 || len(cp.AggregatedSigners) != 0
 	sigs := make([]CommitSig, len(cp.Signatures))
 	for i := range cp.Signatures {
+		if aggregated {
+			sigs[i].fromProto(cp.Signatures[i])
+			continue
+		}
 		if err := sigs[i].FromProto(cp.Signatures[i]); err != nil {
 			return nil, err
 		}
@@ -991,10 +1131,37 @@ func CommitFromProto(cp *tmproto.Commit) (*Commit, error) {
 	commit.Height = cp.Height
 	commit.Round = cp.Round
 	commit.BlockID = *bi
+	if aggregated {
+		commit.AggregatedSignature = cp.AggregatedSignature
+		commit.AggregatedSigners, err = bitArrayFromBytes(len(sigs), cp.AggregatedSigners)
+		if err != nil {
+			return nil, fmt.Errorf("invalid aggregated signers: %w", err)
+		}
+	}
 
 	return commit, commit.ValidateBasic()
 }
 
+// bitArrayFromBytes is the inverse of BitArray.Bytes for a bit array of the
+// given size.
+func bitArrayFromBytes(size int, bz []byte) (*bits.BitArray, error) {
+	if len(bz) != (size+7)/8 {
+		return nil, fmt.Errorf("expected %d bytes for %d bits, got %d", (size+7)/8, size, len(bz))
+	}
+	bA := bits.NewBitArray(size)
+	for i := 0; i < len(bz)*8; i++ {
+		set := bz[i/8]&(1<<uint(i%8)) != 0
+		if i >= size {
+			if set {
+				return nil, fmt.Errorf("bit %d is set beyond size %d", i, size)
+			}
+			continue
+		}
+		bA.SetIndex(i, set)
+	}
+	return bA, nil
+}
+
 //-----------------------------------------------------------------------------
 
 // Data contains the set of transactions included in the block
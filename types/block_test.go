@@ -218,7 +218,7 @@ func makeBlockIDRandom() BlockID {
 	)
 	rand.Read(blockHash)   //nolint: errcheck // ignore errcheck for read
 	rand.Read(partSetHash) //nolint: errcheck // ignore errcheck for read
-	return BlockID{blockHash, PartSetHeader{123, partSetHash}}
+	return BlockID{blockHash, PartSetHeader{Total: 123, Hash: partSetHash}}
 }
 
 func makeBlockID(hash []byte, partSetSize uint32, partSetHash []byte) BlockID {
@@ -504,11 +504,11 @@ func TestBlockMaxDataBytes(t *testing.T) {
 	}{
 		0: {-10, 1, 0, true, 0},
 		1: {10, 1, 0, true, 0},
-		2: {841, 1, 0, true, 0},
-		3: {842, 1, 0, false, 0},
-		4: {843, 1, 0, false, 1},
-		5: {954, 2, 0, false, 1},
-		6: {1053, 2, 100, false, 0},
+		2: {874, 1, 0, true, 0},
+		3: {875, 1, 0, false, 0},
+		4: {876, 1, 0, false, 1},
+		5: {1020, 2, 0, false, 1},
+		6: {1119, 2, 100, false, 0},
 	}
 
 	for i, tc := range testCases {
@@ -535,9 +535,9 @@ func TestBlockMaxDataBytesNoEvidence(t *testing.T) {
 	}{
 		0: {-10, 1, true, 0},
 		1: {10, 1, true, 0},
-		2: {841, 1, true, 0},
-		3: {842, 1, false, 0},
-		4: {843, 1, false, 1},
+		2: {874, 1, true, 0},
+		3: {875, 1, false, 0},
+		4: {876, 1, false, 1},
 	}
 
 	for i, tc := range testCases {
@@ -586,6 +586,54 @@ func TestCommitToVoteSet(t *testing.T) {
 	}
 }
 
+func TestCommitToVoteSetAggregated(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	blockID := makeBlockIDRandom()
+	valSet, vals := randBlsValidatorPrivValSet(ctx, t, 4, 1)
+	voteSet := NewVoteSet("test_chain_id", 2, 1, tmproto.PrecommitType, valSet)
+	commit, err := makeCommit(ctx, blockID, 2, 1, voteSet, vals[:3], time.Now())
+	require.NoError(t, err)
+	aggCommit, err := AggregateCommit(commit, valSet)
+	require.NoError(t, err)
+
+	// the aggregated commit survives a round trip through protobuf
+	pb, err := CommitFromProto(aggCommit.ToProto())
+	require.NoError(t, err)
+	assert.Equal(t, aggCommit.Hash(), pb.Hash())
+	assert.Equal(t, aggCommit.AggregatedSigners.String(), pb.AggregatedSigners.String())
+
+	voteSet2 := CommitToVoteSet(voteSet.ChainID(), pb, valSet)
+	assert.True(t, voteSet2.HasTwoThirdsMajority())
+	assert.Equal(t, aggCommit.BitArray().String(), voteSet2.BitArray().String())
+	assert.Equal(t, pb, voteSet2.AggregatedCommit())
+	assert.Equal(t, pb, voteSet2.MakeCommit())
+
+	// the missing vote can still be added with its signature
+	vote := &Vote{
+		ValidatorAddress: valSet.Validators[3].Address,
+		ValidatorIndex:   3,
+		Height:           2,
+		Round:            1,
+		Type:             tmproto.PrecommitType,
+		BlockID:          blockID,
+		Timestamp:        time.Now(),
+	}
+	added, err := signAddVote(ctx, vals[3], vote, voteSet2)
+	require.NoError(t, err)
+	assert.True(t, added)
+	assert.True(t, voteSet2.HasAll())
+
+	// an aggregated commit that doesn't verify isn't added
+	voteSet3 := NewVoteSet("test_chain_id", 2, 1, tmproto.PrecommitType, valSet)
+	pb.AggregatedSignature = commit.Signatures[0].Signature
+	added, err = voteSet3.AddCommit(pb)
+	assert.Error(t, err)
+	assert.False(t, added)
+	assert.False(t, voteSet3.HasTwoThirdsAny())
+}
+
 func TestCommitToVoteSetWithVotesForNilBlock(t *testing.T) {
 	blockID := makeBlockID([]byte("blockhash"), 1000, []byte("partshash"))
 
@@ -24,6 +24,15 @@ const (
 	EventTxValue                  = "Tx"
 	EventValidatorSetUpdatesValue = "ValidatorSetUpdates"
 
+	// Mempool events.
+	// These are triggered when a transaction is admitted to or rejected by the
+	// mempool, and when a pending transaction leaves the mempool.
+	EventAdmittedTxValue  = "AdmittedTx"
+	EventCommittedTxValue = "CommittedTx"
+	EventEvictedTxValue   = "EvictedTx"
+	EventRejectedTxValue  = "RejectedTx"
+	EventReplacedTxValue  = "ReplacedTx"
+
 	// Internal consensus events.
 	// These are used for testing the consensus state machine.
 	// They can also be used to build real-time consensus visualizers.
@@ -107,6 +116,11 @@ func init() {
 	tmjson.RegisterType(EventDataString(""), "tendermint/event/ProposalString")
 	tmjson.RegisterType(EventDataBlockSyncStatus{}, "tendermint/event/FastSyncStatus")
 	tmjson.RegisterType(EventDataStateSyncStatus{}, "tendermint/event/StateSyncStatus")
+	tmjson.RegisterType(EventDataAdmittedTx{}, "tendermint/event/AdmittedTx")
+	tmjson.RegisterType(EventDataRejectedTx{}, "tendermint/event/RejectedTx")
+	tmjson.RegisterType(EventDataEvictedTx{}, "tendermint/event/EvictedTx")
+	tmjson.RegisterType(EventDataReplacedTx{}, "tendermint/event/ReplacedTx")
+	tmjson.RegisterType(EventDataCommittedTx{}, "tendermint/event/CommittedTx")
 }
 
 // Most event messages are basic types (a block, a transaction)
@@ -116,16 +130,14 @@ type EventDataNewBlock struct {
 	Block   *Block  `json:"block"`
 	BlockID BlockID `json:"block_id"`
 
-	ResultBeginBlock abci.ResponseBeginBlock `json:"result_begin_block"`
-	ResultEndBlock   abci.ResponseEndBlock   `json:"result_end_block"`
+	ResultFinalizeBlock abci.ResponseFinalizeBlock `json:"result_finalize_block"`
 }
 
 type EventDataNewBlockHeader struct {
 	Header Header `json:"header"`
 
-	NumTxs           int64                   `json:"num_txs"` // Number of txs in a block
-	ResultBeginBlock abci.ResponseBeginBlock `json:"result_begin_block"`
-	ResultEndBlock   abci.ResponseEndBlock   `json:"result_end_block"`
+	NumTxs              int64                      `json:"num_txs"` // Number of txs in a block
+	ResultFinalizeBlock abci.ResponseFinalizeBlock `json:"result_finalize_block"`
 }
 
 type EventDataNewEvidence struct {
@@ -191,6 +203,71 @@ type EventDataStateSyncStatus struct {
 	Height   int64 `json:"height"`
 }
 
+// EventDataAdmittedTx is fired when a transaction passes CheckTx and is added
+// to the mempool.
+type EventDataAdmittedTx struct {
+	Tx       Tx     `json:"tx"`
+	Priority int64  `json:"priority"`
+	Sender   string `json:"sender"`
+	Nonce    uint64 `json:"nonce"`
+}
+
+// EventDataRejectedTx is fired when a transaction is not admitted to the
+// mempool, or when a pending transaction fails to be re-checked. Code is the
+// CheckTx response code, which is zero if the transaction was valid but did
+// not fit in the mempool.
+type EventDataRejectedTx struct {
+	Tx     Tx     `json:"tx"`
+	Code   uint32 `json:"code"`
+	Reason string `json:"reason"`
+}
+
+// Reasons for evicting a pending transaction from the mempool.
+const (
+	// EvictReasonSize is used when a transaction is evicted from a full
+	// mempool to make room for a transaction with a higher priority.
+	EvictReasonSize = "size"
+	// EvictReasonTTL is used when a transaction exceeds the mempool TTL.
+	EvictReasonTTL = "ttl"
+	// EvictReasonNonce is used when another transaction of the same sender
+	// and nonce is committed.
+	EvictReasonNonce = "nonce"
+)
+
+// EventDataEvictedTx is fired when a pending transaction is evicted from the
+// mempool without being committed.
+type EventDataEvictedTx struct {
+	Tx       Tx     `json:"tx"`
+	Priority int64  `json:"priority"`
+	Sender   string `json:"sender"`
+	Reason   string `json:"reason"`
+
+	// NewTx is the incoming transaction that caused the eviction, if the
+	// mempool was full.
+	NewTx Tx `json:"new_tx"`
+}
+
+// EventDataReplacedTx is fired when a pending transaction is replaced by a
+// transaction with the same sender and nonce, but a higher priority.
+type EventDataReplacedTx struct {
+	Sender string `json:"sender"`
+	Nonce  uint64 `json:"nonce"`
+
+	OldTx       Tx    `json:"old_tx"`
+	OldPriority int64 `json:"old_priority"`
+	NewTx       Tx    `json:"new_tx"`
+	NewPriority int64 `json:"new_priority"`
+}
+
+// EventDataCommittedTx is fired when a pending transaction is removed from the
+// mempool because it was included in a block.
+type EventDataCommittedTx struct {
+	Tx       Tx     `json:"tx"`
+	Height   int64  `json:"height"`
+	Priority int64  `json:"priority"`
+	Sender   string `json:"sender"`
+}
+
 // PUBSUB
 
 const (
@@ -202,17 +279,22 @@ const (
 	// TxHeightKey is a reserved key, used to specify transaction block's height.
 	// see EventBus#PublishEventTx
 	TxHeightKey = "tx.height"
+	// TxSenderKey is a reserved key, used to specify the sender of a pending
+	// transaction in mempool events.
+	// see EventBus#PublishEventAdmittedTx
+	TxSenderKey = "tx.sender"
 
-	// BlockHeightKey is a reserved key used for indexing BeginBlock and Endblock
-	// events.
+	// BlockHeightKey is a reserved key used for indexing FinalizeBlock events.
 	BlockHeightKey = "block.height"
 
-	EventTypeBeginBlock = "begin_block"
-	EventTypeEndBlock   = "end_block"
+	EventTypeFinalizeBlock = "finalize_block"
 )
 
 var (
+	EventQueryAdmittedTx          = QueryForEvent(EventAdmittedTxValue)
+	EventQueryCommittedTx         = QueryForEvent(EventCommittedTxValue)
 	EventQueryCompleteProposal    = QueryForEvent(EventCompleteProposalValue)
+	EventQueryEvictedTx           = QueryForEvent(EventEvictedTxValue)
 	EventQueryLock                = QueryForEvent(EventLockValue)
 	EventQueryNewBlock            = QueryForEvent(EventNewBlockValue)
 	EventQueryNewBlockHeader      = QueryForEvent(EventNewBlockHeaderValue)
@@ -220,7 +302,9 @@ var (
 	EventQueryNewRound            = QueryForEvent(EventNewRoundValue)
 	EventQueryNewRoundStep        = QueryForEvent(EventNewRoundStepValue)
 	EventQueryPolka               = QueryForEvent(EventPolkaValue)
+	EventQueryRejectedTx          = QueryForEvent(EventRejectedTxValue)
 	EventQueryRelock              = QueryForEvent(EventRelockValue)
+	EventQueryReplacedTx          = QueryForEvent(EventReplacedTxValue)
 	EventQueryTimeoutPropose      = QueryForEvent(EventTimeoutProposeValue)
 	EventQueryTimeoutWait         = QueryForEvent(EventTimeoutWaitValue)
 	EventQueryTx                  = QueryForEvent(EventTxValue)
@@ -252,3 +336,13 @@ type BlockEventPublisher interface {
 type TxEventPublisher interface {
 	PublishEventTx(context.Context, EventDataTx) error
 }
+
+// MempoolEventPublisher publishes the events of transactions entering and
+// leaving the mempool.
+type MempoolEventPublisher interface {
+	PublishEventAdmittedTx(context.Context, EventDataAdmittedTx) error
+	PublishEventRejectedTx(context.Context, EventDataRejectedTx) error
+	PublishEventEvictedTx(context.Context, EventDataEvictedTx) error
+	PublishEventReplacedTx(context.Context, EventDataReplacedTx) error
+	PublishEventCommittedTx(context.Context, EventDataCommittedTx) error
+}
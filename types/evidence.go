@@ -11,6 +11,7 @@ import (
 	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/merkle"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmjson "github.com/tendermint/tendermint/libs/json"
@@ -224,6 +225,209 @@ func DuplicateVoteEvidenceFromProto(pb *tmproto.DuplicateVoteEvidence) (*Duplica
 	return dve, dve.ValidateBasic()
 }
 
+//------------------------------------ PROPOSAL EVIDENCE -----------------------------------
+
+// DuplicateProposalEvidence contains evidence of a single validator signing two
+// conflicting proposals for the same height and round.
+type DuplicateProposalEvidence struct {
+	ProposalA *Proposal `json:"proposal_a"`
+	ProposalB *Proposal `json:"proposal_b"`
+	// the proposer of the round, which signed both proposals
+	ValidatorAddress Address `json:"validator_address"`
+
+	// abci specific information
+	TotalVotingPower int64
+	ValidatorPower   int64
+	Timestamp        time.Time
+}
+
+var _ Evidence = &DuplicateProposalEvidence{}
+
+// NewDuplicateProposalEvidence creates DuplicateProposalEvidence with right
+// ordering given two conflicting proposals. valSet must be the validator set at
+// the height of the proposals, from which the proposer of their round is
+// derived. If either of the proposals is nil or the val set is nil or empty,
+// an error is returned.
+func NewDuplicateProposalEvidence(proposal1, proposal2 *Proposal, blockTime time.Time, valSet *ValidatorSet,
+) (*DuplicateProposalEvidence, error) {
+	var proposalA, proposalB *Proposal
+	if proposal1 == nil || proposal2 == nil {
+		return nil, errors.New("missing proposal")
+	}
+	if valSet.IsNilOrEmpty() {
+		return nil, errors.New("missing validator set")
+	}
+	proposer := valSet.GetProposerForRound(proposal1.Round)
+
+	if strings.Compare(proposal1.BlockID.Key(), proposal2.BlockID.Key()) == -1 {
+		proposalA = proposal1
+		proposalB = proposal2
+	} else {
+		proposalA = proposal2
+		proposalB = proposal1
+	}
+	return &DuplicateProposalEvidence{
+		ProposalA:        proposalA,
+		ProposalB:        proposalB,
+		ValidatorAddress: proposer.Address,
+		TotalVotingPower: valSet.TotalVotingPower(),
+		ValidatorPower:   proposer.VotingPower,
+		Timestamp:        blockTime,
+	}, nil
+}
+
+// ABCI returns the application relevant representation of the evidence
+func (dpe *DuplicateProposalEvidence) ABCI() []abci.Evidence {
+	return []abci.Evidence{{
+		Type: abci.EvidenceType_DUPLICATE_PROPOSAL,
+		Validator: abci.Validator{
+			Address: dpe.ValidatorAddress,
+			Power:   dpe.ValidatorPower,
+		},
+		Height:           dpe.ProposalA.Height,
+		Time:             dpe.Timestamp,
+		TotalVotingPower: dpe.TotalVotingPower,
+	}}
+}
+
+// Bytes returns the proto-encoded evidence as a byte array.
+func (dpe *DuplicateProposalEvidence) Bytes() []byte {
+	pbe := dpe.ToProto()
+	bz, err := pbe.Marshal()
+	if err != nil {
+		panic("marshaling duplicate proposal evidence to bytes: " + err.Error())
+	}
+
+	return bz
+}
+
+// Hash returns the hash of the evidence.
+func (dpe *DuplicateProposalEvidence) Hash() []byte {
+	return tmhash.Sum(dpe.Bytes())
+}
+
+// Height returns the height of the infraction
+func (dpe *DuplicateProposalEvidence) Height() int64 {
+	return dpe.ProposalA.Height
+}
+
+// String returns a string representation of the evidence.
+func (dpe *DuplicateProposalEvidence) String() string {
+	return fmt.Sprintf("DuplicateProposalEvidence{ProposalA: %v, ProposalB: %v, Validator: %X}",
+		dpe.ProposalA, dpe.ProposalB, dpe.ValidatorAddress)
+}
+
+// Time returns the time of the infraction
+func (dpe *DuplicateProposalEvidence) Time() time.Time {
+	return dpe.Timestamp
+}
+
+// ValidateBasic performs basic validation.
+func (dpe *DuplicateProposalEvidence) ValidateBasic() error {
+	if dpe == nil {
+		return errors.New("empty duplicate proposal evidence")
+	}
+
+	if dpe.ProposalA == nil || dpe.ProposalB == nil {
+		return fmt.Errorf("one or both of the proposals are empty %v, %v", dpe.ProposalA, dpe.ProposalB)
+	}
+	if err := dpe.ProposalA.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid ProposalA: %w", err)
+	}
+	if err := dpe.ProposalB.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid ProposalB: %w", err)
+	}
+	if len(dpe.ValidatorAddress) != crypto.AddressSize {
+		return fmt.Errorf("expected ValidatorAddress size to be %d bytes, got %d bytes",
+			crypto.AddressSize,
+			len(dpe.ValidatorAddress),
+		)
+	}
+	// Enforce Proposals are lexicographically sorted on blockID
+	if strings.Compare(dpe.ProposalA.BlockID.Key(), dpe.ProposalB.BlockID.Key()) >= 0 {
+		return errors.New("duplicate proposals in invalid order")
+	}
+	return nil
+}
+
+// ValidateABCI validates the ABCI component of the evidence by checking the
+// timestamp, validator power and total voting power.
+func (dpe *DuplicateProposalEvidence) ValidateABCI(
+	val *Validator,
+	valSet *ValidatorSet,
+	evidenceTime time.Time,
+) error {
+
+	if dpe.Timestamp != evidenceTime {
+		return fmt.Errorf(
+			"evidence has a different time to the block it is associated with (%v != %v)",
+			dpe.Timestamp, evidenceTime)
+	}
+
+	if val.VotingPower != dpe.ValidatorPower {
+		return fmt.Errorf("validator power from evidence and our validator set does not match (%d != %d)",
+			dpe.ValidatorPower, val.VotingPower)
+	}
+	if valSet.TotalVotingPower() != dpe.TotalVotingPower {
+		return fmt.Errorf("total voting power from the evidence and our validator set does not match (%d != %d)",
+			dpe.TotalVotingPower, valSet.TotalVotingPower())
+	}
+
+	return nil
+}
+
+// GenerateABCI populates the ABCI component of the evidence. This includes the
+// validator power, timestamp and total voting power.
+func (dpe *DuplicateProposalEvidence) GenerateABCI(
+	val *Validator,
+	valSet *ValidatorSet,
+	evidenceTime time.Time,
+) {
+	dpe.ValidatorPower = val.VotingPower
+	dpe.TotalVotingPower = valSet.TotalVotingPower()
+	dpe.Timestamp = evidenceTime
+}
+
+// ToProto encodes DuplicateProposalEvidence to protobuf
+func (dpe *DuplicateProposalEvidence) ToProto() *tmproto.DuplicateProposalEvidence {
+	return &tmproto.DuplicateProposalEvidence{
+		ProposalA:        dpe.ProposalA.ToProto(),
+		ProposalB:        dpe.ProposalB.ToProto(),
+		ValidatorAddress: dpe.ValidatorAddress,
+		TotalVotingPower: dpe.TotalVotingPower,
+		ValidatorPower:   dpe.ValidatorPower,
+		Timestamp:        dpe.Timestamp,
+	}
+}
+
+// DuplicateProposalEvidenceFromProto decodes protobuf into DuplicateProposalEvidence
+func DuplicateProposalEvidenceFromProto(pb *tmproto.DuplicateProposalEvidence) (*DuplicateProposalEvidence, error) {
+	if pb == nil {
+		return nil, errors.New("nil duplicate proposal evidence")
+	}
+
+	pA, err := ProposalFromProto(pb.ProposalA)
+	if err != nil {
+		return nil, err
+	}
+
+	pB, err := ProposalFromProto(pb.ProposalB)
+	if err != nil {
+		return nil, err
+	}
+
+	dpe := &DuplicateProposalEvidence{
+		ProposalA:        pA,
+		ProposalB:        pB,
+		ValidatorAddress: pb.ValidatorAddress,
+		TotalVotingPower: pb.TotalVotingPower,
+		ValidatorPower:   pb.ValidatorPower,
+		Timestamp:        pb.Timestamp,
+	}
+
+	return dpe, dpe.ValidateBasic()
+}
+
 //------------------------------------ LIGHT EVIDENCE --------------------------------------
 
 // LightClientAttackEvidence is a generalized evidence that captures all forms of known attacks on
@@ -576,6 +780,16 @@ func (evl EvidenceList) Has(evidence Evidence) bool {
 	return false
 }
 
+// ToABCI converts the evidence list to the ABCI representation of the
+// misbehaving validators it implicates.
+func (evl EvidenceList) ToABCI() []abci.Evidence {
+	el := make([]abci.Evidence, 0)
+	for _, e := range evl {
+		el = append(el, e.ABCI()...)
+	}
+	return el
+}
+
 //------------------------------------------ PROTO --------------------------------------
 
 // EvidenceToProto is a generalized function for encoding evidence that conforms to the
@@ -605,6 +819,14 @@ func EvidenceToProto(evidence Evidence) (*tmproto.Evidence, error) {
 			},
 		}, nil
 
+	case *DuplicateProposalEvidence:
+		pbev := evi.ToProto()
+		return &tmproto.Evidence{
+			Sum: &tmproto.Evidence_DuplicateProposalEvidence{
+				DuplicateProposalEvidence: pbev,
+			},
+		}, nil
+
 	default:
 		return nil, fmt.Errorf("toproto: evidence is not recognized: %T", evi)
 	}
@@ -622,6 +844,8 @@ func EvidenceFromProto(evidence *tmproto.Evidence) (Evidence, error) {
 		return DuplicateVoteEvidenceFromProto(evi.DuplicateVoteEvidence)
 	case *tmproto.Evidence_LightClientAttackEvidence:
 		return LightClientAttackEvidenceFromProto(evi.LightClientAttackEvidence)
+	case *tmproto.Evidence_DuplicateProposalEvidence:
+		return DuplicateProposalEvidenceFromProto(evi.DuplicateProposalEvidence)
 	default:
 		return nil, errors.New("evidence is not recognized")
 	}
@@ -630,6 +854,7 @@ func EvidenceFromProto(evidence *tmproto.Evidence) (Evidence, error) {
 func init() {
 	tmjson.RegisterType(&DuplicateVoteEvidence{}, "tendermint/DuplicateVoteEvidence")
 	tmjson.RegisterType(&LightClientAttackEvidence{}, "tendermint/LightClientAttackEvidence")
+	tmjson.RegisterType(&DuplicateProposalEvidence{}, "tendermint/DuplicateProposalEvidence")
 }
 
 //-------------------------------------------- ERRORS --------------------------------------
@@ -699,6 +924,35 @@ func NewMockDuplicateVoteEvidenceWithValidator(ctx context.Context, height int64
 	return ev, nil
 }
 
+// assumes voting power to be 10 and validator to be the only one in the set
+func NewMockDuplicateProposalEvidenceWithValidator(
+	ctx context.Context,
+	height int64,
+	time time.Time,
+	pv PrivValidator,
+	chainID string,
+) (*DuplicateProposalEvidence, error) {
+	pubKey, err := pv.GetPubKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	val := NewValidator(pubKey, 10)
+	proposalA := NewProposal(height, 0, -1, randBlockID(), time)
+	pA := proposalA.ToProto()
+	_ = pv.SignProposal(ctx, chainID, pA)
+	proposalA.Signature = pA.Signature
+	proposalB := NewProposal(height, 0, -1, randBlockID(), time)
+	pB := proposalB.ToProto()
+	_ = pv.SignProposal(ctx, chainID, pB)
+	proposalB.Signature = pB.Signature
+	ev, err := NewDuplicateProposalEvidence(proposalA, proposalB, time, NewValidatorSet([]*Validator{val}))
+	if err != nil {
+		return nil, fmt.Errorf("constructing mock duplicate proposal evidence: %w", err)
+	}
+	return ev, nil
+}
+
 func makeMockVote(height int64, round, index int32, addr Address,
 	blockID BlockID, time time.Time) *Vote {
 	return &Vote{
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/tmhash"
@@ -104,6 +105,66 @@ func TestDuplicateVoteEvidenceValidation(t *testing.T) {
 	}
 }
 
+func TestDuplicateProposalEvidence(t *testing.T) {
+	const height = int64(13)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	val := NewMockPV()
+	ev, err := NewMockDuplicateProposalEvidenceWithValidator(ctx, height, time.Now(), val, "mock-chain-id")
+	require.NoError(t, err)
+	assert.Equal(t, ev.Hash(), tmhash.Sum(ev.Bytes()))
+	assert.NotNil(t, ev.String())
+	assert.Equal(t, ev.Height(), height)
+	assert.NoError(t, ev.ValidateBasic())
+
+	pubKey, err := val.GetPubKey(ctx)
+	require.NoError(t, err)
+	abciEv := ev.ABCI()
+	require.Len(t, abciEv, 1)
+	assert.Equal(t, abci.EvidenceType_DUPLICATE_PROPOSAL, abciEv[0].Type)
+	assert.Equal(t, pubKey.Address().Bytes(), abciEv[0].Validator.Address)
+	assert.EqualValues(t, 10, abciEv[0].Validator.Power)
+	assert.Equal(t, height, abciEv[0].Height)
+}
+
+func TestDuplicateProposalEvidenceValidation(t *testing.T) {
+	val := NewMockPV()
+	blockID := makeBlockID(tmhash.Sum([]byte("blockhash")), math.MaxInt32, tmhash.Sum([]byte("partshash")))
+	blockID2 := makeBlockID(tmhash.Sum([]byte("blockhash2")), math.MaxInt32, tmhash.Sum([]byte("partshash")))
+	const chainID = "mychain"
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	testCases := []struct {
+		testName         string
+		malleateEvidence func(*DuplicateProposalEvidence)
+		expectErr        bool
+	}{
+		{"Good DuplicateProposalEvidence", func(ev *DuplicateProposalEvidence) {}, false},
+		{"Nil proposal A", func(ev *DuplicateProposalEvidence) { ev.ProposalA = nil }, true},
+		{"Nil proposal B", func(ev *DuplicateProposalEvidence) { ev.ProposalB = nil }, true},
+		{"Unsigned proposal", func(ev *DuplicateProposalEvidence) { ev.ProposalA.Signature = nil }, true},
+		{"Missing validator address", func(ev *DuplicateProposalEvidence) { ev.ValidatorAddress = nil }, true},
+		{"Invalid proposal order", func(ev *DuplicateProposalEvidence) {
+			ev.ProposalA, ev.ProposalB = ev.ProposalB, ev.ProposalA
+		}, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			proposal1 := makeProposal(ctx, t, val, chainID, 10, 2, blockID, defaultVoteTime)
+			proposal2 := makeProposal(ctx, t, val, chainID, 10, 2, blockID2, defaultVoteTime)
+			valSet := NewValidatorSet([]*Validator{val.ExtractIntoValidator(ctx, 10)})
+			ev, err := NewDuplicateProposalEvidence(proposal1, proposal2, defaultVoteTime, valSet)
+			require.NoError(t, err)
+			tc.malleateEvidence(ev)
+			assert.Equal(t, tc.expectErr, ev.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+		})
+	}
+}
+
 func TestLightClientAttackEvidenceBasic(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -280,6 +341,17 @@ func makeVote(
 	return v
 }
 
+func makeProposal(
+	ctx context.Context,
+	t *testing.T, val PrivValidator, chainID string, height int64, round int32, blockID BlockID, time time.Time,
+) *Proposal {
+	p := NewProposal(height, round, -1, blockID, time)
+	ppb := p.ToProto()
+	require.NoError(t, val.SignProposal(ctx, chainID, ppb))
+	p.Signature = ppb.Signature
+	return p
+}
+
 func makeHeaderRandom() *Header {
 	return &Header{
 		Version:            version.Consensus{Block: version.BlockProtocol, App: 1},
@@ -311,6 +383,12 @@ func TestEvidenceProto(t *testing.T) {
 	v := makeVote(ctx, t, val, chainID, math.MaxInt32, math.MaxInt64, 1, 0x01, blockID, defaultVoteTime)
 	v2 := makeVote(ctx, t, val, chainID, math.MaxInt32, math.MaxInt64, 2, 0x01, blockID2, defaultVoteTime)
 
+	// -------- Proposals --------
+	pubKey, err := val.GetPubKey(ctx)
+	require.NoError(t, err)
+	p := makeProposal(ctx, t, val, chainID, math.MaxInt64, 1, blockID, defaultVoteTime)
+	p2 := makeProposal(ctx, t, val, chainID, math.MaxInt64, 1, blockID2, defaultVoteTime)
+
 	tests := []struct {
 		testName     string
 		evidence     Evidence
@@ -322,6 +400,10 @@ func TestEvidenceProto(t *testing.T) {
 		{"DuplicateVoteEvidence nil voteB", &DuplicateVoteEvidence{VoteA: v, VoteB: nil}, false, true},
 		{"DuplicateVoteEvidence nil voteA", &DuplicateVoteEvidence{VoteA: nil, VoteB: v}, false, true},
 		{"DuplicateVoteEvidence success", &DuplicateVoteEvidence{VoteA: v2, VoteB: v}, false, false},
+		{"DuplicateProposalEvidence empty fail", &DuplicateProposalEvidence{}, false, true},
+		{"DuplicateProposalEvidence nil proposalB", &DuplicateProposalEvidence{ProposalA: p, ProposalB: nil}, false, true},
+		{"DuplicateProposalEvidence success", &DuplicateProposalEvidence{
+			ProposalA: p2, ProposalB: p, ValidatorAddress: pubKey.Address()}, false, false},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -82,8 +82,11 @@ func (genDoc *GenesisDoc) ValidateAndComplete() error {
 
 	if genDoc.ConsensusParams == nil {
 		genDoc.ConsensusParams = DefaultConsensusParams()
-	} else if err := genDoc.ConsensusParams.ValidateConsensusParams(); err != nil {
-		return err
+	} else {
+		genDoc.ConsensusParams.Complete()
+		if err := genDoc.ConsensusParams.ValidateConsensusParams(); err != nil {
+			return err
+		}
 	}
 
 	for i, v := range genDoc.Validators {
@@ -3,8 +3,10 @@ package types
 import (
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/crypto/sr25519"
@@ -26,12 +28,14 @@ const (
 	ABCIPubKeyTypeEd25519   = ed25519.KeyType
 	ABCIPubKeyTypeSecp256k1 = secp256k1.KeyType
 	ABCIPubKeyTypeSr25519   = sr25519.KeyType
+	ABCIPubKeyTypeBls12381  = bls12381.KeyType
 )
 
 var ABCIPubKeyTypesToNames = map[string]string{
 	ABCIPubKeyTypeEd25519:   ed25519.PubKeyName,
 	ABCIPubKeyTypeSecp256k1: secp256k1.PubKeyName,
 	ABCIPubKeyTypeSr25519:   sr25519.PubKeyName,
+	ABCIPubKeyTypeBls12381:  bls12381.PubKeyName,
 }
 
 // ConsensusParams contains consensus critical parameters that determine the
@@ -41,6 +45,8 @@ type ConsensusParams struct {
 	Evidence  EvidenceParams  `json:"evidence"`
 	Validator ValidatorParams `json:"validator"`
 	Version   VersionParams   `json:"version"`
+	Synchrony SynchronyParams `json:"synchrony"`
+	Timeout   TimeoutParams   `json:"timeout"`
 }
 
 // HashedParams is a subset of ConsensusParams.
@@ -56,6 +62,12 @@ type HashedParams struct {
 type BlockParams struct {
 	MaxBytes int64 `json:"max_bytes"`
 	MaxGas   int64 `json:"max_gas"`
+
+	// HaltHeight is the height after whose commit the network stops
+	// participating in consensus, so that an upgraded binary can take over
+	// from a clean state. A node restarted at that height only resumes
+	// consensus if its app reports a new app version. 0 disables the halt.
+	HaltHeight int64 `json:"halt_height"`
 }
 
 // EvidenceParams determine how we handle evidence of malfeasance.
@@ -75,6 +87,28 @@ type VersionParams struct {
 	AppVersion uint64 `json:"app_version"`
 }
 
+// SynchronyParams bound the difference between the time of a proposed block,
+// set by its proposer, and the time at which a validator receives the
+// proposal. See Proposal#IsTimely.
+type SynchronyParams struct {
+	Precision    time.Duration `json:"precision"`
+	MessageDelay time.Duration `json:"message_delay"`
+}
+
+// TimeoutParams configure the timeouts of the steps of the consensus
+// algorithm. The propose, prevote and precommit timeouts grow by their delta
+// with every round.
+type TimeoutParams struct {
+	Propose             time.Duration `json:"propose"`
+	ProposeDelta        time.Duration `json:"propose_delta"`
+	Prevote             time.Duration `json:"prevote"`
+	PrevoteDelta        time.Duration `json:"prevote_delta"`
+	Precommit           time.Duration `json:"precommit"`
+	PrecommitDelta      time.Duration `json:"precommit_delta"`
+	Commit              time.Duration `json:"commit"`
+	BypassCommitTimeout bool          `json:"bypass_commit_timeout"`
+}
+
 // DefaultConsensusParams returns a default ConsensusParams.
 func DefaultConsensusParams() *ConsensusParams {
 	return &ConsensusParams{
@@ -82,6 +116,8 @@ func DefaultConsensusParams() *ConsensusParams {
 		Evidence:  DefaultEvidenceParams(),
 		Validator: DefaultValidatorParams(),
 		Version:   DefaultVersionParams(),
+		Synchrony: DefaultSynchronyParams(),
+		Timeout:   DefaultTimeoutParams(),
 	}
 }
 
@@ -116,6 +152,62 @@ func DefaultVersionParams() VersionParams {
 	}
 }
 
+// DefaultSynchronyParams returns a default SynchronyParams.
+func DefaultSynchronyParams() SynchronyParams {
+	return SynchronyParams{
+		Precision:    500 * time.Millisecond,
+		MessageDelay: 2 * time.Second,
+	}
+}
+
+// InRound returns the SynchronyParams to use in the given round. The message
+// delay grows by 10% with every round, so that a network whose message delay
+// was set too low still accepts a proposal after enough rounds.
+func (sp SynchronyParams) InRound(round int32) SynchronyParams {
+	return SynchronyParams{
+		Precision:    sp.Precision,
+		MessageDelay: time.Duration(math.Pow(1.1, float64(round)) * float64(sp.MessageDelay)),
+	}
+}
+
+// DefaultTimeoutParams returns a default TimeoutParams.
+func DefaultTimeoutParams() TimeoutParams {
+	return TimeoutParams{
+		Propose:             3000 * time.Millisecond,
+		ProposeDelta:        500 * time.Millisecond,
+		Prevote:             1000 * time.Millisecond,
+		PrevoteDelta:        500 * time.Millisecond,
+		Precommit:           1000 * time.Millisecond,
+		PrecommitDelta:      500 * time.Millisecond,
+		Commit:              1000 * time.Millisecond,
+		BypassCommitTimeout: false,
+	}
+}
+
+// ProposeTimeout returns the amount of time to wait for a proposal in the
+// given round.
+func (tp TimeoutParams) ProposeTimeout(round int32) time.Duration {
+	return tp.Propose + tp.ProposeDelta*time.Duration(round)
+}
+
+// PrevoteTimeout returns the amount of time to wait for straggler votes after
+// receiving any +2/3 prevotes in the given round.
+func (tp TimeoutParams) PrevoteTimeout(round int32) time.Duration {
+	return tp.Prevote + tp.PrevoteDelta*time.Duration(round)
+}
+
+// PrecommitTimeout returns the amount of time to wait for straggler votes
+// after receiving any +2/3 precommits in the given round.
+func (tp TimeoutParams) PrecommitTimeout(round int32) time.Duration {
+	return tp.Precommit + tp.PrecommitDelta*time.Duration(round)
+}
+
+// CommitTime returns the time at which to start the next height, after
+// receiving +2/3 precommits for a single block (ie. a commit) at t.
+func (tp TimeoutParams) CommitTime(t time.Time) time.Time {
+	return t.Add(tp.Commit)
+}
+
 func (val *ValidatorParams) IsValidPubkeyType(pubkeyType string) bool {
 	for i := 0; i < len(val.PubKeyTypes); i++ {
 		if val.PubKeyTypes[i] == pubkeyType {
@@ -125,6 +217,17 @@ func (val *ValidatorParams) IsValidPubkeyType(pubkeyType string) bool {
 	return false
 }
 
+// Complete sets the parameters that are missing from params, because they were
+// written before the parameters were introduced, to their defaults.
+func (params *ConsensusParams) Complete() {
+	if params.Synchrony == (SynchronyParams{}) {
+		params.Synchrony = DefaultSynchronyParams()
+	}
+	if params.Timeout == (TimeoutParams{}) {
+		params.Timeout = DefaultTimeoutParams()
+	}
+}
+
 // Validate validates the ConsensusParams to ensure all values are within their
 // allowed limits, and returns an error if they are not.
 func (params ConsensusParams) ValidateConsensusParams() error {
@@ -142,6 +245,11 @@ func (params ConsensusParams) ValidateConsensusParams() error {
 			params.Block.MaxGas)
 	}
 
+	if params.Block.HaltHeight < 0 {
+		return fmt.Errorf("block.HaltHeight must be greater or equal to 0. Got %d",
+			params.Block.HaltHeight)
+	}
+
 	if params.Evidence.MaxAgeNumBlocks <= 0 {
 		return fmt.Errorf("evidence.MaxAgeNumBlocks must be greater than 0. Got %d",
 			params.Evidence.MaxAgeNumBlocks)
@@ -162,6 +270,51 @@ func (params ConsensusParams) ValidateConsensusParams() error {
 			params.Evidence.MaxBytes)
 	}
 
+	if params.Synchrony.Precision <= 0 {
+		return fmt.Errorf("synchrony.Precision must be greater than 0. Got: %v",
+			params.Synchrony.Precision)
+	}
+
+	if params.Synchrony.MessageDelay <= 0 {
+		return fmt.Errorf("synchrony.MessageDelay must be greater than 0. Got: %v",
+			params.Synchrony.MessageDelay)
+	}
+
+	if params.Timeout.Propose <= 0 {
+		return fmt.Errorf("timeout.Propose must be greater than 0. Got: %v",
+			params.Timeout.Propose)
+	}
+
+	if params.Timeout.ProposeDelta < 0 {
+		return fmt.Errorf("timeout.ProposeDelta must be non negative. Got: %v",
+			params.Timeout.ProposeDelta)
+	}
+
+	if params.Timeout.Prevote <= 0 {
+		return fmt.Errorf("timeout.Prevote must be greater than 0. Got: %v",
+			params.Timeout.Prevote)
+	}
+
+	if params.Timeout.PrevoteDelta < 0 {
+		return fmt.Errorf("timeout.PrevoteDelta must be non negative. Got: %v",
+			params.Timeout.PrevoteDelta)
+	}
+
+	if params.Timeout.Precommit <= 0 {
+		return fmt.Errorf("timeout.Precommit must be greater than 0. Got: %v",
+			params.Timeout.Precommit)
+	}
+
+	if params.Timeout.PrecommitDelta < 0 {
+		return fmt.Errorf("timeout.PrecommitDelta must be non negative. Got: %v",
+			params.Timeout.PrecommitDelta)
+	}
+
+	if params.Timeout.Commit < 0 {
+		return fmt.Errorf("timeout.Commit must be non negative. Got: %v",
+			params.Timeout.Commit)
+	}
+
 	if len(params.Validator.PubKeyTypes) == 0 {
 		return errors.New("len(Validator.PubKeyTypes) must be greater than 0")
 	}
@@ -205,6 +358,8 @@ func (params ConsensusParams) HashConsensusParams() []byte {
 func (params *ConsensusParams) Equals(params2 *ConsensusParams) bool {
 	return params.Block == params2.Block &&
 		params.Evidence == params2.Evidence &&
+		params.Synchrony == params2.Synchrony &&
+		params.Timeout == params2.Timeout &&
 		tmstrings.StringSliceEqual(params.Validator.PubKeyTypes, params2.Validator.PubKeyTypes)
 }
 
@@ -221,6 +376,7 @@ func (params ConsensusParams) UpdateConsensusParams(params2 *tmproto.ConsensusPa
 	if params2.Block != nil {
 		res.Block.MaxBytes = params2.Block.MaxBytes
 		res.Block.MaxGas = params2.Block.MaxGas
+		res.Block.HaltHeight = params2.Block.HaltHeight
 	}
 	if params2.Evidence != nil {
 		res.Evidence.MaxAgeNumBlocks = params2.Evidence.MaxAgeNumBlocks
@@ -235,14 +391,22 @@ func (params ConsensusParams) UpdateConsensusParams(params2 *tmproto.ConsensusPa
 	if params2.Version != nil {
 		res.Version.AppVersion = params2.Version.AppVersion
 	}
+	if params2.Synchrony != nil {
+		res.Synchrony.Precision = params2.Synchrony.Precision
+		res.Synchrony.MessageDelay = params2.Synchrony.MessageDelay
+	}
+	if params2.Timeout != nil {
+		res.Timeout = timeoutParamsFromProto(params2.Timeout)
+	}
 	return res
 }
 
 func (params *ConsensusParams) ToProto() tmproto.ConsensusParams {
 	return tmproto.ConsensusParams{
 		Block: &tmproto.BlockParams{
-			MaxBytes: params.Block.MaxBytes,
-			MaxGas:   params.Block.MaxGas,
+			MaxBytes:   params.Block.MaxBytes,
+			MaxGas:     params.Block.MaxGas,
+			HaltHeight: params.Block.HaltHeight,
 		},
 		Evidence: &tmproto.EvidenceParams{
 			MaxAgeNumBlocks: params.Evidence.MaxAgeNumBlocks,
@@ -255,14 +419,32 @@ func (params *ConsensusParams) ToProto() tmproto.ConsensusParams {
 		Version: &tmproto.VersionParams{
 			AppVersion: params.Version.AppVersion,
 		},
+		Synchrony: &tmproto.SynchronyParams{
+			Precision:    params.Synchrony.Precision,
+			MessageDelay: params.Synchrony.MessageDelay,
+		},
+		Timeout: &tmproto.TimeoutParams{
+			Propose:             params.Timeout.Propose,
+			ProposeDelta:        params.Timeout.ProposeDelta,
+			Prevote:             params.Timeout.Prevote,
+			PrevoteDelta:        params.Timeout.PrevoteDelta,
+			Precommit:           params.Timeout.Precommit,
+			PrecommitDelta:      params.Timeout.PrecommitDelta,
+			Commit:              params.Timeout.Commit,
+			BypassCommitTimeout: params.Timeout.BypassCommitTimeout,
+		},
 	}
 }
 
+// ConsensusParamsFromProto converts pbParams to ConsensusParams. Parameters
+// stored before SynchronyParams or TimeoutParams were introduced get the
+// default ones.
 func ConsensusParamsFromProto(pbParams tmproto.ConsensusParams) ConsensusParams {
-	return ConsensusParams{
+	c := ConsensusParams{
 		Block: BlockParams{
-			MaxBytes: pbParams.Block.MaxBytes,
-			MaxGas:   pbParams.Block.MaxGas,
+			MaxBytes:   pbParams.Block.MaxBytes,
+			MaxGas:     pbParams.Block.MaxGas,
+			HaltHeight: pbParams.Block.HaltHeight,
 		},
 		Evidence: EvidenceParams{
 			MaxAgeNumBlocks: pbParams.Evidence.MaxAgeNumBlocks,
@@ -275,5 +457,30 @@ func ConsensusParamsFromProto(pbParams tmproto.ConsensusParams) ConsensusParams
 		Version: VersionParams{
 			AppVersion: pbParams.Version.AppVersion,
 		},
+		Synchrony: DefaultSynchronyParams(),
+		Timeout:   DefaultTimeoutParams(),
+	}
+	if pbParams.Synchrony != nil {
+		c.Synchrony = SynchronyParams{
+			Precision:    pbParams.Synchrony.Precision,
+			MessageDelay: pbParams.Synchrony.MessageDelay,
+		}
+	}
+	if pbParams.Timeout != nil {
+		c.Timeout = timeoutParamsFromProto(pbParams.Timeout)
+	}
+	return c
+}
+
+func timeoutParamsFromProto(pbParams *tmproto.TimeoutParams) TimeoutParams {
+	return TimeoutParams{
+		Propose:             pbParams.Propose,
+		ProposeDelta:        pbParams.ProposeDelta,
+		Prevote:             pbParams.Prevote,
+		PrevoteDelta:        pbParams.PrevoteDelta,
+		Precommit:           pbParams.Precommit,
+		PrecommitDelta:      pbParams.PrecommitDelta,
+		Commit:              pbParams.Commit,
+		BypassCommitTimeout: pbParams.BypassCommitTimeout,
 	}
 }
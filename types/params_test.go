@@ -69,9 +69,90 @@ func makeParams(
 		Validator: ValidatorParams{
 			PubKeyTypes: pubkeyTypes,
 		},
+		Synchrony: DefaultSynchronyParams(),
+		Timeout:   DefaultTimeoutParams(),
 	}
 }
 
+func TestConsensusParamsValidation_Synchrony(t *testing.T) {
+	testCases := []struct {
+		precision    time.Duration
+		messageDelay time.Duration
+		valid        bool
+	}{
+		{time.Millisecond, time.Second, true},
+		{0, time.Second, false},
+		{-time.Millisecond, time.Second, false},
+		{time.Millisecond, 0, false},
+		{time.Millisecond, -time.Second, false},
+	}
+	for i, tc := range testCases {
+		params := makeParams(1, 0, 2, 0, valEd25519)
+		params.Synchrony = SynchronyParams{Precision: tc.precision, MessageDelay: tc.messageDelay}
+		if tc.valid {
+			assert.NoErrorf(t, params.ValidateConsensusParams(), "expected no error for valid params (#%d)", i)
+		} else {
+			assert.Errorf(t, params.ValidateConsensusParams(), "expected error for non valid params (#%d)", i)
+		}
+	}
+}
+
+func TestConsensusParamsValidation_Timeout(t *testing.T) {
+	testCases := []struct {
+		malleate func(*TimeoutParams)
+		valid    bool
+	}{
+		{func(tp *TimeoutParams) {}, true},
+		{func(tp *TimeoutParams) { tp.ProposeDelta, tp.PrevoteDelta, tp.PrecommitDelta = 0, 0, 0 }, true},
+		{func(tp *TimeoutParams) { tp.Commit = 0 }, true},
+		{func(tp *TimeoutParams) { tp.Propose = 0 }, false},
+		{func(tp *TimeoutParams) { tp.ProposeDelta = -1 }, false},
+		{func(tp *TimeoutParams) { tp.Prevote = 0 }, false},
+		{func(tp *TimeoutParams) { tp.PrevoteDelta = -1 }, false},
+		{func(tp *TimeoutParams) { tp.Precommit = 0 }, false},
+		{func(tp *TimeoutParams) { tp.PrecommitDelta = -1 }, false},
+		{func(tp *TimeoutParams) { tp.Commit = -1 }, false},
+	}
+	for i, tc := range testCases {
+		params := makeParams(1, 0, 2, 0, valEd25519)
+		tc.malleate(&params.Timeout)
+		if tc.valid {
+			assert.NoErrorf(t, params.ValidateConsensusParams(), "expected no error for valid params (#%d)", i)
+		} else {
+			assert.Errorf(t, params.ValidateConsensusParams(), "expected error for non valid params (#%d)", i)
+		}
+	}
+}
+
+func TestTimeoutParamsRounds(t *testing.T) {
+	tp := TimeoutParams{
+		Propose:        3 * time.Second,
+		ProposeDelta:   500 * time.Millisecond,
+		Prevote:        time.Second,
+		PrevoteDelta:   100 * time.Millisecond,
+		Precommit:      2 * time.Second,
+		PrecommitDelta: 200 * time.Millisecond,
+		Commit:         time.Second,
+	}
+
+	assert.Equal(t, 3*time.Second, tp.ProposeTimeout(0))
+	assert.Equal(t, 4*time.Second, tp.ProposeTimeout(2))
+	assert.Equal(t, 1300*time.Millisecond, tp.PrevoteTimeout(3))
+	assert.Equal(t, 2200*time.Millisecond, tp.PrecommitTimeout(1))
+
+	now := time.Now()
+	assert.Equal(t, now.Add(time.Second), tp.CommitTime(now))
+}
+
+func TestSynchronyParamsInRound(t *testing.T) {
+	sp := SynchronyParams{Precision: time.Second, MessageDelay: 10 * time.Second}
+
+	assert.Equal(t, sp, sp.InRound(0))
+	assert.Equal(t, time.Second, sp.InRound(5).Precision)
+	assert.Equal(t, 11*time.Second, sp.InRound(1).MessageDelay)
+	assert.Greater(t, int64(sp.InRound(10).MessageDelay), int64(sp.InRound(9).MessageDelay))
+}
+
 func TestConsensusParamsHash(t *testing.T) {
 	params := []ConsensusParams{
 		makeParams(4, 2, 3, 1, valEd25519),
@@ -165,6 +246,61 @@ func TestConsensusParamsUpdate_AppVersion(t *testing.T) {
 	assert.EqualValues(t, 1, updated.Version.AppVersion)
 }
 
+func TestConsensusParamsUpdate_HaltHeight(t *testing.T) {
+	params := makeParams(1, 2, 3, 0, valEd25519)
+
+	updated := params.UpdateConsensusParams(
+		&tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxBytes: 1, MaxGas: 2, HaltHeight: 100}})
+
+	assert.EqualValues(t, 100, updated.Block.HaltHeight)
+	assert.NoError(t, updated.ValidateConsensusParams())
+	assert.Equal(t, updated, ConsensusParamsFromProto(updated.ToProto()))
+
+	updated.Block.HaltHeight = -1
+	assert.Error(t, updated.ValidateConsensusParams())
+}
+
+func TestConsensusParamsUpdate_Synchrony(t *testing.T) {
+	params := makeParams(1, 2, 3, 0, valEd25519)
+
+	updated := params.UpdateConsensusParams(
+		&tmproto.ConsensusParams{Synchrony: &tmproto.SynchronyParams{
+			Precision:    time.Second,
+			MessageDelay: 5 * time.Second,
+		}})
+
+	assert.Equal(t, SynchronyParams{Precision: time.Second, MessageDelay: 5 * time.Second}, updated.Synchrony)
+	assert.Equal(t, DefaultSynchronyParams(), params.Synchrony)
+}
+
+func TestConsensusParamsUpdate_Timeout(t *testing.T) {
+	params := makeParams(1, 2, 3, 0, valEd25519)
+
+	updated := params.UpdateConsensusParams(
+		&tmproto.ConsensusParams{Timeout: &tmproto.TimeoutParams{
+			Propose:             time.Second,
+			ProposeDelta:        time.Millisecond,
+			Prevote:             2 * time.Second,
+			PrevoteDelta:        2 * time.Millisecond,
+			Precommit:           3 * time.Second,
+			PrecommitDelta:      3 * time.Millisecond,
+			Commit:              4 * time.Second,
+			BypassCommitTimeout: true,
+		}})
+
+	assert.Equal(t, TimeoutParams{
+		Propose:             time.Second,
+		ProposeDelta:        time.Millisecond,
+		Prevote:             2 * time.Second,
+		PrevoteDelta:        2 * time.Millisecond,
+		Precommit:           3 * time.Second,
+		PrecommitDelta:      3 * time.Millisecond,
+		Commit:              4 * time.Second,
+		BypassCommitTimeout: true,
+	}, updated.Timeout)
+	assert.Equal(t, DefaultTimeoutParams(), params.Timeout)
+}
+
 func TestProto(t *testing.T) {
 	params := []ConsensusParams{
 		makeParams(4, 2, 3, 1, valEd25519),
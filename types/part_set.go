@@ -2,11 +2,14 @@ package types
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
 	"sync"
 
+	"github.com/klauspost/reedsolomon"
+
 	"github.com/tendermint/tendermint/crypto/merkle"
 	"github.com/tendermint/tendermint/libs/bits"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
@@ -18,8 +21,13 @@ import (
 var (
 	ErrPartSetUnexpectedIndex = errors.New("error part set unexpected index")
 	ErrPartSetInvalidProof    = errors.New("error part set invalid proof")
+	ErrPartSetInvalidCoding   = errors.New("error part set invalid erasure coding")
 )
 
+// ErasureCodedPartAlign is the size that the parts of an erasure coded part
+// set must be a multiple of.
+const ErasureCodedPartAlign = 64
+
 type Part struct {
 	Index uint32           `json:"index"`
 	Bytes tmbytes.HexBytes `json:"bytes"`
@@ -94,22 +102,35 @@ func PartFromProto(pb *tmproto.Part) (*Part, error) {
 type PartSetHeader struct {
 	Total uint32           `json:"total"`
 	Hash  tmbytes.HexBytes `json:"hash"`
+	// Parity is the number of the parts that are Reed-Solomon parity parts,
+	// zero unless the part set is erasure coded.
+	Parity uint32 `json:"parity,omitempty"`
 }
 
 // String returns a string representation of PartSetHeader.
 //
 // 1. total number of parts
-// 2. first 6 bytes of the hash
+// 2. number of parity parts, if erasure coded
+// 3. first 6 bytes of the hash
 func (psh PartSetHeader) String() string {
+	if psh.Parity > 0 {
+		return fmt.Sprintf("%v(%v parity):%X", psh.Total, psh.Parity, tmbytes.Fingerprint(psh.Hash))
+	}
 	return fmt.Sprintf("%v:%X", psh.Total, tmbytes.Fingerprint(psh.Hash))
 }
 
 func (psh PartSetHeader) IsZero() bool {
-	return psh.Total == 0 && len(psh.Hash) == 0
+	return psh.Total == 0 && len(psh.Hash) == 0 && psh.Parity == 0
 }
 
 func (psh PartSetHeader) Equals(other PartSetHeader) bool {
-	return psh.Total == other.Total && bytes.Equal(psh.Hash, other.Hash)
+	return psh.Total == other.Total && bytes.Equal(psh.Hash, other.Hash) && psh.Parity == other.Parity
+}
+
+// DataTotal returns the number of parts that hold the data, which is the
+// number of parts needed to rebuild it.
+func (psh PartSetHeader) DataTotal() uint32 {
+	return psh.Total - psh.Parity
 }
 
 // ValidateBasic performs basic validation.
@@ -118,6 +139,9 @@ func (psh PartSetHeader) ValidateBasic() error {
 	if err := ValidateHash(psh.Hash); err != nil {
 		return fmt.Errorf("wrong Hash: %w", err)
 	}
+	if 2*uint64(psh.Parity) > uint64(psh.Total) {
+		return fmt.Errorf("more parity parts (%d) than data parts (%d)", psh.Parity, psh.Total-psh.Parity)
+	}
 	return nil
 }
 
@@ -128,8 +152,9 @@ func (psh *PartSetHeader) ToProto() tmproto.PartSetHeader {
 	}
 
 	return tmproto.PartSetHeader{
-		Total: psh.Total,
-		Hash:  psh.Hash,
+		Total:  psh.Total,
+		Hash:   psh.Hash,
+		Parity: psh.Parity,
 	}
 }
 
@@ -141,6 +166,7 @@ func PartSetHeaderFromProto(ppsh *tmproto.PartSetHeader) (*PartSetHeader, error)
 	psh := new(PartSetHeader)
 	psh.Total = ppsh.Total
 	psh.Hash = ppsh.Hash
+	psh.Parity = ppsh.Parity
 
 	return psh, psh.ValidateBasic()
 }
@@ -148,16 +174,21 @@ func PartSetHeaderFromProto(ppsh *tmproto.PartSetHeader) (*PartSetHeader, error)
 //-------------------------------------
 
 type PartSet struct {
-	total uint32
-	hash  []byte
+	total  uint32
+	parity uint32
+	hash   []byte
 
 	mtx           sync.Mutex
 	parts         []*Part
 	partsBitArray *bits.BitArray
 	count         uint32
 	// a count of the total size (in bytes). Used to ensure that the
-	// part set doesn't exceed the maximum block bytes
+	// part set doesn't exceed the maximum block bytes. Parity parts are not
+	// counted.
 	byteSize int64
+	// set once the parts of an erasure coded part set turn out not to be
+	// an encoding of any data
+	codingErr error
 }
 
 // Returns an immutable, full PartSet from the data bytes.
@@ -166,30 +197,80 @@ type PartSet struct {
 func NewPartSetFromData(data []byte, partSize uint32) *PartSet {
 	// divide data into 4kb parts.
 	total := (uint32(len(data)) + partSize - 1) / partSize
-	parts := make([]*Part, total)
 	partsBytes := make([][]byte, total)
-	partsBitArray := bits.NewBitArray(int(total))
 	for i := uint32(0); i < total; i++ {
-		part := &Part{
-			Index: i,
-			Bytes: data[i*partSize : tmmath.MinInt(len(data), int((i+1)*partSize))],
-		}
-		parts[i] = part
-		partsBytes[i] = part.Bytes
-		partsBitArray.SetIndex(int(i), true)
+		partsBytes[i] = data[i*partSize : tmmath.MinInt(len(data), int((i+1)*partSize))]
+	}
+	return newFullPartSet(partsBytes, 0, int64(len(data)))
+}
+
+// NewErasureCodedPartSetFromData returns an immutable, full PartSet from the
+// data bytes, extended with parity Reed-Solomon parity parts so that any
+// Total - parity of its parts rebuild the data. The data is prefixed with its
+// length as a uvarint and padded, to be split into parts of exactly partSize
+// bytes, and the merkle tree is computed over all of the parts, parity parts
+// included. A parity of zero makes the same PartSet as NewPartSetFromData.
+// CONTRACT: partSize is greater than zero.
+func NewErasureCodedPartSetFromData(data []byte, partSize, parity uint32) (*PartSet, error) {
+	if parity == 0 {
+		return NewPartSetFromData(data, partSize), nil
+	}
+	if partSize%ErasureCodedPartAlign != 0 {
+		return nil, fmt.Errorf("part size %d is not a multiple of %d", partSize, ErasureCodedPartAlign)
+	}
+	dataTotal := ErasureCodedDataParts(len(data), partSize)
+	if parity > dataTotal {
+		return nil, fmt.Errorf("more parity parts (%d) than data parts (%d)", parity, dataTotal)
 	}
+
+	total := dataTotal + parity
+	buf := make([]byte, int(total)*int(partSize))
+	n := binary.PutUvarint(buf, uint64(len(data)))
+	copy(buf[n:], data)
+	partsBytes := make([][]byte, total)
+	for i := range partsBytes {
+		partsBytes[i] = buf[i*int(partSize) : (i+1)*int(partSize)]
+	}
+
+	enc, err := reedsolomon.New(int(dataTotal), int(parity))
+	if err != nil {
+		return nil, err
+	}
+	if err := enc.Encode(partsBytes); err != nil {
+		return nil, err
+	}
+	return newFullPartSet(partsBytes, parity, int64(len(data))), nil
+}
+
+// ErasureCodedDataParts returns the number of data parts of an erasure coded
+// part set of size bytes of data.
+func ErasureCodedDataParts(size int, partSize uint32) uint32 {
+	prefixed := binary.PutUvarint(make([]byte, binary.MaxVarintLen64), uint64(size)) + size
+	return uint32((prefixed + int(partSize) - 1) / int(partSize))
+}
+
+func newFullPartSet(partsBytes [][]byte, parity uint32, byteSize int64) *PartSet {
+	total := uint32(len(partsBytes))
+	parts := make([]*Part, total)
+	partsBitArray := bits.NewBitArray(int(total))
 	// Compute merkle proofs
 	root, proofs := merkle.ProofsFromByteSlices(partsBytes)
 	for i := uint32(0); i < total; i++ {
-		parts[i].Proof = *proofs[i]
+		parts[i] = &Part{
+			Index: i,
+			Bytes: partsBytes[i],
+			Proof: *proofs[i],
+		}
+		partsBitArray.SetIndex(int(i), true)
 	}
 	return &PartSet{
 		total:         total,
+		parity:        parity,
 		hash:          root,
 		parts:         parts,
 		partsBitArray: partsBitArray,
 		count:         total,
-		byteSize:      int64(len(data)),
+		byteSize:      byteSize,
 	}
 }
 
@@ -197,6 +278,7 @@ func NewPartSetFromData(data []byte, partSize uint32) *PartSet {
 func NewPartSetFromHeader(header PartSetHeader) *PartSet {
 	return &PartSet{
 		total:         header.Total,
+		parity:        header.Parity,
 		hash:          header.Hash,
 		parts:         make([]*Part, header.Total),
 		partsBitArray: bits.NewBitArray(int(header.Total)),
@@ -210,8 +292,9 @@ func (ps *PartSet) Header() PartSetHeader {
 		return PartSetHeader{}
 	}
 	return PartSetHeader{
-		Total: ps.total,
-		Hash:  ps.hash,
+		Total:  ps.total,
+		Hash:   ps.hash,
+		Parity: ps.parity,
 	}
 }
 
@@ -263,6 +346,11 @@ func (ps *PartSet) Total() uint32 {
 	return ps.total
 }
 
+// AddPart adds a part whose proof verifies against the hash of the part set.
+// Once an erasure coded part set has as many parts as data parts, the other
+// parts are rebuilt from them and it is complete. ErrPartSetInvalidCoding is
+// returned if the parts are not an erasure coding of any data, in which case
+// the part set can never be completed.
 func (ps *PartSet) AddPart(part *Part) (bool, error) {
 	if ps == nil {
 		return false, nil
@@ -270,6 +358,10 @@ func (ps *PartSet) AddPart(part *Part) (bool, error) {
 	ps.mtx.Lock()
 	defer ps.mtx.Unlock()
 
+	if ps.codingErr != nil {
+		return false, ps.codingErr
+	}
+
 	// Invalid part index
 	if part.Index >= ps.total {
 		return false, ErrPartSetUnexpectedIndex
@@ -289,10 +381,73 @@ func (ps *PartSet) AddPart(part *Part) (bool, error) {
 	ps.parts[part.Index] = part
 	ps.partsBitArray.SetIndex(int(part.Index), true)
 	ps.count++
-	ps.byteSize += int64(len(part.Bytes))
+	if part.Index < ps.total-ps.parity {
+		ps.byteSize += int64(len(part.Bytes))
+	}
+
+	if ps.parity > 0 && ps.count == ps.total-ps.parity {
+		if err := ps.reconstruct(); err != nil {
+			ps.codingErr = err
+			return false, err
+		}
+	}
 	return true, nil
 }
 
+// reconstruct rebuilds the missing parts of an erasure coded part set, and
+// checks that all of its parts hash to the part set hash and hold a valid
+// length prefix.
+// CONTRACT: ps.mtx is held and the part set has as many parts as data parts.
+func (ps *PartSet) reconstruct() error {
+	dataTotal := ps.total - ps.parity
+	partsBytes := make([][]byte, ps.total)
+	partSize := -1
+	for i, part := range ps.parts {
+		if part == nil {
+			continue
+		}
+		if partSize != -1 && len(part.Bytes) != partSize {
+			return ErrPartSetInvalidCoding
+		}
+		partSize = len(part.Bytes)
+		partsBytes[i] = part.Bytes
+	}
+	if partSize == 0 || partSize%ErasureCodedPartAlign != 0 {
+		return ErrPartSetInvalidCoding
+	}
+
+	enc, err := reedsolomon.New(int(dataTotal), int(ps.parity))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrPartSetInvalidCoding, err)
+	}
+	if err := enc.Reconstruct(partsBytes); err != nil {
+		return fmt.Errorf("%w: %v", ErrPartSetInvalidCoding, err)
+	}
+	root, proofs := merkle.ProofsFromByteSlices(partsBytes)
+	if !bytes.Equal(root, ps.hash) {
+		return ErrPartSetInvalidCoding
+	}
+	size, n := binary.Uvarint(partsBytes[0])
+	if n <= 0 || size > uint64(int(dataTotal)*partSize-n) {
+		return ErrPartSetInvalidCoding
+	}
+
+	for i, part := range ps.parts {
+		if part != nil {
+			continue
+		}
+		ps.parts[i] = &Part{
+			Index: uint32(i),
+			Bytes: partsBytes[i],
+			Proof: *proofs[i],
+		}
+		ps.partsBitArray.SetIndex(i, true)
+		ps.count++
+	}
+	ps.byteSize = int64(size)
+	return nil
+}
+
 func (ps *PartSet) GetPart(index int) *Part {
 	ps.mtx.Lock()
 	defer ps.mtx.Unlock()
@@ -303,13 +458,41 @@ func (ps *PartSet) IsComplete() bool {
 	return ps.count == ps.total
 }
 
+// GetReader returns a reader of the data of a complete part set, without the
+// parity parts and padding of an erasure coded part set.
 func (ps *PartSet) GetReader() io.Reader {
 	if !ps.IsComplete() {
 		panic("Cannot GetReader() on incomplete PartSet")
 	}
+	if ps.parity > 0 {
+		data, err := PartSetData(ps.Header(), ps.parts[:ps.total-ps.parity])
+		if err != nil {
+			// the length prefix is checked when the part set is made
+			panic(err)
+		}
+		return bytes.NewReader(data)
+	}
 	return NewPartSetReader(ps.parts)
 }
 
+// PartSetData returns the data held by the data parts of a part set with the
+// given header, ordered by index. These are all of the parts, except for the
+// parity parts of an erasure coded part set.
+func PartSetData(header PartSetHeader, dataParts []*Part) ([]byte, error) {
+	buf := []byte{}
+	for _, part := range dataParts {
+		buf = append(buf, part.Bytes...)
+	}
+	if header.Parity == 0 {
+		return buf, nil
+	}
+	size, n := binary.Uvarint(buf)
+	if n <= 0 || size > uint64(len(buf)-n) {
+		return nil, ErrPartSetInvalidCoding
+	}
+	return buf[n : n+int(size)], nil
+}
+
 type PartSetReader struct {
 	i      int
 	parts  []*Part
@@ -88,6 +88,99 @@ func TestWrongProof(t *testing.T) {
 	}
 }
 
+func TestErasureCodedPartSet(t *testing.T) {
+	const partSize = 4096
+	data := tmrand.Bytes(partSize*10 + 100)
+	partSet, err := NewErasureCodedPartSetFromData(data, partSize, 4)
+	require.NoError(t, err)
+
+	// the length prefix takes the data over 10 parts
+	header := partSet.Header()
+	assert.EqualValues(t, 15, header.Total)
+	assert.EqualValues(t, 4, header.Parity)
+	assert.EqualValues(t, 11, header.DataTotal())
+	assert.EqualValues(t, 11, ErasureCodedDataParts(len(data), partSize))
+	require.NoError(t, header.ValidateBasic())
+	assert.True(t, partSet.IsComplete())
+	assert.EqualValues(t, len(data), partSet.ByteSize())
+
+	data2, err := io.ReadAll(partSet.GetReader())
+	require.NoError(t, err)
+	assert.Equal(t, data, data2)
+
+	// any data total of the parts rebuild the part set
+	for _, missing := range [][]int{{0, 1, 2, 3}, {11, 12, 13, 14}, {0, 5, 10, 14}} {
+		partSet2 := NewPartSetFromHeader(header)
+		for i := 0; i < int(header.Total); i++ {
+			if contains(missing, i) {
+				continue
+			}
+			added, err := partSet2.AddPart(partSet.GetPart(i))
+			require.NoError(t, err)
+			require.True(t, added)
+		}
+		require.True(t, partSet2.IsComplete(), missing)
+		assert.EqualValues(t, len(data), partSet2.ByteSize())
+		for _, i := range missing {
+			assert.Equal(t, partSet.GetPart(i), partSet2.GetPart(i))
+		}
+		data2, err := io.ReadAll(partSet2.GetReader())
+		require.NoError(t, err)
+		assert.Equal(t, data, data2)
+
+		// the missing parts are no longer needed
+		added, err := partSet2.AddPart(partSet.GetPart(missing[0]))
+		assert.False(t, added)
+		assert.NoError(t, err)
+	}
+
+	// a parity of zero makes a plain part set
+	plain, err := NewErasureCodedPartSetFromData(data, partSize, 0)
+	require.NoError(t, err)
+	assert.Equal(t, NewPartSetFromData(data, partSize).Header(), plain.Header())
+
+	_, err = NewErasureCodedPartSetFromData(data, partSize+1, 4)
+	assert.Error(t, err)
+	_, err = NewErasureCodedPartSetFromData(data, partSize, 12)
+	assert.Error(t, err)
+}
+
+func TestErasureCodedPartSetInvalidCoding(t *testing.T) {
+	const partSize = 4096
+
+	// parity parts that are not the encoding of the data parts
+	partsBytes := make([][]byte, 6)
+	for i := range partsBytes {
+		partsBytes[i] = tmrand.Bytes(partSize)
+	}
+	partSet := newFullPartSet(partsBytes, 2, partSize*4)
+
+	partSet2 := NewPartSetFromHeader(partSet.Header())
+	for i := 0; i < 3; i++ {
+		added, err := partSet2.AddPart(partSet.GetPart(i))
+		require.NoError(t, err)
+		require.True(t, added)
+	}
+	added, err := partSet2.AddPart(partSet.GetPart(5))
+	assert.False(t, added)
+	assert.ErrorIs(t, err, ErrPartSetInvalidCoding)
+	assert.False(t, partSet2.IsComplete())
+
+	// the part set can no longer be completed
+	added, err = partSet2.AddPart(partSet.GetPart(4))
+	assert.False(t, added)
+	assert.ErrorIs(t, err, ErrPartSetInvalidCoding)
+}
+
+func contains(s []int, i int) bool {
+	for _, j := range s {
+		if i == j {
+			return true
+		}
+	}
+	return false
+}
+
 func TestPartSetHeaderValidateBasic(t *testing.T) {
 	testCases := []struct {
 		testName              string
@@ -96,6 +189,8 @@ func TestPartSetHeaderValidateBasic(t *testing.T) {
 	}{
 		{"Good PartSet", func(psHeader *PartSetHeader) {}, false},
 		{"Invalid Hash", func(psHeader *PartSetHeader) { psHeader.Hash = make([]byte, 1) }, true},
+		{"Half parity", func(psHeader *PartSetHeader) { psHeader.Parity = psHeader.Total / 2 }, false},
+		{"Too much parity", func(psHeader *PartSetHeader) { psHeader.Parity = psHeader.Total/2 + 1 }, true},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -147,6 +242,8 @@ func TestParSetHeaderProtoBuf(t *testing.T) {
 		{"success empty", &PartSetHeader{}, true},
 		{"success",
 			&PartSetHeader{Total: 1, Hash: []byte("hash")}, true},
+		{"success with parity",
+			&PartSetHeader{Total: 3, Hash: []byte("hash"), Parity: 1}, true},
 	}
 
 	for _, tc := range testCases {
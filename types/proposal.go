@@ -34,14 +34,15 @@ type Proposal struct {
 
 // NewProposal returns a new Proposal.
 // If there is no POLRound, polRound should be -1.
-func NewProposal(height int64, round int32, polRound int32, blockID BlockID) *Proposal {
+// The timestamp must be the time of the proposed block.
+func NewProposal(height int64, round int32, polRound int32, blockID BlockID, ts time.Time) *Proposal {
 	return &Proposal{
 		Type:      tmproto.ProposalType,
 		Height:    height,
 		Round:     round,
 		BlockID:   blockID,
 		POLRound:  polRound,
-		Timestamp: tmtime.Now(),
+		Timestamp: tmtime.Canonical(ts),
 	}
 }
 
@@ -79,6 +80,32 @@ func (p *Proposal) ValidateBasic() error {
 	return nil
 }
 
+// IsTimely validates that the proposal timestamp is 'timely' according to the
+// proposer-based timestamp algorithm. To evaluate if a proposal is timely, its
+// timestamp is compared to the local time of the validator when it receives
+// the proposal along with the configured Precision and MessageDelay
+// parameters. Specifically, a proposal timestamp is considered timely if it
+// satisfies the following inequalities:
+//
+// localtime >= proposedBlockTime - Precision
+// localtime <= proposedBlockTime + MessageDelay + Precision
+//
+// The MessageDelay is adjusted for the round of the proposal, see
+// SynchronyParams.InRound.
+func (p *Proposal) IsTimely(recvTime time.Time, sp SynchronyParams, round int32) bool {
+	sp = sp.InRound(round)
+
+	// lhs is `proposedBlockTime - Precision` in the first inequality
+	lhs := p.Timestamp.Add(-sp.Precision)
+	// rhs is `proposedBlockTime + MessageDelay + Precision` in the second inequality
+	rhs := p.Timestamp.Add(sp.MessageDelay).Add(sp.Precision)
+
+	if recvTime.Before(lhs) || recvTime.After(rhs) {
+		return false
+	}
+	return true
+}
+
 // String returns a string representation of the Proposal.
 //
 // 1. height
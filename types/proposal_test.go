@@ -13,6 +13,7 @@ import (
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/internal/libs/protoio"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmtime "github.com/tendermint/tendermint/libs/time"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
@@ -60,7 +61,8 @@ func TestProposalVerifySignature(t *testing.T) {
 
 	prop := NewProposal(
 		4, 2, 2,
-		BlockID{tmrand.Bytes(tmhash.Size), PartSetHeader{777, tmrand.Bytes(tmhash.Size)}})
+		BlockID{tmrand.Bytes(tmhash.Size), PartSetHeader{Total: 777, Hash: tmrand.Bytes(tmhash.Size)}},
+		tmtime.Now())
 	p := prop.ToProto()
 	signBytes := ProposalSignBytes("test_chain_id", p)
 
@@ -153,7 +155,7 @@ func TestProposalValidateBasic(t *testing.T) {
 		{"Invalid Round", func(p *Proposal) { p.Round = -1 }, true},
 		{"Invalid POLRound", func(p *Proposal) { p.POLRound = -2 }, true},
 		{"Invalid BlockId", func(p *Proposal) {
-			p.BlockID = BlockID{[]byte{1, 2, 3}, PartSetHeader{111, []byte("blockparts")}}
+			p.BlockID = BlockID{[]byte{1, 2, 3}, PartSetHeader{Total: 111, Hash: []byte("blockparts")}}
 		}, true},
 		{"Invalid Signature", func(p *Proposal) {
 			p.Signature = make([]byte, 0)
@@ -172,7 +174,7 @@ func TestProposalValidateBasic(t *testing.T) {
 
 			prop := NewProposal(
 				4, 2, 2,
-				blockID)
+				blockID, tmtime.Now())
 			p := prop.ToProto()
 			err := privVal.SignProposal(ctx, "test_chain_id", p)
 			prop.Signature = p.Signature
@@ -184,9 +186,9 @@ func TestProposalValidateBasic(t *testing.T) {
 }
 
 func TestProposalProtoBuf(t *testing.T) {
-	proposal := NewProposal(1, 2, 3, makeBlockID([]byte("hash"), 2, []byte("part_set_hash")))
+	proposal := NewProposal(1, 2, 3, makeBlockID([]byte("hash"), 2, []byte("part_set_hash")), tmtime.Now())
 	proposal.Signature = []byte("sig")
-	proposal2 := NewProposal(1, 2, 3, BlockID{})
+	proposal2 := NewProposal(1, 2, 3, BlockID{}, tmtime.Now())
 
 	testCases := []struct {
 		msg     string
@@ -210,3 +212,35 @@ func TestProposalProtoBuf(t *testing.T) {
 		}
 	}
 }
+
+func TestIsTimely(t *testing.T) {
+	genesisTime, err := time.Parse(time.RFC3339, "2019-03-13T23:00:00Z")
+	require.NoError(t, err)
+	sp := SynchronyParams{
+		Precision:    500 * time.Millisecond,
+		MessageDelay: 2 * time.Second,
+	}
+
+	testCases := []struct {
+		name         string
+		proposalTime time.Time
+		recvTime     time.Time
+		round        int32
+		expectTimely bool
+	}{
+		{"received at proposal time", genesisTime, genesisTime, 0, true},
+		{"received within precision before", genesisTime, genesisTime.Add(-400 * time.Millisecond), 0, true},
+		{"received too early", genesisTime, genesisTime.Add(-600 * time.Millisecond), 0, false},
+		{"received within message delay", genesisTime, genesisTime.Add(2400 * time.Millisecond), 0, true},
+		{"received too late", genesisTime, genesisTime.Add(2600 * time.Millisecond), 0, false},
+		{"received late in a later round", genesisTime, genesisTime.Add(2600 * time.Millisecond), 1, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			p := NewProposal(1, 0, -1, BlockID{}, tc.proposalTime)
+			assert.Equal(t, tc.expectTimely, p.IsTimely(tc.recvTime, sp, tc.round))
+		})
+	}
+}
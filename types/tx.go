@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/tendermint/tendermint/crypto/merkle"
 	"github.com/tendermint/tendermint/crypto/tmhash"
@@ -41,6 +42,24 @@ func (txs Txs) Hash() []byte {
 	return merkle.HashFromByteSlices(txBzs)
 }
 
+// ToSliceOfBytes converts a Txs to slice of byte slices.
+func (txs Txs) ToSliceOfBytes() [][]byte {
+	txBzs := make([][]byte, len(txs))
+	for i := 0; i < len(txs); i++ {
+		txBzs[i] = txs[i]
+	}
+	return txBzs
+}
+
+// ToTxs converts a slice of byte slices to Txs.
+func ToTxs(txl [][]byte) Txs {
+	txs := make([]Tx, 0, len(txl))
+	for _, tx := range txl {
+		txs = append(txs, tx)
+	}
+	return txs
+}
+
 // Index returns the index of this transaction in the list, or -1 if not found
 func (txs Txs) Index(tx Tx) int {
 	for i := range txs {
@@ -138,6 +157,77 @@ func TxProofFromProto(pb tmproto.TxProof) (TxProof, error) {
 	return pbtp, nil
 }
 
+// MultiProof returns a merkle proof of the transactions at the given
+// indices, which may be given in any order and may repeat. The transactions
+// of the proof are in increasing order of their indices.
+func (txs Txs) MultiProof(indices []int) (TxMultiProof, error) {
+	bzs := make([][]byte, len(txs))
+	for i := 0; i < len(txs); i++ {
+		bzs[i] = txs[i].Hash()
+	}
+	idxs := make([]int64, len(indices))
+	for i, index := range indices {
+		idxs[i] = int64(index)
+	}
+	root, proof, err := merkle.MultiProofFromByteSlices(bzs, idxs)
+	if err != nil {
+		return TxMultiProof{}, err
+	}
+
+	data := make(Txs, len(proof.Indices))
+	for i, index := range proof.Indices {
+		data[i] = txs[index]
+	}
+	return TxMultiProof{
+		RootHash: root,
+		Data:     data,
+		Proof:    *proof,
+	}, nil
+}
+
+// TxMultiProof represents a Merkle proof of the presence of several
+// transactions in the Merkle tree.
+type TxMultiProof struct {
+	RootHash tmbytes.HexBytes  `json:"root_hash"`
+	Data     Txs               `json:"data"`
+	Proof    merkle.MultiProof `json:"proof"`
+}
+
+// Leaves returns the hash(tx) of every transaction, which are the leaves in
+// the merkle tree which this proof refers to.
+func (tp TxMultiProof) Leaves() [][]byte {
+	leaves := make([][]byte, len(tp.Data))
+	for i, tx := range tp.Data {
+		leaves[i] = tx.Hash()
+	}
+	return leaves
+}
+
+// Validate verifies the proof. It returns nil if the RootHash matches the dataHash argument,
+// and if the proof is internally consistent. Otherwise, it returns a sensible error.
+func (tp TxMultiProof) Validate(dataHash []byte) error {
+	if !bytes.Equal(dataHash, tp.RootHash) {
+		return errors.New("proof matches different data hash")
+	}
+	if err := tp.Proof.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid proof: %w", err)
+	}
+	if err := tp.Proof.Verify(tp.RootHash, tp.Leaves()); err != nil {
+		return errors.New("proof is not internally consistent")
+	}
+	return nil
+}
+
+// IndexOf returns the position in Data of the transaction at the given index
+// of the block, or -1 if the proof doesn't include it.
+func (tp TxMultiProof) IndexOf(index int64) int {
+	i := sort.Search(len(tp.Proof.Indices), func(i int) bool { return tp.Proof.Indices[i] >= index })
+	if i < len(tp.Proof.Indices) && i < len(tp.Data) && tp.Proof.Indices[i] == index {
+		return i
+	}
+	return -1
+}
+
 // ComputeProtoSizeForTxs wraps the transactions in tmproto.Data{} and calculates the size.
 // https://developers.google.com/protocol-buffers/docs/encoding
 func ComputeProtoSizeForTxs(txs []Tx) int64 {
@@ -99,6 +99,32 @@ func TestValidTxProof(t *testing.T) {
 	}
 }
 
+func TestValidTxMultiProof(t *testing.T) {
+	txs := makeTxs(61, 15)
+	root := txs.Hash()
+
+	proof, err := txs.MultiProof([]int{42, 3, 17, 3})
+	require.NoError(t, err)
+	assert.EqualValues(t, root, proof.RootHash)
+	assert.Equal(t, []int64{3, 17, 42}, proof.Proof.Indices)
+	assert.Equal(t, Txs{txs[3], txs[17], txs[42]}, proof.Data)
+	assert.NoError(t, proof.Validate(root))
+	assert.Error(t, proof.Validate([]byte("foobar")))
+
+	assert.Equal(t, 1, proof.IndexOf(17))
+	assert.Equal(t, -1, proof.IndexOf(18))
+
+	// the data must match the proven transactions
+	bad := proof
+	bad.Data = Txs{txs[3], txs[18], txs[42]}
+	assert.Error(t, bad.Validate(root))
+	bad.Data = proof.Data[:2]
+	assert.Error(t, bad.Validate(root))
+
+	_, err = txs.MultiProof([]int{61})
+	assert.Error(t, err)
+}
+
 func TestTxProofUnchangable(t *testing.T) {
 	// run the other test a bunch...
 	for i := 0; i < 40; i++ {
@@ -1,25 +1,38 @@
 package types
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 
+	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/batch"
+	"github.com/tendermint/tendermint/crypto/bls12381"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmmath "github.com/tendermint/tendermint/libs/math"
 )
 
 const batchVerifyThreshold = 2
 
+// shouldBatchVerify returns true if the commit has enough signatures and any
+// of the validators has a key type that supports batch verification.
 func shouldBatchVerify(vals *ValidatorSet, commit *Commit) bool {
-	return len(commit.Signatures) >= batchVerifyThreshold && batch.SupportsBatchVerifier(vals.GetProposer().PubKey)
+	if len(commit.Signatures) < batchVerifyThreshold {
+		return false
+	}
+	for _, val := range vals.Validators {
+		if batch.SupportsBatchVerifier(val.PubKey) {
+			return true
+		}
+	}
+	return false
 }
 
 // VerifyCommit verifies +2/3 of the set had signed the given commit.
 //
 // It checks all the signatures! While it's safe to exit as soon as we have
 // 2/3+ signatures, doing so would impact incentivization logic in the ABCI
-// application that depends on the LastCommitInfo sent in BeginBlock, which
+// application that depends on the LastCommitInfo sent in FinalizeBlock, which
 // includes which validators signed. For instance, Gaia incentivizes proposers
 // with a bonus for including more than +2/3 of the signatures.
 func VerifyCommit(chainID string, vals *ValidatorSet, blockID BlockID,
@@ -39,6 +52,12 @@ func VerifyCommit(chainID string, vals *ValidatorSet, blockID BlockID,
 	// only count the signatures that are for the block
 	count := func(c CommitSig) bool { return c.ForBlock() }
 
+	// an aggregated signature is verified all at once
+	if commit.IsAggregated() {
+		return verifyCommitAggregated(chainID, vals, vals, commit,
+			votingPowerNeeded, ignore, count, true)
+	}
+
 	// attempt to batch verify
 	if shouldBatchVerify(vals, commit) {
 		return verifyCommitBatch(chainID, vals, commit,
@@ -72,6 +91,12 @@ func VerifyCommitLight(chainID string, vals *ValidatorSet, blockID BlockID,
 	// count all the remaining signatures
 	count := func(c CommitSig) bool { return true }
 
+	// an aggregated signature is verified all at once
+	if commit.IsAggregated() {
+		return verifyCommitAggregated(chainID, vals, vals, commit,
+			votingPowerNeeded, ignore, count, true)
+	}
+
 	// attempt to batch verify
 	if shouldBatchVerify(vals, commit) {
 		return verifyCommitBatch(chainID, vals, commit,
@@ -91,25 +116,19 @@ func VerifyCommitLight(chainID string, vals *ValidatorSet, blockID BlockID,
 //
 // This method is primarily used by the light client and does not check all the
 // signatures.
+//
+// An aggregated commit can't be verified without the keys of all its signers,
+// which the given validators may lack: use VerifyAggregatedCommitLightTrusting
+// instead.
 func VerifyCommitLightTrusting(chainID string, vals *ValidatorSet, commit *Commit, trustLevel tmmath.Fraction) error {
-	// sanity checks
-	if vals == nil {
-		return errors.New("nil validator set")
-	}
-	if trustLevel.Denominator == 0 {
-		return errors.New("trustLevel has zero Denominator")
+	votingPowerNeeded, err := verifyBasicTrusting(vals, commit, trustLevel)
+	if err != nil {
+		return err
 	}
-	if commit == nil {
-		return errors.New("nil commit")
+	if commit.IsAggregated() {
+		return errors.New("aggregated commit must be verified against the validator set that signed it")
 	}
 
-	// safely calculate voting power needed.
-	totalVotingPowerMulByNumerator, overflow := safeMul(vals.TotalVotingPower(), int64(trustLevel.Numerator))
-	if overflow {
-		return errors.New("int64 overflow while calculating voting power needed. please provide smaller trustLevel numerator")
-	}
-	votingPowerNeeded := totalVotingPowerMulByNumerator / int64(trustLevel.Denominator)
-
 	// ignore all commit signatures that are not for the block
 	ignore := func(c CommitSig) bool { return !c.ForBlock() }
 
@@ -129,6 +148,38 @@ func VerifyCommitLightTrusting(chainID string, vals *ValidatorSet, commit *Commi
 		ignore, count, false, false)
 }
 
+// VerifyAggregatedCommitLightTrusting verifies that trustLevel of the
+// validator set signed this commit, like VerifyCommitLightTrusting, given
+// signedVals, the validator set that signed it. Commits that aren't
+// aggregated are verified with VerifyCommitLightTrusting.
+//
+// NOTE signedVals must be trusted to be the validator set of the commit's
+// block, e.g. by checking the ValidatorsHash of its header.
+func VerifyAggregatedCommitLightTrusting(chainID string, vals, signedVals *ValidatorSet,
+	commit *Commit, trustLevel tmmath.Fraction) error {
+	if !commit.IsAggregated() {
+		return VerifyCommitLightTrusting(chainID, vals, commit, trustLevel)
+	}
+
+	votingPowerNeeded, err := verifyBasicTrusting(vals, commit, trustLevel)
+	if err != nil {
+		return err
+	}
+	if signedVals == nil {
+		return errors.New("nil signed validator set")
+	}
+
+	// ignore all commit signatures that are not for the block
+	ignore := func(c CommitSig) bool { return !c.ForBlock() }
+
+	// count all the remaining signatures
+	count := func(c CommitSig) bool { return true }
+
+	// the signers are looked up by address in the trusted validator set
+	return verifyCommitAggregated(chainID, vals, signedVals, commit,
+		votingPowerNeeded, ignore, count, false)
+}
+
 // ValidateHash returns an error if the hash is not empty, but its
 // size != tmhash.Size.
 func ValidateHash(h []byte) error {
@@ -147,6 +198,10 @@ func ValidateHash(h []byte) error {
 // to verifyCommitSingle in behavior, just faster iff every signature in the
 // batch is valid.
 //
+// Signatures are batched per key type, so that validator sets mixing key
+// types are batch verified too. Signatures of keys that don't support batch
+// verification are verified one by one.
+//
 // Note: The caller is responsible for checking to see if this routine is
 // usable via `shouldVerifyBatch(vals, commit)`.
 func verifyCommitBatch(
@@ -159,20 +214,19 @@ func verifyCommitBatch(
 	countAllSignatures bool,
 	lookUpByIndex bool,
 ) error {
+	type sigBatch struct {
+		bv     crypto.BatchVerifier
+		sigIdx []int
+	}
+
 	var (
 		val                *Validator
 		valIdx             int32
 		talliedVotingPower int64
 		seenVals           = make(map[int32]int, len(commit.Signatures))
-		batchSigIdxs       = make([]int, 0, len(commit.Signatures))
+		batches            []*sigBatch
+		batchesByKeyType   = make(map[string]*sigBatch)
 	)
-	// attempt to create a batch verifier
-	bv, ok := batch.CreateBatchVerifier(vals.GetProposer().PubKey)
-	// re-check if batch verification is supported
-	if !ok || len(commit.Signatures) < batchVerifyThreshold {
-		// This should *NEVER* happen.
-		return fmt.Errorf("unsupported signature algorithm or insufficient signatures for batch verification")
-	}
 
 	for idx, commitSig := range commit.Signatures {
 		// skip over signatures that should be ignored
@@ -205,11 +259,26 @@ func verifyCommitBatch(
 		// Validate signature.
 		voteSignBytes := commit.VoteSignBytes(chainID, int32(idx))
 
-		// add the key, sig and message to the verifier
-		if err := bv.Add(val.PubKey, voteSignBytes, commitSig.Signature); err != nil {
-			return err
+		// find or create the batch verifier of the key type
+		b, ok := batchesByKeyType[val.PubKey.Type()]
+		if !ok {
+			if bv, ok := batch.CreateBatchVerifier(val.PubKey); ok {
+				b = &sigBatch{bv: bv}
+				batches = append(batches, b)
+			}
+			batchesByKeyType[val.PubKey.Type()] = b
+		}
+
+		if b != nil {
+			// add the key, sig and message to the verifier
+			if err := b.bv.Add(val.PubKey, voteSignBytes, commitSig.Signature); err != nil {
+				return err
+			}
+			b.sigIdx = append(b.sigIdx, idx)
+		} else if !val.PubKey.VerifySignature(voteSignBytes, commitSig.Signature) {
+			// the key type does not support batch verification
+			return fmt.Errorf("wrong signature (#%d): %X", idx, commitSig.Signature)
 		}
-		batchSigIdxs = append(batchSigIdxs, idx)
 
 		// If this signature counts then add the voting power of the validator
 		// to the tally
@@ -230,29 +299,142 @@ func verifyCommitBatch(
 		return ErrNotEnoughVotingPowerSigned{Got: got, Needed: needed}
 	}
 
-	// attempt to verify the batch.
-	ok, validSigs := bv.Verify()
-	if ok {
-		// success
-		return nil
+	// attempt to verify the batches, finding the first invalid signature of
+	// the commit if any of them fails.
+	invalidIdx := -1
+	for _, b := range batches {
+		ok, validSigs := b.bv.Verify()
+		if ok {
+			continue
+		}
+
+		found := false
+		for i, ok := range validSigs {
+			if !ok {
+				// go back from the batch index to the commit.Signatures index
+				if idx := b.sigIdx[i]; invalidIdx == -1 || idx < invalidIdx {
+					invalidIdx = idx
+				}
+				found = true
+				break
+			}
+		}
+
+		// execution reaching here is a bug, and one of the following has
+		// happened:
+		//  * non-zero tallied voting power, empty batch (impossible?)
+		//  * bv.Verify() returned `false, []bool{true, ..., true}` (BUG)
+		if !found {
+			return fmt.Errorf("BUG: batch verification failed with no invalid signatures")
+		}
+	}
+
+	if invalidIdx != -1 {
+		sig := commit.Signatures[invalidIdx]
+		return fmt.Errorf("wrong signature (#%d): %X", invalidIdx, sig)
 	}
 
-	// one or more of the signatures is invalid, find and return the first
-	// invalid signature.
-	for i, ok := range validSigs {
-		if !ok {
-			// go back from the batch index to the commit.Signatures index
-			idx := batchSigIdxs[i]
-			sig := commit.Signatures[idx]
-			return fmt.Errorf("wrong signature (#%d): %X", idx, sig)
+	// success
+	return nil
+}
+
+// Aggregated Verification
+
+// verifyCommitAggregated verifies the aggregated signature of a commit
+// against the keys of signedVals, the validator set that signed it, and
+// tallies the voting power of the signers in vals. Unlike the other routines,
+// every signature is verified, as they are all verified at once. The key of
+// every signer must match its address, and the key vals has for it, so that
+// signedVals can't claim the voting power of vals with other keys.
+//
+// CONTRACT: the commit must be aggregated
+func verifyCommitAggregated(
+	chainID string,
+	vals *ValidatorSet,
+	signedVals *ValidatorSet,
+	commit *Commit,
+	votingPowerNeeded int64,
+	ignoreSig func(CommitSig) bool,
+	countSig func(CommitSig) bool,
+	lookUpByIndex bool,
+) error {
+	var (
+		val                *Validator
+		valIdx             int32
+		talliedVotingPower int64
+		seenVals           = make(map[int32]int, len(commit.Signatures))
+		pubKeys            = make([]crypto.PubKey, 0, len(commit.Signatures))
+		msgs               = make([][]byte, 0, len(commit.Signatures))
+	)
+	if signedVals.Size() != len(commit.Signatures) {
+		return NewErrInvalidCommitSignatures(signedVals.Size(), len(commit.Signatures))
+	}
+	if err := commit.validateAggregated(); err != nil {
+		return err
+	}
+
+	for idx, commitSig := range commit.Signatures {
+		// the aggregated signature covers every vote that isn't absent,
+		// including those that are ignored in the tally
+		if commitSig.Absent() {
+			continue
+		}
+		signer := signedVals.Validators[idx]
+		if !bytes.Equal(signer.Address, commitSig.ValidatorAddress) {
+			return fmt.Errorf("wrong validator address (#%d): expected %v, got %v",
+				idx, signer.Address, commitSig.ValidatorAddress)
 		}
+		if !bytes.Equal(signer.PubKey.Address(), commitSig.ValidatorAddress) {
+			return fmt.Errorf("wrong validator key (#%d): %v doesn't match address %v",
+				idx, signer.PubKey, commitSig.ValidatorAddress)
+		}
+		pubKeys = append(pubKeys, signer.PubKey)
+		msgs = append(msgs, commit.VoteSignBytes(chainID, int32(idx)))
+
+		if ignoreSig(commitSig) || !countSig(commitSig) {
+			continue
+		}
+
+		// If the vals and commit have a 1-to-1 correspondance we can retrieve
+		// them by index else we need to retrieve them by address
+		if lookUpByIndex {
+			val = vals.Validators[idx]
+		} else {
+			valIdx, val = vals.GetByAddress(commitSig.ValidatorAddress)
+
+			// if the signature doesn't belong to anyone in the validator set
+			// then we just skip over it
+			if val == nil {
+				continue
+			}
+
+			// because we are getting validators by address we need to make sure
+			// that the same validator doesn't commit twice
+			if firstIndex, ok := seenVals[valIdx]; ok {
+				secondIndex := idx
+				return fmt.Errorf("double vote from %v (%d and %d)", val, firstIndex, secondIndex)
+			}
+			seenVals[valIdx] = idx
+
+			// the voting power of val is only counted for a signature made
+			// with its key
+			if !val.PubKey.Equals(signer.PubKey) {
+				return fmt.Errorf("wrong validator key (#%d): expected %v, got %v",
+					idx, val.PubKey, signer.PubKey)
+			}
+		}
+		talliedVotingPower += val.VotingPower
+	}
+
+	// there is no need to verify the signature without enough voting power
+	if got, needed := talliedVotingPower, votingPowerNeeded; got <= needed {
+		return ErrNotEnoughVotingPowerSigned{Got: got, Needed: needed}
 	}
 
-	// execution reaching here is a bug, and one of the following has
-	// happened:
-	//  * non-zero tallied voting power, empty batch (impossible?)
-	//  * bv.Verify() returned `false, []bool{true, ..., true}` (BUG)
-	return fmt.Errorf("BUG: batch verification failed with no invalid signatures")
+	if !bls12381.VerifyAggregateSignature(pubKeys, msgs, commit.AggregatedSignature) {
+		return fmt.Errorf("wrong aggregated signature: %X", commit.AggregatedSignature)
+	}
+	return nil
 }
 
 // Single Verification
@@ -331,6 +513,28 @@ func verifyCommitSingle(
 	return nil
 }
 
+// verifyBasicTrusting checks the arguments of the light client verification
+// with a trust level and returns the voting power needed.
+func verifyBasicTrusting(vals *ValidatorSet, commit *Commit, trustLevel tmmath.Fraction) (int64, error) {
+	// sanity checks
+	if vals == nil {
+		return 0, errors.New("nil validator set")
+	}
+	if trustLevel.Denominator == 0 {
+		return 0, errors.New("trustLevel has zero Denominator")
+	}
+	if commit == nil {
+		return 0, errors.New("nil commit")
+	}
+
+	// safely calculate voting power needed.
+	totalVotingPowerMulByNumerator, overflow := safeMul(vals.TotalVotingPower(), int64(trustLevel.Numerator))
+	if overflow {
+		return 0, errors.New("int64 overflow while calculating voting power needed. please provide smaller trustLevel numerator")
+	}
+	return totalVotingPowerMulByNumerator / int64(trustLevel.Denominator), nil
+}
+
 func verifyBasicValsAndCommit(vals *ValidatorSet, commit *Commit, height int64, blockID BlockID) error {
 	if vals == nil {
 		return errors.New("nil validator set")
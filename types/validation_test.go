@@ -2,12 +2,19 @@ package types
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/bls12381"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/sr25519"
 	tmmath "github.com/tendermint/tendermint/libs/math"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
@@ -281,3 +288,236 @@ func TestValidatorSet_VerifyCommitLightTrustingErrorsOnOverflow(t *testing.T) {
 		assert.Contains(t, err.Error(), "int64 overflow")
 	}
 }
+
+func TestValidatorSet_VerifyAggregatedCommit(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		chainID = "test_chain_id"
+		blockID = makeBlockIDRandom()
+		height  = int64(1)
+	)
+	valSet, vals := randBlsValidatorPrivValSet(ctx, t, 4, 10)
+	voteSet := NewVoteSet(chainID, height, 0, tmproto.PrecommitType, valSet)
+	// the last validator votes for nil
+	commit, err := makeCommit(ctx, blockID, height, 0, voteSet, vals[:3], time.Now())
+	require.NoError(t, err)
+	nilVote := &Vote{
+		ValidatorAddress: valSet.Validators[3].Address,
+		ValidatorIndex:   3,
+		Height:           height,
+		Type:             tmproto.PrecommitType,
+		Timestamp:        time.Now(),
+	}
+	_, err = signAddVote(ctx, vals[3], nilVote, voteSet)
+	require.NoError(t, err)
+	commit = voteSet.MakeCommit()
+
+	aggCommit, err := AggregateCommit(commit, valSet)
+	require.NoError(t, err)
+	require.True(t, aggCommit.IsAggregated())
+	require.NoError(t, aggCommit.ValidateBasic())
+	assert.Len(t, aggCommit.AggregatedSignature, bls12381.SignatureSize)
+	assert.True(t, aggCommit.AggregatedSigners.IsFull())
+	for _, commitSig := range aggCommit.Signatures {
+		assert.Empty(t, commitSig.Signature)
+	}
+	// the original commit is left untouched
+	assert.False(t, commit.IsAggregated())
+	assert.NotEqual(t, commit.Hash(), aggCommit.Hash())
+
+	assert.NoError(t, valSet.VerifyCommit(chainID, blockID, height, aggCommit))
+	assert.NoError(t, valSet.VerifyCommitLight(chainID, blockID, height, aggCommit))
+
+	// the signature covers the votes for nil too
+	tampered := *aggCommit
+	tampered.Signatures = append([]CommitSig{}, aggCommit.Signatures...)
+	tampered.Signatures[3].Timestamp = tampered.Signatures[3].Timestamp.Add(time.Second)
+	assert.Error(t, valSet.VerifyCommit(chainID, blockID, height, &tampered))
+	assert.Error(t, valSet.VerifyCommitLight(chainID, blockID, height, &tampered))
+
+	tampered = *aggCommit
+	tampered.AggregatedSignature = commit.Signatures[0].Signature
+	assert.Error(t, valSet.VerifyCommit(chainID, blockID, height, &tampered))
+
+	// not enough voting power for the block
+	tampered = *aggCommit
+	tampered.Signatures = append([]CommitSig{}, aggCommit.Signatures...)
+	tampered.Signatures[2] = NewCommitSigAbsent()
+	assert.Error(t, tampered.ValidateBasic())
+	tampered.AggregatedSigners = tampered.AggregatedSigners.Copy()
+	tampered.AggregatedSigners.SetIndex(2, false)
+	assert.NoError(t, tampered.ValidateBasic())
+	err = valSet.VerifyCommit(chainID, blockID, height, &tampered)
+	assert.IsType(t, ErrNotEnoughVotingPowerSigned{}, err)
+
+	// commits of other validators aren't aggregated
+	edVoteSet, edValSet, edVals := randVoteSet(ctx, t, height, 0, tmproto.PrecommitType, 4, 10)
+	edCommit, err := makeCommit(ctx, blockID, height, 0, edVoteSet, edVals, time.Now())
+	require.NoError(t, err)
+	notAggCommit, err := AggregateCommit(edCommit, edValSet)
+	require.NoError(t, err)
+	assert.Equal(t, edCommit, notAggCommit)
+}
+
+func TestValidatorSet_VerifyAggregatedCommitLightTrusting(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		chainID    = "test_chain_id"
+		blockID    = makeBlockIDRandom()
+		trustLevel = tmmath.Fraction{Numerator: 1, Denominator: 3}
+	)
+	valSet, vals := randBlsValidatorPrivValSet(ctx, t, 6, 1)
+	voteSet := NewVoteSet(chainID, 1, 1, tmproto.PrecommitType, valSet)
+	signedCommit, err := makeCommit(ctx, blockID, 1, 1, voteSet, vals, time.Now())
+	require.NoError(t, err)
+	commit, err := AggregateCommit(signedCommit, valSet)
+	require.NoError(t, err)
+	newValSet, _ := randBlsValidatorPrivValSet(ctx, t, 2, 1)
+
+	testCases := []struct {
+		valSet *ValidatorSet
+		err    bool
+	}{
+		// good
+		0: {
+			valSet: valSet,
+			err:    false,
+		},
+		// bad - no overlap between validator sets
+		1: {
+			valSet: newValSet,
+			err:    true,
+		},
+		// good - first two are different but the rest of the same -> >1/3
+		2: {
+			valSet: NewValidatorSet(append(newValSet.Validators, valSet.Validators...)),
+			err:    false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err = tc.valSet.VerifyAggregatedCommitLightTrusting(chainID, valSet, commit, trustLevel)
+		if tc.err {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+
+		// the keys of the signers are needed
+		assert.Error(t, tc.valSet.VerifyCommitLightTrusting(chainID, commit, trustLevel))
+	}
+
+	// the signers must match the validator set that signed the commit
+	assert.Error(t, valSet.VerifyAggregatedCommitLightTrusting(chainID,
+		NewValidatorSet(append(newValSet.Validators, valSet.Validators[2:]...)), commit, trustLevel))
+
+	// a forged validator set, pairing the addresses of the trusted validators
+	// with other keys, can't sign for them
+	forgedVals := make([]*Validator, valSet.Size())
+	forgedSigs := make([]CommitSig, valSet.Size())
+	for i, val := range valSet.Validators {
+		privKey := bls12381.GenPrivKey()
+		forgedVals[i] = &Validator{
+			Address:     val.Address,
+			PubKey:      privKey.PubKey(),
+			VotingPower: val.VotingPower,
+		}
+
+		forgedSigs[i] = signedCommit.Signatures[i]
+		forgedSigs[i].Signature, err = privKey.Sign(signedCommit.VoteSignBytes(chainID, int32(i)))
+		require.NoError(t, err)
+	}
+	forgedValSet := &ValidatorSet{Validators: forgedVals}
+	forgedCommit, err := AggregateCommit(
+		NewCommit(signedCommit.Height, signedCommit.Round, signedCommit.BlockID, forgedSigs), forgedValSet)
+	require.NoError(t, err)
+	require.True(t, forgedCommit.IsAggregated())
+
+	err = valSet.VerifyAggregatedCommitLightTrusting(chainID, forgedValSet, forgedCommit, trustLevel)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "wrong validator key")
+	}
+}
+
+func TestValidatorSet_VerifyCommit_MixedKeyTypes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		chainID    = "test_chain_id"
+		blockID    = makeBlockIDRandom()
+		height     = int64(1)
+		trustLevel = tmmath.Fraction{Numerator: 1, Denominator: 3}
+	)
+	// the signatures of each key type are batched together, except for
+	// bls12381 ones, which are verified one by one when not aggregated
+	valSet, vals := privValSetWithKeys(ctx, t, []crypto.PrivKey{
+		ed25519.GenPrivKey(), ed25519.GenPrivKey(),
+		secp256k1.GenPrivKey(), secp256k1.GenPrivKey(),
+		sr25519.GenPrivKey(), bls12381.GenPrivKey(),
+	}, 10)
+	require.True(t, shouldBatchVerify(valSet, &Commit{Signatures: make([]CommitSig, valSet.Size())}))
+	voteSet := NewVoteSet(chainID, height, 0, tmproto.PrecommitType, valSet)
+	commit, err := makeCommit(ctx, blockID, height, 0, voteSet, vals, time.Now())
+	require.NoError(t, err)
+
+	assert.NoError(t, valSet.VerifyCommit(chainID, blockID, height, commit))
+	assert.NoError(t, valSet.VerifyCommitLight(chainID, blockID, height, commit))
+	assert.NoError(t, valSet.VerifyCommitLightTrusting(chainID, commit, trustLevel))
+
+	// an invalid signature of any key type is found
+	for idx, val := range valSet.Validators {
+		tampered := *commit
+		tampered.Signatures = append([]CommitSig{}, commit.Signatures...)
+		tampered.Signatures[idx].Timestamp = tampered.Signatures[idx].Timestamp.Add(time.Second)
+
+		err := valSet.VerifyCommit(chainID, blockID, height, &tampered)
+		if assert.Error(t, err, val.PubKey.Type()) {
+			assert.Contains(t, err.Error(), fmt.Sprintf("wrong signature (#%d)", idx))
+		}
+	}
+}
+
+func randBlsValidatorPrivValSet(
+	ctx context.Context,
+	t *testing.T,
+	numValidators int,
+	votingPower int64,
+) (*ValidatorSet, []PrivValidator) {
+	privKeys := make([]crypto.PrivKey, numValidators)
+	for i := range privKeys {
+		privKeys[i] = bls12381.GenPrivKey()
+	}
+	return privValSetWithKeys(ctx, t, privKeys, votingPower)
+}
+
+// privValSetWithKeys returns a validator set, and its sorted private
+// validators, of the given private keys.
+func privValSetWithKeys(
+	ctx context.Context,
+	t *testing.T,
+	privKeys []crypto.PrivKey,
+	votingPower int64,
+) (*ValidatorSet, []PrivValidator) {
+	var (
+		valz           = make([]*Validator, len(privKeys))
+		privValidators = make([]PrivValidator, len(privKeys))
+	)
+
+	for i, privKey := range privKeys {
+		privVal := NewMockPVWithParams(privKey, false, false)
+		pubKey, err := privVal.GetPubKey(ctx)
+		require.NoError(t, err)
+
+		valz[i] = NewValidator(pubKey, votingPower)
+		privValidators[i] = privVal
+	}
+
+	sort.Sort(PrivValidatorsByAddress(privValidators))
+
+	return NewValidatorSet(valz), privValidators
+}
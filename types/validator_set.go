@@ -332,6 +332,16 @@ func (vals *ValidatorSet) GetProposer() (proposer *Validator) {
 	return vals.Proposer.Copy()
 }
 
+// GetProposerForRound returns the proposer of the given round, assuming the
+// proposer priorities of vals are those of round 0 of its height. The
+// priorities of vals are left unchanged.
+func (vals *ValidatorSet) GetProposerForRound(round int32) *Validator {
+	if round <= 0 {
+		return vals.GetProposer()
+	}
+	return vals.CopyIncrementProposerPriority(round).GetProposer()
+}
+
 func (vals *ValidatorSet) findProposer() *Validator {
 	var proposer *Validator
 	for _, val := range vals.Validators {
@@ -673,6 +683,13 @@ func (vals *ValidatorSet) VerifyCommitLightTrusting(chainID string, commit *Comm
 	return VerifyCommitLightTrusting(chainID, vals, commit, trustLevel)
 }
 
+// VerifyAggregatedCommitLightTrusting verifies that trustLevel of the
+// validator set signed this commit, which signedVals signed.
+func (vals *ValidatorSet) VerifyAggregatedCommitLightTrusting(chainID string, signedVals *ValidatorSet,
+	commit *Commit, trustLevel tmmath.Fraction) error {
+	return VerifyAggregatedCommitLightTrusting(chainID, vals, signedVals, commit, trustLevel)
+}
+
 // findPreviousProposer reverses the compare proposer priority function to find the validator
 // with the lowest proposer priority which would have been the previous proposer.
 //
@@ -212,6 +212,21 @@ func TestProposerSelection1(t *testing.T) {
 	}
 }
 
+func TestGetProposerForRound(t *testing.T) {
+	vset := NewValidatorSet([]*Validator{
+		newValidator([]byte("foo"), 1000),
+		newValidator([]byte("bar"), 300),
+		newValidator([]byte("baz"), 330),
+	})
+	rotated := vset.Copy()
+	for round := int32(0); round < 10; round++ {
+		assert.Equal(t, rotated.GetProposer().Address, vset.GetProposerForRound(round).Address, "round %d", round)
+		rotated.IncrementProposerPriority(1)
+	}
+	// the priorities of the original set are left unchanged
+	assert.Equal(t, Address("foo"), vset.GetProposer().Address)
+}
+
 func TestProposerSelection2(t *testing.T) {
 	addr0 := []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
 	addr1 := []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}
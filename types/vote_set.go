@@ -65,6 +65,10 @@ type VoteSet struct {
 	signedMsgType tmproto.SignedMsgType
 	valSet        *ValidatorSet
 
+	// If this is true, the vote extension signature of every precommit added
+	// to the set is verified as well.
+	extensionsEnabled bool
+
 	mtx           sync.Mutex
 	votesBitArray *bits.BitArray
 	votes         []*Vote                // Primary votes to share
@@ -72,6 +76,10 @@ type VoteSet struct {
 	maj23         *BlockID               // First 2/3 majority seen
 	votesByBlock  map[string]*blockVotes // string(blockHash|blockParts) -> blockVotes
 	peerMaj23s    map[P2PID]BlockID      // Maj23 for each peer
+
+	// The aggregated commit whose precommits were added without a signature
+	// of their own, if any.
+	aggregatedCommit *Commit
 }
 
 // Constructs a new VoteSet struct used to accumulate votes for given height/round.
@@ -95,6 +103,16 @@ func NewVoteSet(chainID string, height int64, round int32,
 	}
 }
 
+// NewExtendedVoteSet constructs a vote set with additional vote verification
+// logic. The VoteSet constructed with NewExtendedVoteSet verifies the vote
+// extension signatures of precommits, in addition to the vote signatures.
+func NewExtendedVoteSet(chainID string, height int64, round int32,
+	signedMsgType tmproto.SignedMsgType, valSet *ValidatorSet) *VoteSet {
+	vs := NewVoteSet(chainID, height, round, signedMsgType, valSet)
+	vs.extensionsEnabled = true
+	return vs
+}
+
 func (voteSet *VoteSet) ChainID() string {
 	return voteSet.chainID
 }
@@ -199,9 +217,18 @@ func (voteSet *VoteSet) addVote(vote *Vote) (added bool, err error) {
 		return false, fmt.Errorf("existing vote: %v; new vote: %v: %w", existing, vote, ErrVoteNonDeterministicSignature)
 	}
 
-	// Check signature.
-	if err := vote.Verify(voteSet.chainID, val.PubKey); err != nil {
-		return false, fmt.Errorf("failed to verify vote with ChainID %s and PubKey %s: %w", voteSet.chainID, val.PubKey, err)
+	// Check signature. Precommits without an extension, such as those of a
+	// stored commit, are only added through AddCommit.
+	if voteSet.extensionsEnabled {
+		if err := vote.VerifyVoteAndExtension(voteSet.chainID, val.PubKey); err != nil {
+			return false, fmt.Errorf("failed to verify extended vote with ChainID %s and PubKey %s: %w",
+				voteSet.chainID, val.PubKey, err)
+		}
+	} else {
+		if err := vote.Verify(voteSet.chainID, val.PubKey); err != nil {
+			return false, fmt.Errorf("failed to verify vote with ChainID %s and PubKey %s: %w",
+				voteSet.chainID, val.PubKey, err)
+		}
 	}
 
 	// Add vote and get conflicting vote if any.
@@ -215,6 +242,85 @@ func (voteSet *VoteSet) addVote(vote *Vote) (added bool, err error) {
 	return added, nil
 }
 
+// AddCommit adds the precommits of a commit for the height and round of the
+// vote set, once it is verified to have +2/3 of the voting power. Its
+// precommits carry no vote extension, so they can't be added with AddVote to
+// an extended vote set. The precommits of an aggregated commit carry no
+// signature of their own either, so MakeCommit returns the aggregated commit
+// itself for its block.
+//
+// Returns added=true if any precommit was added.
+// NOTE: VoteSet must not be nil
+func (voteSet *VoteSet) AddCommit(commit *Commit) (added bool, err error) {
+	if voteSet == nil {
+		panic("AddCommit() on nil VoteSet")
+	}
+	voteSet.mtx.Lock()
+	defer voteSet.mtx.Unlock()
+
+	if (commit.Height != voteSet.height) ||
+		(commit.Round != voteSet.round) ||
+		(voteSet.signedMsgType != tmproto.PrecommitType) {
+		return false, fmt.Errorf("expected %d/%d/%d, but got commit for %d/%d: %w",
+			voteSet.height, voteSet.round, voteSet.signedMsgType,
+			commit.Height, commit.Round, ErrVoteUnexpectedStep)
+	}
+	if err := VerifyCommit(voteSet.chainID, voteSet.valSet, commit.BlockID, commit.Height, commit); err != nil {
+		return false, fmt.Errorf("failed to verify commit: %w", err)
+	}
+
+	// The commit proves a 2/3 majority for its block, so its precommits are
+	// tracked even if they conflict with votes we have already seen.
+	if votesByBlock, ok := voteSet.votesByBlock[commit.BlockID.Key()]; ok {
+		votesByBlock.peerMaj23 = true
+	} else {
+		voteSet.votesByBlock[commit.BlockID.Key()] = newBlockVotes(true, voteSet.valSet.Size())
+	}
+
+	for idx, commitSig := range commit.Signatures {
+		if commitSig.Absent() {
+			continue
+		}
+		vote := commit.GetVote(int32(idx))
+		blockKey := vote.BlockID.Key()
+
+		// Keep the votes we already know of, which may have a signature and an
+		// extension.
+		if _, ok := voteSet.getVote(vote.ValidatorIndex, blockKey); ok {
+			continue
+		}
+		_, val := voteSet.valSet.GetByIndex(vote.ValidatorIndex)
+		if ok, _ := voteSet.addVerifiedVote(vote, blockKey, val.VotingPower); ok {
+			added = true
+		}
+	}
+
+	if commit.IsAggregated() {
+		voteSet.aggregatedCommit = commit
+	}
+	return added, nil
+}
+
+// ExtensionsEnabled returns whether the vote extension signatures of the
+// precommits added to the vote set are verified.
+func (voteSet *VoteSet) ExtensionsEnabled() bool {
+	if voteSet == nil {
+		return false
+	}
+	return voteSet.extensionsEnabled
+}
+
+// AggregatedCommit returns the aggregated commit added to the vote set, if
+// any.
+func (voteSet *VoteSet) AggregatedCommit() *Commit {
+	if voteSet == nil {
+		return nil
+	}
+	voteSet.mtx.Lock()
+	defer voteSet.mtx.Unlock()
+	return voteSet.aggregatedCommit
+}
+
 // Returns (vote, true) if vote exists for valIndex and blockKey.
 func (voteSet *VoteSet) getVote(valIndex int32, blockKey string) (vote *Vote, ok bool) {
 	if existing := voteSet.votes[valIndex]; existing != nil && existing.BlockID.Key() == blockKey {
@@ -378,6 +484,19 @@ func (voteSet *VoteSet) GetByIndex(valIndex int32) *Vote {
 	return voteSet.votes[valIndex]
 }
 
+// GetVotes returns the "canonical" votes of the set, indexed by validator
+// index. Entries of validators that did not vote are nil.
+func (voteSet *VoteSet) GetVotes() []*Vote {
+	if voteSet == nil {
+		return nil
+	}
+	voteSet.mtx.Lock()
+	defer voteSet.mtx.Unlock()
+	votes := make([]*Vote, len(voteSet.votes))
+	copy(votes, voteSet.votes)
+	return votes
+}
+
 func (voteSet *VoteSet) GetByAddress(address []byte) *Vote {
 	if voteSet == nil {
 		return nil
@@ -605,6 +724,12 @@ func (voteSet *VoteSet) MakeCommit() *Commit {
 		panic("Cannot MakeCommit() unless a blockhash has +2/3")
 	}
 
+	// The precommits of an aggregated commit have no signature to make
+	// another commit with.
+	if voteSet.aggregatedCommit != nil && voteSet.aggregatedCommit.BlockID.Equals(*voteSet.maj23) {
+		return voteSet.aggregatedCommit
+	}
+
 	// For every validator, get the precommit
 	commitSigs := make([]CommitSig, len(voteSet.votes))
 	for i, v := range voteSet.votes {
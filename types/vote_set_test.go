@@ -191,7 +191,7 @@ func TestVoteSet_2_3MajorityRedux(t *testing.T) {
 
 	blockHash := crypto.CRandBytes(32)
 	blockPartsTotal := uint32(123)
-	blockPartSetHeader := PartSetHeader{blockPartsTotal, crypto.CRandBytes(32)}
+	blockPartSetHeader := PartSetHeader{Total: blockPartsTotal, Hash: crypto.CRandBytes(32)}
 
 	voteProto := &Vote{
 		ValidatorAddress: nil, // NOTE: must fill in
@@ -235,7 +235,7 @@ func TestVoteSet_2_3MajorityRedux(t *testing.T) {
 		require.NoError(t, err)
 		addr := pubKey.Address()
 		vote := withValidator(voteProto, addr, 67)
-		blockPartsHeader := PartSetHeader{blockPartsTotal, crypto.CRandBytes(32)}
+		blockPartsHeader := PartSetHeader{Total: blockPartsTotal, Hash: crypto.CRandBytes(32)}
 		_, err = signAddVote(ctx, privValidators[67], withBlockPartSetHeader(vote, blockPartsHeader), voteSet)
 		require.NoError(t, err)
 		blockID, ok = voteSet.TwoThirdsMajority()
@@ -249,7 +249,7 @@ func TestVoteSet_2_3MajorityRedux(t *testing.T) {
 		require.NoError(t, err)
 		addr := pubKey.Address()
 		vote := withValidator(voteProto, addr, 68)
-		blockPartsHeader := PartSetHeader{blockPartsTotal + 1, blockPartSetHeader.Hash}
+		blockPartsHeader := PartSetHeader{Total: blockPartsTotal + 1, Hash: blockPartSetHeader.Hash}
 		_, err = signAddVote(ctx, privValidators[68], withBlockPartSetHeader(vote, blockPartsHeader), voteSet)
 		require.NoError(t, err)
 		blockID, ok = voteSet.TwoThirdsMajority()
@@ -424,7 +424,7 @@ func TestVoteSet_MakeCommit(t *testing.T) {
 	height, round := int64(1), int32(0)
 	voteSet, _, privValidators := randVoteSet(ctx, t, height, round, tmproto.PrecommitType, 10, 1)
 
-	blockHash, blockPartSetHeader := crypto.CRandBytes(32), PartSetHeader{123, crypto.CRandBytes(32)}
+	blockHash, blockPartSetHeader := crypto.CRandBytes(32), PartSetHeader{Total: 123, Hash: crypto.CRandBytes(32)}
 
 	voteProto := &Vote{
 		ValidatorAddress: nil,
@@ -458,7 +458,7 @@ func TestVoteSet_MakeCommit(t *testing.T) {
 		addr := pv.Address()
 		vote := withValidator(voteProto, addr, 6)
 		vote = withBlockHash(vote, tmrand.Bytes(32))
-		vote = withBlockPartSetHeader(vote, PartSetHeader{123, tmrand.Bytes(32)})
+		vote = withBlockPartSetHeader(vote, PartSetHeader{Total: 123, Hash: tmrand.Bytes(32)})
 
 		_, err = signAddVote(ctx, privValidators[6], vote, voteSet)
 		require.NoError(t, err)
@@ -497,6 +497,112 @@ func TestVoteSet_MakeCommit(t *testing.T) {
 	}
 }
 
+func TestVoteSet_VoteExtensions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	height, round := int64(1), int32(0)
+	valSet, privValidators := randValidatorPrivValSet(ctx, t, 2, 1)
+	blockID := makeBlockIDRandom()
+
+	signPrecommit := func(t *testing.T, idx int32) *Vote {
+		t.Helper()
+		pubKey, err := privValidators[idx].GetPubKey(ctx)
+		require.NoError(t, err)
+		vote := &Vote{
+			ValidatorAddress: pubKey.Address(),
+			ValidatorIndex:   idx,
+			Height:           height,
+			Round:            round,
+			Type:             tmproto.PrecommitType,
+			Timestamp:        tmtime.Now(),
+			BlockID:          blockID,
+			Extension:        []byte("extension"),
+		}
+		v := vote.ToProto()
+		require.NoError(t, privValidators[idx].SignVote(ctx, "test_chain_id", v))
+		vote.Signature = v.Signature
+		vote.ExtensionSignature = v.ExtensionSignature
+		return vote
+	}
+
+	t.Run("extended vote set verifies extension signatures", func(t *testing.T) {
+		voteSet := NewExtendedVoteSet("test_chain_id", height, round, tmproto.PrecommitType, valSet)
+
+		added, err := voteSet.AddVote(signPrecommit(t, 0))
+		require.NoError(t, err)
+		assert.True(t, added)
+
+		vote := signPrecommit(t, 1)
+		vote.Extension = []byte("tampered")
+		added, err = voteSet.AddVote(vote)
+		assert.ErrorIs(t, err, ErrVoteInvalidExtensionSignature)
+		assert.False(t, added)
+
+		vote = signPrecommit(t, 1)
+		vote.ExtensionSignature = nil
+		added, err = voteSet.AddVote(vote)
+		assert.ErrorIs(t, err, ErrVoteInvalidExtensionSignature)
+		assert.False(t, added)
+
+		votes := voteSet.GetVotes()
+		require.Len(t, votes, 2)
+		assert.Equal(t, []byte("extension"), votes[0].Extension)
+		assert.Nil(t, votes[1])
+	})
+
+	t.Run("extended vote set rejects precommits stripped of their extension", func(t *testing.T) {
+		voteSet := NewExtendedVoteSet("test_chain_id", height, round, tmproto.PrecommitType, valSet)
+
+		vote := signPrecommit(t, 0)
+		stripped := vote.Copy()
+		stripped.Extension = nil
+		stripped.ExtensionSignature = nil
+		added, err := voteSet.AddVote(stripped)
+		assert.ErrorIs(t, err, ErrVoteInvalidExtensionSignature)
+		assert.False(t, added)
+
+		// so the extended vote isn't taken for a duplicate
+		added, err = voteSet.AddVote(vote)
+		require.NoError(t, err)
+		assert.True(t, added)
+		assert.Equal(t, []byte("extension"), voteSet.GetByIndex(0).Extension)
+	})
+
+	t.Run("extended vote set adds the precommits of a commit", func(t *testing.T) {
+		regular := NewVoteSet("test_chain_id", height, round, tmproto.PrecommitType, valSet)
+		for idx := int32(0); idx < 2; idx++ {
+			added, err := regular.AddVote(signPrecommit(t, idx))
+			require.NoError(t, err)
+			require.True(t, added)
+		}
+		commit := regular.MakeCommit()
+
+		// a commit without +2/3 of the voting power isn't added
+		voteSet := NewExtendedVoteSet("test_chain_id", height, round, tmproto.PrecommitType, valSet)
+		partial := *commit
+		partial.Signatures = []CommitSig{commit.Signatures[0], NewCommitSigAbsent()}
+		added, err := voteSet.AddCommit(&partial)
+		assert.Error(t, err)
+		assert.False(t, added)
+
+		added, err = voteSet.AddCommit(commit)
+		require.NoError(t, err)
+		assert.True(t, added)
+		assert.True(t, voteSet.HasTwoThirdsMajority())
+	})
+
+	t.Run("regular vote set ignores extensions", func(t *testing.T) {
+		voteSet := NewVoteSet("test_chain_id", height, round, tmproto.PrecommitType, valSet)
+
+		vote := signPrecommit(t, 0)
+		vote.ExtensionSignature = nil
+		added, err := voteSet.AddVote(vote)
+		require.NoError(t, err)
+		assert.True(t, added)
+	})
+}
+
 // NOTE: privValidators are in order
 func randVoteSet(
 	ctx context.Context,
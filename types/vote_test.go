@@ -224,6 +224,42 @@ func TestVoteVerify(t *testing.T) {
 	}
 }
 
+func TestVoteVerifyExtension(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	privVal := NewMockPV()
+	pubkey, err := privVal.GetPubKey(ctx)
+	require.NoError(t, err)
+
+	vote := examplePrecommit(t)
+	vote.ValidatorAddress = pubkey.Address()
+	vote.Extension = []byte("extension")
+	v := vote.ToProto()
+	require.NoError(t, privVal.SignVote(ctx, "test_chain_id", v))
+	vote.Signature = v.Signature
+	vote.ExtensionSignature = v.ExtensionSignature
+
+	require.NotEmpty(t, vote.ExtensionSignature)
+	assert.NoError(t, vote.VerifyVoteAndExtension("test_chain_id", pubkey))
+
+	// The extension is signed separately from the vote, so tampering with it
+	// only invalidates the extension signature.
+	vote.Extension = []byte("tampered")
+	assert.NoError(t, vote.Verify("test_chain_id", pubkey))
+	assert.Equal(t, ErrVoteInvalidExtensionSignature, vote.VerifyExtension("test_chain_id", pubkey))
+	assert.Equal(t, ErrVoteInvalidExtensionSignature, vote.VerifyVoteAndExtension("test_chain_id", pubkey))
+
+	// Prevotes carry no extension, so there is nothing to verify.
+	prevote := examplePrevote(t)
+	prevote.ValidatorAddress = pubkey.Address()
+	v = prevote.ToProto()
+	require.NoError(t, privVal.SignVote(ctx, "test_chain_id", v))
+	prevote.Signature = v.Signature
+	require.Empty(t, v.ExtensionSignature)
+	assert.NoError(t, prevote.VerifyVoteAndExtension("test_chain_id", pubkey))
+}
+
 func TestVoteString(t *testing.T) {
 	str := examplePrecommit(t).String()
 	expected := `Vote{56789:6AF1F4111082 12345/02/SIGNED_MSG_TYPE_PRECOMMIT(Precommit) 8B01023386C3 000000000000 @ 2017-12-25T03:00:01.234Z}`
@@ -250,12 +286,28 @@ func TestVoteValidateBasic(t *testing.T) {
 		{"Negative Height", func(v *Vote) { v.Height = -1 }, true},
 		{"Negative Round", func(v *Vote) { v.Round = -1 }, true},
 		{"Invalid BlockID", func(v *Vote) {
-			v.BlockID = BlockID{[]byte{1, 2, 3}, PartSetHeader{111, []byte("blockparts")}}
+			v.BlockID = BlockID{[]byte{1, 2, 3}, PartSetHeader{Total: 111, Hash: []byte("blockparts")}}
 		}, true},
 		{"Invalid Address", func(v *Vote) { v.ValidatorAddress = make([]byte, 1) }, true},
 		{"Invalid ValidatorIndex", func(v *Vote) { v.ValidatorIndex = -1 }, true},
 		{"Invalid Signature", func(v *Vote) { v.Signature = nil }, true},
 		{"Too big Signature", func(v *Vote) { v.Signature = make([]byte, MaxSignatureSize+1) }, true},
+		{"Precommit with extension", func(v *Vote) { v.Extension = []byte("extension") }, false},
+		{"Too big extension signature", func(v *Vote) {
+			v.ExtensionSignature = make([]byte, MaxSignatureSize+1)
+		}, true},
+		{"Prevote with extension", func(v *Vote) {
+			v.Type = tmproto.PrevoteType
+			v.Extension = []byte("extension")
+		}, true},
+		{"Prevote with extension signature", func(v *Vote) {
+			v.Type = tmproto.PrevoteType
+			v.ExtensionSignature = []byte("signature")
+		}, true},
+		{"Nil precommit with extension", func(v *Vote) {
+			v.BlockID = BlockID{}
+			v.Extension = []byte("extension")
+		}, true},
 	}
 	for _, tc := range testCases {
 		tc := tc